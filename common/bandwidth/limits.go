@@ -0,0 +1,161 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package bandwidth
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// TagService is the circuit tag holding the limits of the circuit's service. They're applied to each circuit
+	TagService = "bandwidth.service"
+
+	// TagClient is the circuit tag holding the limits of the dialing identity. They're applied to the total traffic of
+	// all the identity's circuits on the initiating router
+	TagClient = "bandwidth.client"
+
+	// TagHost is the circuit tag holding the limits of the hosting identity. They're applied to the total traffic of
+	// all the identity's circuits on the terminating router
+	TagHost = "bandwidth.host"
+
+	ingressRateKey  = "ingressRate"
+	ingressBurstKey = "ingressBurst"
+	egressRateKey   = "egressRate"
+	egressBurstKey  = "egressBurst"
+)
+
+// Limits are token bucket bandwidth limits. Rates are sustained bytes per second and bursts are the bytes which may
+// be sent at once before the rate applies. A rate of zero means unlimited. A burst of zero defaults to one second of
+// traffic at the rate.
+//
+// Ingress is traffic entering the network from the identity, or from clients of the service. Egress is traffic the
+// network delivers to the identity, or back to clients of the service.
+type Limits struct {
+	IngressRate  int64
+	IngressBurst int64
+	EgressRate   int64
+	EgressBurst  int64
+}
+
+// IsEmpty returns true if the limits don't restrict traffic in either direction
+func (self *Limits) IsEmpty() bool {
+	return self == nil || (self.IngressRate == 0 && self.EgressRate == 0)
+}
+
+func (self *Limits) Validate() error {
+	for _, v := range []struct {
+		name  string
+		value int64
+	}{
+		{ingressRateKey, self.IngressRate},
+		{ingressBurstKey, self.IngressBurst},
+		{egressRateKey, self.EgressRate},
+		{egressBurstKey, self.EgressBurst},
+	} {
+		if v.value < 0 {
+			return errors.Errorf("%v may not be negative", v.name)
+		}
+	}
+	return nil
+}
+
+// GetIngressBurst returns the ingress burst, defaulting to the ingress rate if not set
+func (self *Limits) GetIngressBurst() int64 {
+	if self.IngressBurst == 0 {
+		return self.IngressRate
+	}
+	return self.IngressBurst
+}
+
+// GetEgressBurst returns the egress burst, defaulting to the egress rate if not set
+func (self *Limits) GetEgressBurst() int64 {
+	if self.EgressBurst == 0 {
+		return self.EgressRate
+	}
+	return self.EgressBurst
+}
+
+// String encodes the limits for use as a circuit tag value, for example ingressRate=1048576,ingressBurst=2097152.
+// Unset values are left out
+func (self *Limits) String() string {
+	var parts []string
+	add := func(key string, value int64) {
+		if value != 0 {
+			parts = append(parts, fmt.Sprintf("%s=%d", key, value))
+		}
+	}
+	add(ingressRateKey, self.IngressRate)
+	add(ingressBurstKey, self.IngressBurst)
+	add(egressRateKey, self.EgressRate)
+	add(egressBurstKey, self.EgressBurst)
+	return strings.Join(parts, ",")
+}
+
+// Parse decodes limits encoded by Limits.String
+func Parse(val string) (*Limits, error) {
+	result := &Limits{}
+	for _, part := range strings.Split(val, ",") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		key, strValue, found := strings.Cut(part, "=")
+		if !found {
+			return nil, errors.Errorf("invalid bandwidth limit '%s', expected key=value", part)
+		}
+		value, err := strconv.ParseInt(strValue, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for bandwidth limit '%s'", key)
+		}
+		switch key {
+		case ingressRateKey:
+			result.IngressRate = value
+		case ingressBurstKey:
+			result.IngressBurst = value
+		case egressRateKey:
+			result.EgressRate = value
+		case egressBurstKey:
+			result.EgressBurst = value
+		default:
+			return nil, errors.Errorf("unknown bandwidth limit '%s'", key)
+		}
+	}
+	return result, result.Validate()
+}
+
+// FromTags returns the limits stored in the given circuit tag, or nil if the tag isn't set or doesn't restrict traffic
+func FromTags(tags map[string]string, tag string) (*Limits, error) {
+	val, found := tags[tag]
+	if !found {
+		return nil, nil
+	}
+	result, err := Parse(val)
+	if err != nil || result.IsEmpty() {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AddToTags stores the limits in the given circuit tag, if they restrict traffic
+func (self *Limits) AddToTags(tags map[string]string, tag string) {
+	if !self.IsEmpty() {
+		tags[tag] = self.String()
+	}
+}
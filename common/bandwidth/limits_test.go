@@ -0,0 +1,59 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package bandwidth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitsTagRoundTrip(t *testing.T) {
+	req := require.New(t)
+
+	limits := &Limits{
+		IngressRate:  1024,
+		IngressBurst: 4096,
+		EgressRate:   2048,
+	}
+
+	tags := map[string]string{}
+	limits.AddToTags(tags, TagService)
+	req.Equal("ingressRate=1024,ingressBurst=4096,egressRate=2048", tags[TagService])
+
+	parsed, err := FromTags(tags, TagService)
+	req.NoError(err)
+	req.Equal(limits, parsed)
+	req.Equal(int64(4096), parsed.GetIngressBurst())
+	req.Equal(int64(2048), parsed.GetEgressBurst())
+
+	parsed, err = FromTags(tags, TagClient)
+	req.NoError(err)
+	req.Nil(parsed)
+
+	(&Limits{IngressBurst: 100}).AddToTags(tags, TagHost)
+	req.NotContains(tags, TagHost)
+}
+
+func TestParseInvalid(t *testing.T) {
+	req := require.New(t)
+
+	for _, val := range []string{"ingressRate", "ingressRate=abc", "bogus=1", "egressRate=-1"} {
+		_, err := Parse(val)
+		req.Error(err, val)
+	}
+}
@@ -0,0 +1,189 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package compression
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	// TagCodec is the circuit tag holding the name of the codec the circuit's service wants payloads compressed with
+	TagCodec = "compression"
+
+	CodecZstd = "zstd"
+	CodecLz4  = "lz4"
+
+	// MaxDecompressedSize bounds how large a single payload may be once decompressed, so a corrupt or malicious
+	// payload can't make a router allocate an unbounded amount of memory
+	MaxDecompressedSize = 16 * 1024 * 1024
+)
+
+// Codec compresses and decompresses individual payloads. Codecs are safe for concurrent use.
+type Codec interface {
+	// Id is the identifier used on the wire to mark payloads compressed with the codec
+	Id() byte
+	Name() string
+	// Compress returns the compressed form of data, or nil if compressing it didn't make it any smaller
+	Compress(data []byte) []byte
+	Decompress(data []byte) ([]byte, error)
+}
+
+var codecs = []Codec{
+	&zstdCodec{},
+	&lz4Codec{},
+}
+
+// GetCodec returns the codec with the given name
+func GetCodec(name string) (Codec, bool) {
+	for _, codec := range codecs {
+		if codec.Name() == name {
+			return codec, true
+		}
+	}
+	return nil, false
+}
+
+// GetCodecById returns the codec with the given wire identifier
+func GetCodecById(id byte) (Codec, bool) {
+	for _, codec := range codecs {
+		if codec.Id() == id {
+			return codec, true
+		}
+	}
+	return nil, false
+}
+
+// CodecIds returns the wire identifiers of every supported codec
+func CodecIds() []byte {
+	var result []byte
+	for _, codec := range codecs {
+		result = append(result, codec.Id())
+	}
+	return result
+}
+
+// Validate returns an error if the name isn't empty and isn't the name of a supported codec
+func Validate(name string) error {
+	if name == "" {
+		return nil
+	}
+	if _, found := GetCodec(name); !found {
+		return errors.Errorf("invalid compression codec '%s', must be one of '%s' or '%s'", name, CodecZstd, CodecLz4)
+	}
+	return nil
+}
+
+type zstdCodec struct {
+	once    sync.Once
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+	err     error
+}
+
+func (self *zstdCodec) Id() byte {
+	return 1
+}
+
+func (self *zstdCodec) Name() string {
+	return CodecZstd
+}
+
+func (self *zstdCodec) init() error {
+	self.once.Do(func() {
+		// payloads are small, so favor speed and a small window over compression ratio
+		self.encoder, self.err = zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.SpeedFastest),
+			zstd.WithEncoderConcurrency(1),
+			zstd.WithWindowSize(1<<20))
+		if self.err != nil {
+			return
+		}
+		self.decoder, self.err = zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(0),
+			zstd.WithDecoderMaxMemory(MaxDecompressedSize))
+	})
+	return self.err
+}
+
+func (self *zstdCodec) Compress(data []byte) []byte {
+	if err := self.init(); err != nil {
+		return nil
+	}
+	result := self.encoder.EncodeAll(data, make([]byte, 0, len(data)))
+	if len(result) >= len(data) {
+		return nil
+	}
+	return result
+}
+
+func (self *zstdCodec) Decompress(data []byte) ([]byte, error) {
+	if err := self.init(); err != nil {
+		return nil, err
+	}
+	result, err := self.decoder.DecodeAll(data, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decompress zstd payload")
+	}
+	return result, nil
+}
+
+// lz4Codec uses the lz4 block format, which doesn't record the uncompressed size, so the size is prepended as a
+// uvarint
+type lz4Codec struct{}
+
+func (self *lz4Codec) Id() byte {
+	return 2
+}
+
+func (self *lz4Codec) Name() string {
+	return CodecLz4
+}
+
+func (self *lz4Codec) Compress(data []byte) []byte {
+	result := make([]byte, binary.MaxVarintLen64+lz4.CompressBlockBound(len(data)))
+	headerLen := binary.PutUvarint(result, uint64(len(data)))
+	n, err := lz4.CompressBlock(data, result[headerLen:], nil)
+	if err != nil || n == 0 || headerLen+n >= len(data) {
+		return nil
+	}
+	return result[:headerLen+n]
+}
+
+func (self *lz4Codec) Decompress(data []byte) ([]byte, error) {
+	size, headerLen := binary.Uvarint(data)
+	if headerLen <= 0 {
+		return nil, errors.New("unable to decompress lz4 payload, invalid size header")
+	}
+	if size > MaxDecompressedSize {
+		return nil, fmt.Errorf("unable to decompress lz4 payload, size %d exceeds max of %d", size, MaxDecompressedSize)
+	}
+	result := make([]byte, size)
+	n, err := lz4.UncompressBlock(data[headerLen:], result)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decompress lz4 payload")
+	}
+	if uint64(n) != size {
+		return nil, fmt.Errorf("unable to decompress lz4 payload, expected %d bytes, got %d", size, n)
+	}
+	return result, nil
+}
@@ -0,0 +1,67 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecs(t *testing.T) {
+	compressible := bytes.Repeat([]byte(`{"level":"info","msg":"request complete","status":200}`), 100)
+
+	incompressible := make([]byte, 4096)
+	_, err := rand.Read(incompressible)
+	require.NoError(t, err)
+
+	for _, name := range []string{CodecZstd, CodecLz4} {
+		t.Run(name, func(t *testing.T) {
+			req := require.New(t)
+
+			codec, found := GetCodec(name)
+			req.True(found)
+
+			byId, found := GetCodecById(codec.Id())
+			req.True(found)
+			req.Equal(codec, byId)
+
+			compressed := codec.Compress(compressible)
+			req.NotNil(compressed)
+			req.Less(len(compressed), len(compressible)/4)
+
+			decompressed, err := codec.Decompress(compressed)
+			req.NoError(err)
+			req.Equal(compressible, decompressed)
+
+			req.Nil(codec.Compress(incompressible))
+
+			_, err = codec.Decompress(incompressible)
+			req.Error(err)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	req := require.New(t)
+	req.NoError(Validate(""))
+	req.NoError(Validate(CodecZstd))
+	req.NoError(Validate(CodecLz4))
+	req.Error(Validate("gzip"))
+}
@@ -28,11 +28,12 @@ const (
 	CircuitFailedType  = 1016
 	RouteResultType    = 1022
 
-	CircuitSuccessAddressHeader = 1100
-	RouteResultAttemptHeader    = 1101
-	RouteResultSuccessHeader    = 1102
-	RouteResultErrorHeader      = 1103
-	RouteResultErrorCodeHeader  = 1104
+	CircuitSuccessAddressHeader   = 1100
+	RouteResultAttemptHeader      = 1101
+	RouteResultSuccessHeader      = 1102
+	RouteResultErrorHeader        = 1103
+	RouteResultErrorCodeHeader    = 1104
+	RouteResultDialDurationHeader = 1105
 
 	TerminatorLocalAddressHeader  = 1110
 	TerminatorRemoteAddressHeader = 1111
@@ -0,0 +1,108 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package geoip resolves client addresses to countries using a local MaxMind format database (GeoLite2 or GeoIP2
+// Country/City). The database is optional. When it isn't configured, country lookups fail and anything relying on
+// them, such as SOURCE_IP posture checks with country lists, treats the country as unknown.
+package geoip
+
+import (
+	"net"
+	"strings"
+	"sync/atomic"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/pkg/errors"
+)
+
+var ErrNotConfigured = errors.New("no geoip database configured")
+
+type Database interface {
+	// Country returns the ISO 3166-1 alpha-2 code of the country the address is registered to, or an empty string if
+	// the address isn't in the database
+	Country(ip net.IP) (string, error)
+}
+
+type databaseHolder struct {
+	Database
+}
+
+var current atomic.Pointer[databaseHolder]
+
+// SetDatabase sets the database used by Country. Passing nil clears it.
+func SetDatabase(db Database) {
+	if db == nil {
+		current.Store(nil)
+	} else {
+		current.Store(&databaseHolder{Database: db})
+	}
+}
+
+// Configure opens the database at the given path and makes it the current database. An empty path clears it.
+func Configure(path string) error {
+	if path == "" {
+		SetDatabase(nil)
+		return nil
+	}
+
+	db, err := Open(path)
+	if err != nil {
+		return err
+	}
+	SetDatabase(db)
+	pfxlog.Logger().WithField("path", path).Info("geoip database loaded")
+	return nil
+}
+
+// Country looks the address up in the current database
+func Country(ip net.IP) (string, error) {
+	holder := current.Load()
+	if holder == nil {
+		return "", ErrNotConfigured
+	}
+	return holder.Country(ip)
+}
+
+type mmdbDatabase struct {
+	reader *maxminddb.Reader
+}
+
+type countryRecord struct {
+	Country struct {
+		IsoCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+}
+
+func Open(path string) (Database, error) {
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open geoip database '%v'", path)
+	}
+	return &mmdbDatabase{reader: reader}, nil
+}
+
+func (self *mmdbDatabase) Country(ip net.IP) (string, error) {
+	if ip == nil {
+		return "", errors.New("no address given")
+	}
+
+	record := &countryRecord{}
+	if err := self.reader.Lookup(ip, record); err != nil {
+		return "", errors.Wrapf(err, "geoip lookup failed for %v", ip)
+	}
+	return strings.ToUpper(record.Country.IsoCode), nil
+}
@@ -0,0 +1,157 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package geoip
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SourceIpRules decides whether a client address is acceptable. It's shared by the controller and routers, so SOURCE_IP
+// posture checks are evaluated the same way in both places.
+//
+// An address is rejected if it's in any denied range or is located in a denied country. If any allowed ranges or
+// countries are given, the address must also be in one of the allowed ranges or located in one of the allowed
+// countries. Country rules require a geoip database. If the country can't be determined, country deny lists fail
+// closed and country allow lists don't match.
+type SourceIpRules struct {
+	AllowedCidrs     []string
+	DeniedCidrs      []string
+	AllowedCountries []string
+	DeniedCountries  []string
+}
+
+// NormalizeCidr accepts either a CIDR or a bare address, which is treated as a single host range
+func NormalizeCidr(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !strings.Contains(value, "/") {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return "", errors.Errorf("invalid address or CIDR '%v'", value)
+		}
+		if ip.To4() != nil {
+			return ip.String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+
+	_, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		return "", errors.Errorf("invalid address or CIDR '%v'", value)
+	}
+	return ipNet.String(), nil
+}
+
+// NormalizeCountry upper cases the country code and verifies that it looks like an ISO 3166-1 alpha-2 code
+func NormalizeCountry(value string) (string, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if len(value) != 2 || value[0] < 'A' || value[0] > 'Z' || value[1] < 'A' || value[1] > 'Z' {
+		return "", errors.Errorf("invalid country code '%v', expected an ISO 3166-1 alpha-2 code such as US", value)
+	}
+	return value, nil
+}
+
+func (self *SourceIpRules) Validate() error {
+	if len(self.AllowedCidrs) == 0 && len(self.DeniedCidrs) == 0 && len(self.AllowedCountries) == 0 && len(self.DeniedCountries) == 0 {
+		return errors.New("at least one allowed or denied CIDR or country must be specified")
+	}
+
+	for _, list := range [][]string{self.AllowedCidrs, self.DeniedCidrs} {
+		for _, cidr := range list {
+			if _, err := NormalizeCidr(cidr); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, list := range [][]string{self.AllowedCountries, self.DeniedCountries} {
+		for _, country := range list {
+			if _, err := NormalizeCountry(country); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Evaluate returns nil if the address is acceptable, otherwise an error describing why it isn't
+func (self *SourceIpRules) Evaluate(ip net.IP) error {
+	if ip == nil {
+		return errors.New("client source address is unknown")
+	}
+
+	if cidr := findCidr(ip, self.DeniedCidrs); cidr != "" {
+		return fmt.Errorf("source address %v is in denied range %v", ip, cidr)
+	}
+
+	country, countryErr := "", error(nil)
+	if len(self.DeniedCountries) > 0 || len(self.AllowedCountries) > 0 {
+		country, countryErr = Country(ip)
+	}
+
+	if len(self.DeniedCountries) > 0 {
+		if countryErr != nil {
+			return errors.Wrapf(countryErr, "unable to determine country of source address %v", ip)
+		}
+		if containsCountry(self.DeniedCountries, country) {
+			return fmt.Errorf("source address %v is located in denied country %v", ip, country)
+		}
+	}
+
+	if len(self.AllowedCidrs) == 0 && len(self.AllowedCountries) == 0 {
+		return nil
+	}
+
+	if findCidr(ip, self.AllowedCidrs) != "" {
+		return nil
+	}
+
+	if countryErr == nil && country != "" && containsCountry(self.AllowedCountries, country) {
+		return nil
+	}
+
+	if country != "" {
+		return fmt.Errorf("source address %v (%v) is not in an allowed range or country", ip, country)
+	}
+	return fmt.Errorf("source address %v is not in an allowed range or country", ip)
+}
+
+func findCidr(ip net.IP, cidrs []string) string {
+	for _, cidr := range cidrs {
+		normalized, err := NormalizeCidr(cidr)
+		if err != nil {
+			continue
+		}
+		if _, ipNet, err := net.ParseCIDR(normalized); err == nil && ipNet.Contains(ip) {
+			return normalized
+		}
+	}
+	return ""
+}
+
+func containsCountry(countries []string, country string) bool {
+	for _, candidate := range countries {
+		if strings.EqualFold(strings.TrimSpace(candidate), country) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package geoip
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testDatabase map[string]string
+
+func (self testDatabase) Country(ip net.IP) (string, error) {
+	return self[ip.String()], nil
+}
+
+func TestSourceIpRules_Evaluate(t *testing.T) {
+	SetDatabase(nil)
+	defer SetDatabase(nil)
+
+	t.Run("cidr rules", func(t *testing.T) {
+		req := require.New(t)
+
+		rules := &SourceIpRules{
+			AllowedCidrs: []string{"10.0.0.0/8", "2001:db8::/32"},
+			DeniedCidrs:  []string{"10.1.0.0/16"},
+		}
+		req.NoError(rules.Validate())
+
+		req.NoError(rules.Evaluate(net.ParseIP("10.2.3.4")))
+		req.NoError(rules.Evaluate(net.ParseIP("2001:db8::1")))
+		req.Error(rules.Evaluate(net.ParseIP("10.1.3.4")))
+		req.Error(rules.Evaluate(net.ParseIP("192.168.1.1")))
+		req.Error(rules.Evaluate(nil))
+	})
+
+	t.Run("deny only rules allow everything else", func(t *testing.T) {
+		req := require.New(t)
+
+		rules := &SourceIpRules{DeniedCidrs: []string{"203.0.113.7"}}
+		req.NoError(rules.Validate())
+		req.Error(rules.Evaluate(net.ParseIP("203.0.113.7")))
+		req.NoError(rules.Evaluate(net.ParseIP("203.0.113.8")))
+	})
+
+	t.Run("country rules", func(t *testing.T) {
+		req := require.New(t)
+
+		rules := &SourceIpRules{
+			AllowedCidrs:     []string{"10.0.0.0/8"},
+			AllowedCountries: []string{"us", "CA"},
+		}
+		req.NoError(rules.Validate())
+
+		// without a database, only the ranges can match
+		req.NoError(rules.Evaluate(net.ParseIP("10.0.0.1")))
+		req.Error(rules.Evaluate(net.ParseIP("198.51.100.1")))
+
+		SetDatabase(testDatabase{"198.51.100.1": "US", "198.51.100.2": "FR"})
+		req.NoError(rules.Evaluate(net.ParseIP("198.51.100.1")))
+		req.Error(rules.Evaluate(net.ParseIP("198.51.100.2")))
+		req.Error(rules.Evaluate(net.ParseIP("198.51.100.3")))
+	})
+
+	t.Run("denied countries fail closed", func(t *testing.T) {
+		req := require.New(t)
+
+		rules := &SourceIpRules{DeniedCountries: []string{"FR"}}
+
+		SetDatabase(nil)
+		req.Error(rules.Evaluate(net.ParseIP("198.51.100.1")))
+
+		SetDatabase(testDatabase{"198.51.100.1": "US", "198.51.100.2": "FR"})
+		req.NoError(rules.Evaluate(net.ParseIP("198.51.100.1")))
+		req.Error(rules.Evaluate(net.ParseIP("198.51.100.2")))
+	})
+}
+
+func TestSourceIpRules_Validate(t *testing.T) {
+	req := require.New(t)
+
+	req.Error((&SourceIpRules{}).Validate())
+	req.Error((&SourceIpRules{AllowedCidrs: []string{"10.0.0.0/33"}}).Validate())
+	req.Error((&SourceIpRules{AllowedCidrs: []string{"not-an-ip"}}).Validate())
+	req.Error((&SourceIpRules{DeniedCountries: []string{"USA"}}).Validate())
+
+	cidr, err := NormalizeCidr("10.1.2.3/8")
+	req.NoError(err)
+	req.Equal("10.0.0.0/8", cidr)
+
+	cidr, err = NormalizeCidr("2001:db8::1")
+	req.NoError(err)
+	req.Equal("2001:db8::1/128", cidr)
+
+	country, err := NormalizeCountry(" de ")
+	req.NoError(err)
+	req.Equal("DE", country)
+}
@@ -18,6 +18,7 @@ package inspect
 
 type CircuitInspectDetail struct {
 	CircuitId         string                        `json:"circuitId"`
+	Priority          string                        `json:"priority,omitempty"`
 	Forwards          map[string]string             `json:"forwards"`
 	XgressDetails     map[string]*XgressDetail      `json:"xgressDetails"`
 	LinkDetails       map[string]*LinkInspectDetail `json:"linkDetails"`
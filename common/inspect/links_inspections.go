@@ -36,6 +36,9 @@ type LinkInspectDetail struct {
 	Dest        string `json:"dest"`
 	DestVersion string `json:"destVersion"`
 	Dialed      bool   `json:"dialed"`
+
+	// QueuedMsgs holds the number of messages waiting to be scheduled onto the link, by priority class
+	QueuedMsgs map[string]int `json:"queuedMsgs,omitempty"`
 }
 
 type LinkDest struct {
@@ -59,3 +59,11 @@ func (request *ValidateRouterDataModelResponse) GetContentType() int32 {
 func (request *RouterDataModelDetails) GetContentType() int32 {
 	return int32(ContentType_ValidateRouterDataModelResultType)
 }
+
+func (request *PolicySimulationRequest) GetContentType() int32 {
+	return int32(ContentType_PolicySimulationRequestType)
+}
+
+func (request *PolicySimulationResponse) GetContentType() int32 {
+	return int32(ContentType_PolicySimulationResponseType)
+}
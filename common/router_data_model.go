@@ -22,11 +22,13 @@ import (
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/common/schedule"
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/sirupsen/logrus"
 	"io"
 	"os"
 	"sync/atomic"
+	"time"
 )
 
 // AccessPolicies represents the Identity's access to a Service through many Policies. The PostureChecks provided
@@ -84,6 +86,39 @@ type ServicePolicy struct {
 	PostureChecks map[string]struct{} `json:"postureChecks"`
 }
 
+// GetSchedule returns the time restrictions on the policy, or nil if the policy is always in effect
+func (self *ServicePolicy) GetSchedule() *schedule.Schedule {
+	result := &schedule.Schedule{}
+
+	if validFrom := self.GetValidFrom(); validFrom != nil {
+		t := validFrom.AsTime()
+		result.ValidFrom = &t
+	}
+
+	if validUntil := self.GetValidUntil(); validUntil != nil {
+		t := validUntil.AsTime()
+		result.ValidUntil = &t
+	}
+
+	for _, window := range self.GetSchedules() {
+		result.Windows = append(result.Windows, &schedule.Window{
+			Cron:     window.Cron,
+			Duration: time.Duration(window.DurationSeconds) * time.Second,
+			Timezone: window.Timezone,
+		})
+	}
+
+	if result.IsEmpty() {
+		return nil
+	}
+	return result
+}
+
+// IsActiveAt returns false if the policy has a schedule which doesn't grant access at the given time
+func (self *ServicePolicy) IsActiveAt(t time.Time) bool {
+	return self.GetSchedule().IsActiveAt(t)
+}
+
 // RouterDataModel represents a sub-set of a controller's data model. Enough to validate an identities access to dial/bind
 // a service through policies and posture checks. RouterDataModel can operate in two modes: sender (controller) and
 // receiver (router). Sender mode allows a controller support an event cache that supports replays for routers connecting
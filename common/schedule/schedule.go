@@ -0,0 +1,153 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package schedule evaluates the time bounds and recurring windows which can be attached to service policies. It's
+// shared by the controller and routers, so both come to the same answer about whether a policy is currently active.
+package schedule
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+)
+
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// parsed cron schedules, keyed by timezone and expression, since policies are evaluated far more often than they change
+var parsedWindows sync.Map
+
+// Window is a recurring period of access. It opens each time the cron expression fires, evaluated in the given
+// timezone, and stays open for the given duration. For example, business hours could be expressed as a cron of
+// `0 9 * * MON-FRI`, a duration of 8h and a timezone of America/New_York.
+type Window struct {
+	Cron     string
+	Duration time.Duration
+	Timezone string
+}
+
+func (self *Window) String() string {
+	return fmt.Sprintf("%v for %v (%v)", self.Cron, self.Duration, self.getTimezone())
+}
+
+func (self *Window) getTimezone() string {
+	if self.Timezone == "" {
+		return "UTC"
+	}
+	return self.Timezone
+}
+
+func (self *Window) parse() (cron.Schedule, error) {
+	key := self.getTimezone() + "|" + self.Cron
+	if val, found := parsedWindows.Load(key); found {
+		return val.(cron.Schedule), nil
+	}
+
+	if _, err := time.LoadLocation(self.getTimezone()); err != nil {
+		return nil, errors.Wrapf(err, "invalid timezone '%v'", self.Timezone)
+	}
+
+	sched, err := cronParser.Parse("CRON_TZ=" + self.getTimezone() + " " + self.Cron)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cron expression '%v'", self.Cron)
+	}
+
+	parsedWindows.Store(key, sched)
+	return sched, nil
+}
+
+func (self *Window) Validate() error {
+	if self.Duration <= 0 {
+		return errors.Errorf("invalid duration '%v' for schedule '%v', must be greater than zero", self.Duration, self.Cron)
+	}
+	_, err := self.parse()
+	return err
+}
+
+// IsOpenAt returns true if the window was opened by a cron activation in the duration leading up to t. Invalid
+// windows are never open.
+func (self *Window) IsOpenAt(t time.Time) bool {
+	sched, err := self.parse()
+	if err != nil || self.Duration <= 0 {
+		return false
+	}
+	// Next returns the first activation strictly after the given time, so if there's an activation in
+	// (t - duration, t], the window is open
+	return !sched.Next(t.Add(-self.Duration)).After(t)
+}
+
+// Schedule limits when something, such as a service policy, is in effect. Access is only granted between ValidFrom and
+// ValidUntil, when set, and, if any windows are defined, only while at least one of them is open.
+type Schedule struct {
+	ValidFrom  *time.Time
+	ValidUntil *time.Time
+	Windows    []*Window
+}
+
+// IsEmpty returns true if the schedule places no restrictions on access. A nil schedule is empty.
+func (self *Schedule) IsEmpty() bool {
+	return self == nil || (self.ValidFrom == nil && self.ValidUntil == nil && len(self.Windows) == 0)
+}
+
+func (self *Schedule) Validate() error {
+	if self.IsEmpty() {
+		return nil
+	}
+
+	if self.ValidFrom != nil && self.ValidUntil != nil && !self.ValidUntil.After(*self.ValidFrom) {
+		return errors.Errorf("validUntil (%v) must be after validFrom (%v)", self.ValidUntil.Format(time.RFC3339), self.ValidFrom.Format(time.RFC3339))
+	}
+
+	for _, window := range self.Windows {
+		if window == nil {
+			return errors.New("schedules may not contain empty entries")
+		}
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsActiveAt returns true if the schedule allows access at the given time
+func (self *Schedule) IsActiveAt(t time.Time) bool {
+	if self.IsEmpty() {
+		return true
+	}
+
+	if self.ValidFrom != nil && t.Before(*self.ValidFrom) {
+		return false
+	}
+
+	if self.ValidUntil != nil && !t.Before(*self.ValidUntil) {
+		return false
+	}
+
+	if len(self.Windows) == 0 {
+		return true
+	}
+
+	for _, window := range self.Windows {
+		if window.IsOpenAt(t) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, value string) time.Time {
+	result, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	return result
+}
+
+func TestWindow_IsOpenAt(t *testing.T) {
+	req := require.New(t)
+
+	businessHours := &Window{
+		Cron:     "0 9 * * MON-FRI",
+		Duration: 8 * time.Hour,
+		Timezone: "America/New_York",
+	}
+	req.NoError(businessHours.Validate())
+
+	// 2024-06-03 is a monday, with New York at UTC-4
+	req.False(businessHours.IsOpenAt(mustParse(t, "2024-06-03T12:59:59Z")))
+	req.True(businessHours.IsOpenAt(mustParse(t, "2024-06-03T13:00:00Z")))
+	req.True(businessHours.IsOpenAt(mustParse(t, "2024-06-03T20:59:59Z")))
+	req.False(businessHours.IsOpenAt(mustParse(t, "2024-06-03T21:00:00Z")))
+
+	// saturday
+	req.False(businessHours.IsOpenAt(mustParse(t, "2024-06-08T15:00:00Z")))
+
+	// windows which run past midnight stay open into the next day
+	overnight := &Window{Cron: "0 22 * * *", Duration: 4 * time.Hour}
+	req.True(overnight.IsOpenAt(mustParse(t, "2024-06-04T01:30:00Z")))
+	req.False(overnight.IsOpenAt(mustParse(t, "2024-06-04T02:00:00Z")))
+}
+
+func TestWindow_Validate(t *testing.T) {
+	req := require.New(t)
+
+	req.Error((&Window{Cron: "0 9 * * *"}).Validate())
+	req.Error((&Window{Cron: "not cron", Duration: time.Hour}).Validate())
+	req.Error((&Window{Cron: "0 9 * * *", Duration: time.Hour, Timezone: "Mars/Olympus_Mons"}).Validate())
+	req.NoError((&Window{Cron: "@daily", Duration: time.Hour, Timezone: "Europe/Paris"}).Validate())
+
+	invalid := &Window{Cron: "not cron", Duration: time.Hour}
+	req.False(invalid.IsOpenAt(time.Now()))
+}
+
+func TestSchedule_IsActiveAt(t *testing.T) {
+	req := require.New(t)
+
+	var empty *Schedule
+	req.True(empty.IsEmpty())
+	req.True(empty.IsActiveAt(time.Now()))
+
+	validFrom := mustParse(t, "2024-06-01T00:00:00Z")
+	validUntil := mustParse(t, "2024-07-01T00:00:00Z")
+	bounded := &Schedule{ValidFrom: &validFrom, ValidUntil: &validUntil}
+	req.NoError(bounded.Validate())
+	req.False(bounded.IsActiveAt(mustParse(t, "2024-05-31T23:59:59Z")))
+	req.True(bounded.IsActiveAt(validFrom))
+	req.True(bounded.IsActiveAt(mustParse(t, "2024-06-15T03:00:00Z")))
+	req.False(bounded.IsActiveAt(validUntil))
+
+	bounded.Windows = []*Window{
+		{Cron: "0 9 * * *", Duration: time.Hour},
+		{Cron: "0 17 * * *", Duration: time.Hour},
+	}
+	req.NoError(bounded.Validate())
+	req.False(bounded.IsActiveAt(mustParse(t, "2024-06-15T03:00:00Z")))
+	req.True(bounded.IsActiveAt(mustParse(t, "2024-06-15T09:30:00Z")))
+	req.True(bounded.IsActiveAt(mustParse(t, "2024-06-15T17:30:00Z")))
+	req.False(bounded.IsActiveAt(mustParse(t, "2024-07-01T09:30:00Z")))
+
+	reversed := &Schedule{ValidFrom: &validUntil, ValidUntil: &validFrom}
+	req.Error(reversed.Validate())
+}
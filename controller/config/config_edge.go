@@ -103,6 +103,9 @@ type EdgeConfig struct {
 	Totp            Totp
 	AuthRateLimiter command.AdaptiveRateLimiterConfig
 	caCerts         []*x509.Certificate
+
+	// GeoIpDatabase is the path to a MaxMind format country database, used by SOURCE_IP posture checks with country rules
+	GeoIpDatabase string
 }
 
 type HttpTimeouts struct {
@@ -490,6 +493,14 @@ func LoadEdgeConfigFromMap(configMap map[interface{}]interface{}) (*EdgeConfig,
 		return nil, err
 	}
 
+	if value, found := edgeConfigMap["geoIpDatabase"]; found && value != nil {
+		if path, ok := value.(string); ok {
+			edgeConfig.GeoIpDatabase = path
+		} else {
+			return nil, fmt.Errorf("[edge.geoIpDatabase] must be a string")
+		}
+	}
+
 	return edgeConfig, nil
 }
 
@@ -65,6 +65,27 @@ type NetworkConfig struct {
 		RerouteCap      uint32
 		MinCostDelta    uint32
 	}
+	LinkCost LinkCostConfig
+}
+
+// LinkCostConfig controls how link quality metrics reported by routers factor into link cost. Link cost always
+// includes the static cost and the latency, in milliseconds, reported by each side of the link. Each weight adds to
+// that cost in proportion to the matching metric. All weights default to zero, which leaves link cost based on latency
+// only.
+type LinkCostConfig struct {
+	// JitterWeight is the cost added per millisecond of latency standard deviation, summed across both sides of the link
+	JitterWeight float64
+	// LossWeight is the cost added per percent of messages dropped by the link
+	LossWeight float64
+	// UtilizationWeight is the cost added per percent of link capacity in use. Requires Capacity.
+	UtilizationWeight float64
+	// Capacity is the link throughput capacity, in bytes per second, which utilization is measured against
+	Capacity float64
+}
+
+// IsLatencyOnly returns true if link cost ignores all link quality metrics other than latency
+func (self *LinkCostConfig) IsLatencyOnly() bool {
+	return self == nil || (self.JitterWeight == 0 && self.LossWeight == 0 && (self.UtilizationWeight == 0 || self.Capacity == 0))
 }
 
 func DefaultNetworkConfig() *NetworkConfig {
@@ -158,6 +179,16 @@ func LoadNetworkConfig(src map[interface{}]interface{}) (*NetworkConfig, error)
 		}
 	}
 
+	if value, found := src["linkCost"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if err := loadLinkCostConfig(&options.LinkCost, submap); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, errors.New("invalid 'linkCost' stanza")
+		}
+	}
+
 	if value, found := src["routerMessaging"]; found {
 		if submap, ok := value.(map[interface{}]interface{}); ok {
 			if value, found := submap["queueSize"]; found {
@@ -270,3 +301,45 @@ func LoadNetworkConfig(src map[interface{}]interface{}) (*NetworkConfig, error)
 
 	return options, nil
 }
+
+func loadLinkCostConfig(linkCost *LinkCostConfig, src map[interface{}]interface{}) error {
+	weights := map[string]*float64{
+		"jitterWeight":      &linkCost.JitterWeight,
+		"lossWeight":        &linkCost.LossWeight,
+		"utilizationWeight": &linkCost.UtilizationWeight,
+	}
+
+	for key, target := range weights {
+		if value, found := src[key]; found {
+			weight, ok := toFloat64(value)
+			if !ok || weight < 0 {
+				return errors.Errorf("invalid value for 'linkCost.%s', must be a number greater than or equal to 0", key)
+			}
+			*target = weight
+		}
+	}
+
+	if value, found := src["capacityMbps"]; found {
+		capacity, ok := toFloat64(value)
+		if !ok || capacity <= 0 {
+			return errors.New("invalid value for 'linkCost.capacityMbps', must be a number greater than 0")
+		}
+		linkCost.Capacity = capacity * 1_000_000 / 8
+	}
+
+	if linkCost.UtilizationWeight > 0 && linkCost.Capacity == 0 {
+		return errors.New("'linkCost.utilizationWeight' requires 'linkCost.capacityMbps' to be set")
+	}
+
+	return nil
+}
+
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -63,6 +63,9 @@ import (
 	"github.com/openziti/ziti/controller/xctrl"
 	"github.com/openziti/ziti/controller/xmgmt"
 	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_consistenthash"
+	"github.com/openziti/ziti/controller/xt_leastconnections"
+	"github.com/openziti/ziti/controller/xt_lowestlatency"
 	"github.com/openziti/ziti/controller/xt_random"
 	"github.com/openziti/ziti/controller/xt_smartrouting"
 	"github.com/openziti/ziti/controller/xt_weighted"
@@ -461,6 +464,11 @@ func (c *Controller) registerXts() {
 	xt.GlobalRegistry().RegisterFactory(xt_random.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_weighted.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_sticky.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_lowestlatency.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_leastconnections.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_consistenthash.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_consistenthash.NewAppDataFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_consistenthash.NewSourceAddressFactory())
 }
 
 func (c *Controller) registerComponents() error {
@@ -0,0 +1,75 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/storage/boltz"
+)
+
+const (
+	FieldBandwidthLimits = "bandwidthLimits"
+
+	FieldBandwidthLimitsIngressRate  = "ingressRate"
+	FieldBandwidthLimitsIngressBurst = "ingressBurst"
+	FieldBandwidthLimitsEgressRate   = "egressRate"
+	FieldBandwidthLimitsEgressBurst  = "egressBurst"
+)
+
+// BandwidthLimits are the token bucket limits applied to traffic for a service or identity. Rates are in bytes per
+// second and bursts in bytes. Zero values are unset
+type BandwidthLimits struct {
+	IngressRate  int64 `json:"ingressRate"`
+	IngressBurst int64 `json:"ingressBurst"`
+	EgressRate   int64 `json:"egressRate"`
+	EgressBurst  int64 `json:"egressBurst"`
+}
+
+func loadBandwidthLimits(bucket *boltz.TypedBucket) *BandwidthLimits {
+	limitsBucket := bucket.GetBucket(FieldBandwidthLimits)
+	if limitsBucket == nil {
+		return nil
+	}
+	return &BandwidthLimits{
+		IngressRate:  limitsBucket.GetInt64WithDefault(FieldBandwidthLimitsIngressRate, 0),
+		IngressBurst: limitsBucket.GetInt64WithDefault(FieldBandwidthLimitsIngressBurst, 0),
+		EgressRate:   limitsBucket.GetInt64WithDefault(FieldBandwidthLimitsEgressRate, 0),
+		EgressBurst:  limitsBucket.GetInt64WithDefault(FieldBandwidthLimitsEgressBurst, 0),
+	}
+}
+
+func persistBandwidthLimits(ctx *boltz.PersistContext, limits *BandwidthLimits) {
+	if !ctx.ProceedWithSet(FieldBandwidthLimits) {
+		return
+	}
+
+	if limits == nil {
+		if ctx.Bucket.GetBucket(FieldBandwidthLimits) != nil {
+			ctx.Bucket.DeleteEntity(FieldBandwidthLimits)
+		}
+		return
+	}
+
+	limitsBucket, err := ctx.Bucket.EmptyBucket(FieldBandwidthLimits)
+	if ctx.Bucket.SetError(err) {
+		return
+	}
+	limitsBucket.SetInt64(FieldBandwidthLimitsIngressRate, limits.IngressRate, nil)
+	limitsBucket.SetInt64(FieldBandwidthLimitsIngressBurst, limits.IngressBurst, nil)
+	limitsBucket.SetInt64(FieldBandwidthLimitsEgressRate, limits.EgressRate, nil)
+	limitsBucket.SetInt64(FieldBandwidthLimitsEgressBurst, limits.EgressBurst, nil)
+	ctx.Bucket.SetError(limitsBucket.Err)
+}
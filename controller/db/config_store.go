@@ -19,6 +19,7 @@ package db
 import (
 	"fmt"
 	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/common/eid"
@@ -60,6 +61,9 @@ var _ ConfigStore = (*configStoreImpl)(nil)
 type ConfigStore interface {
 	Store[*Config]
 	NameIndexed
+
+	// GetServiceIds returns the ids of services which use the config, either directly or as an identity override
+	GetServiceIds(tx *bbolt.Tx, id string) ([]string, error)
 }
 
 func newConfigsStore(stores *stores) *configStoreImpl {
@@ -171,3 +175,20 @@ func (store *configStoreImpl) createServiceChangeEvents(tx *bbolt.Tx, configId s
 		eh.addServiceEvent(tx, []byte(identityId), []byte(serviceId), ServiceUpdated)
 	})
 }
+
+func (store *configStoreImpl) GetServiceIds(tx *bbolt.Tx, id string) ([]string, error) {
+	result := store.GetRelatedEntitiesIdList(tx, id, EntityTypeServices)
+
+	err := store.symbolIdentityServices.Map(tx, []byte(id), func(mapCtx *boltz.MapContext) {
+		keys, err := boltz.DecodeStringSlice(mapCtx.Value())
+		if err != nil {
+			mapCtx.SetError(err)
+			return
+		}
+		if serviceId := keys[1]; !stringz.Contains(result, serviceId) {
+			result = append(result, serviceId)
+		}
+	})
+
+	return result, err
+}
@@ -19,8 +19,10 @@ package db
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/openziti/storage/boltz"
 	"github.com/openziti/storage/boltztest"
 	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
 	"go.etcd.io/bbolt"
 	"testing"
 	"time"
@@ -33,6 +35,7 @@ func Test_ConfigStore(t *testing.T) {
 
 	t.Run("test config CRUD", ctx.testConfigCrud)
 	t.Run("test config Query", ctx.testConfigQuery)
+	t.Run("test config service ids", ctx.testConfigServiceIds)
 }
 
 func (ctx *TestContext) testConfigCrud(*testing.T) {
@@ -183,3 +186,44 @@ func (ctx *TestContext) testConfigQuery(*testing.T) {
 
 	boltztest.RequireDelete(ctx, config)
 }
+
+func (ctx *TestContext) testConfigServiceIds(*testing.T) {
+	ctx.CleanupAll()
+
+	configType := newConfigType(eid.New())
+	boltztest.RequireCreate(ctx, configType)
+
+	config := newConfig(eid.New(), configType.Id, map[string]interface{}{"port": int64(22)})
+	boltztest.RequireCreate(ctx, config)
+
+	unusedConfig := newConfig(eid.New(), configType.Id, map[string]interface{}{"port": int64(23)})
+	boltztest.RequireCreate(ctx, unusedConfig)
+
+	service := newEdgeService(eid.New())
+	service.Configs = []string{config.Id}
+	boltztest.RequireCreate(ctx, service)
+
+	overrideService := ctx.RequireNewService(eid.New())
+	identity := ctx.RequireNewIdentity(eid.New(), false)
+	err := ctx.GetDb().Update(change.New().NewMutateContext(), func(mutateCtx boltz.MutateContext) error {
+		identity.ServiceConfigs = map[string]map[string]string{
+			overrideService.Id: {configType.Id: config.Id},
+		}
+		return ctx.stores.Identity.Update(mutateCtx, identity, boltz.MapFieldChecker{
+			FieldIdentityServiceConfigs: struct{}{},
+		})
+	})
+	ctx.NoError(err)
+
+	err = ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		serviceIds, err := ctx.stores.Config.GetServiceIds(tx, config.Id)
+		ctx.NoError(err)
+		ctx.ElementsMatch([]string{service.Id, overrideService.Id}, serviceIds)
+
+		serviceIds, err = ctx.stores.Config.GetServiceIds(tx, unusedConfig.Id)
+		ctx.NoError(err)
+		ctx.Empty(serviceIds)
+		return nil
+	})
+	ctx.NoError(err)
+}
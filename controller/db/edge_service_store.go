@@ -32,9 +32,10 @@ const (
 
 type EdgeService struct {
 	Service
-	RoleAttributes     []string `json:"roleAttributes"`
-	Configs            []string `json:"configs"`
-	EncryptionRequired bool     `json:"encryptionRequired"`
+	RoleAttributes     []string         `json:"roleAttributes"`
+	Configs            []string         `json:"configs"`
+	EncryptionRequired bool             `json:"encryptionRequired"`
+	BandwidthLimits    *BandwidthLimits `json:"bandwidthLimits"`
 }
 
 func newEdgeService(name string, roleAttributes ...string) *EdgeService {
@@ -166,6 +167,7 @@ func (store *edgeServiceStoreImpl) FillEntity(entity *EdgeService, bucket *boltz
 
 	//default to true for old services w/o any value explicitly set
 	entity.EncryptionRequired = bucket.GetBoolWithDefault(FieldServiceEncryptionRequired, true)
+	entity.BandwidthLimits = loadBandwidthLimits(bucket)
 }
 
 func (store *edgeServiceStoreImpl) PersistEntity(entity *EdgeService, ctx *boltz.PersistContext) {
@@ -176,6 +178,7 @@ func (store *edgeServiceStoreImpl) PersistEntity(entity *EdgeService, ctx *boltz
 	ctx.SetStringList(FieldRoleAttributes, entity.RoleAttributes)
 	ctx.SetLinkedIds(EntityTypeConfigs, entity.Configs)
 	ctx.SetBool(FieldServiceEncryptionRequired, entity.EncryptionRequired)
+	persistBandwidthLimits(ctx, entity.BandwidthLimits)
 
 	// index change won't fire if we don't have any roles on create, but we need to evaluate if we match any #all roles
 	if ctx.IsCreate && len(entity.RoleAttributes) == 0 {
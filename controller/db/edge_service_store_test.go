@@ -40,6 +40,7 @@ func Test_EdgeServiceStore(t *testing.T) {
 	t.Run("test create service", ctx.testCreateEdgeServices)
 	t.Run("test load/query services", ctx.testLoadQueryEdgeServices)
 	t.Run("test update services", ctx.testUpdateEdgeServices)
+	t.Run("test service bandwidth limits", ctx.testEdgeServiceBandwidthLimits)
 	t.Run("test delete services", ctx.testDeleteEdgeServices)
 }
 
@@ -247,6 +248,27 @@ func (ctx *TestContext) testUpdateEdgeServices(_ *testing.T) {
 	ctx.NoError(err)
 }
 
+func (ctx *TestContext) testEdgeServiceBandwidthLimits(_ *testing.T) {
+	ctx.CleanupAll()
+
+	edgeService := newEdgeService(eid.New())
+	edgeService.BandwidthLimits = &BandwidthLimits{
+		IngressRate:  1024,
+		IngressBurst: 4096,
+		EgressRate:   2048,
+	}
+	boltztest.RequireCreate(ctx, edgeService)
+	boltztest.ValidateBaseline(ctx, edgeService)
+
+	edgeService.BandwidthLimits.EgressBurst = 8192
+	boltztest.RequireUpdate(ctx, edgeService)
+	boltztest.ValidateUpdated(ctx, edgeService)
+
+	edgeService.BandwidthLimits = nil
+	boltztest.RequireUpdate(ctx, edgeService)
+	boltztest.ValidateUpdated(ctx, edgeService)
+}
+
 func (ctx *TestContext) testDeleteEdgeServices(_ *testing.T) {
 	ctx.CleanupAll()
 	entities := ctx.createEdgeServiceTestEntities()
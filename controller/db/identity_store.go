@@ -17,6 +17,7 @@
 package db
 
 import (
+	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/sdk-golang/ziti"
@@ -61,8 +62,37 @@ const (
 	FieldIdentityExternalId                = "externalId"
 	FieldIdentityDisabledAt                = "disabledAt"
 	FieldIdentityDisabledUntil             = "disabledUntil"
+	FieldIdentityPermissions               = "permissions"
+
+	// IdentityPermissionAuditor grants read-only access to the whole management API
+	IdentityPermissionAuditor = "auditor"
+
+	// IdentityPermissionServiceOperator grants management of services, configs and terminators. It may be scoped to
+	// services with a given role attribute by appending the attribute, e.g. 'service-operator:web'
+	IdentityPermissionServiceOperator = "service-operator"
+
+	// IdentityPermissionEnrollmentOperator grants management of enrollments and authenticators
+	IdentityPermissionEnrollmentOperator = "enrollment-operator"
 )
 
+// ValidateIdentityPermissions checks that each permission is a known role, or a service operator scoped to a role
+// attribute
+func ValidateIdentityPermissions(permissions []string) error {
+	for _, permission := range permissions {
+		switch permission {
+		case IdentityPermissionAuditor, IdentityPermissionServiceOperator, IdentityPermissionEnrollmentOperator:
+			continue
+		}
+		if attr, found := strings.CutPrefix(permission, IdentityPermissionServiceOperator+":"); found && attr != "" {
+			continue
+		}
+		return errorz.NewFieldError(fmt.Sprintf("invalid permission, must be one of '%v', '%v', '%v' or '%v:<role attribute>'",
+			IdentityPermissionAuditor, IdentityPermissionServiceOperator, IdentityPermissionEnrollmentOperator,
+			IdentityPermissionServiceOperator), FieldIdentityPermissions, permission)
+	}
+	return nil
+}
+
 func newIdentity(name string, identityTypeId string, roleAttributes ...string) *Identity {
 	return &Identity{
 		BaseExtEntity:  boltz.BaseExtEntity{Id: eid.New()},
@@ -112,6 +142,8 @@ type Identity struct {
 	DisabledUntil             *time.Time                   `json:"disabledUntil"`
 	Disabled                  bool                         `json:"disabled"`
 	ServiceConfigs            map[string]map[string]string `json:"serviceConfigs"`
+	Permissions               []string                     `json:"permissions"`
+	BandwidthLimits           *BandwidthLimits             `json:"bandwidthLimits"`
 }
 
 func (entity *Identity) GetEntityType() string {
@@ -196,6 +228,7 @@ func (store *identityStoreImpl) initializeLocal() {
 	store.AddFkConstraint(store.symbolAuthPolicyId, true, boltz.CascadeNone)
 
 	store.AddSymbol(FieldIdentityIsAdmin, ast.NodeTypeBool)
+	store.AddSetSymbol(FieldIdentityPermissions, ast.NodeTypeString)
 	store.AddSymbol(FieldIdentityIsDefaultAdmin, ast.NodeTypeBool)
 
 	store.indexRoleAttributes.AddListener(store.rolesChanged)
@@ -224,6 +257,7 @@ func (store *identityStoreImpl) FillEntity(entity *Identity, bucket *boltz.Typed
 	entity.Authenticators = bucket.GetStringList(FieldIdentityAuthenticators)
 	entity.Enrollments = bucket.GetStringList(FieldIdentityEnrollments)
 	entity.RoleAttributes = bucket.GetStringList(FieldRoleAttributes)
+	entity.Permissions = bucket.GetStringList(FieldIdentityPermissions)
 	entity.DefaultHostingPrecedence = ziti.Precedence(bucket.GetInt32WithDefault(FieldIdentityDefaultHostingPrecedence, 0))
 	entity.DefaultHostingCost = uint16(bucket.GetInt32WithDefault(FieldIdentityDefaultHostingCost, 0))
 	entity.AppData = bucket.GetMap(FieldIdentityAppData)
@@ -267,6 +301,8 @@ func (store *identityStoreImpl) FillEntity(entity *Identity, bucket *boltz.Typed
 		entity.ServiceHostingCosts[k] = uint16(v.(int32))
 	}
 
+	entity.BandwidthLimits = loadBandwidthLimits(bucket)
+
 	store.fillServiceConfig(entity, bucket)
 }
 
@@ -315,6 +351,11 @@ func (store *identityStoreImpl) PersistEntity(entity *Identity, ctx *boltz.Persi
 	ctx.SetString(FieldIdentityAuthPolicyId, entity.AuthPolicyId)
 	store.validateRoleAttributes(entity.RoleAttributes, ctx.Bucket)
 	ctx.SetStringList(FieldRoleAttributes, entity.RoleAttributes)
+	if err := ValidateIdentityPermissions(entity.Permissions); err != nil {
+		ctx.Bucket.SetError(err)
+		return
+	}
+	ctx.SetStringList(FieldIdentityPermissions, entity.Permissions)
 	ctx.SetInt32(FieldIdentityDefaultHostingPrecedence, int32(entity.DefaultHostingPrecedence))
 	ctx.SetInt32(FieldIdentityDefaultHostingCost, int32(entity.DefaultHostingCost))
 	ctx.Bucket.PutMap(FieldIdentityAppData, entity.AppData, ctx.FieldChecker, false)
@@ -327,6 +368,7 @@ func (store *identityStoreImpl) PersistEntity(entity *Identity, ctx *boltz.Persi
 		entity.ExternalId = nil
 	}
 	ctx.SetStringP(FieldIdentityExternalId, entity.ExternalId)
+	persistBandwidthLimits(ctx, entity.BandwidthLimits)
 
 	if entity.EnvInfo != nil {
 		ctx.SetString(FieldIdentityEnvInfoArch, entity.EnvInfo.Arch)
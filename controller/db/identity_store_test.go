@@ -16,6 +16,27 @@ func Test_IdentityStore(t *testing.T) {
 	ctx.Init()
 
 	t.Run("test identity service configs", ctx.testIdentityServiceConfigs)
+	t.Run("test identity permissions", ctx.testIdentityPermissions)
+}
+
+func (ctx *TestContext) testIdentityPermissions(_ *testing.T) {
+	identity := newIdentity(eid.New(), "")
+	identity.Permissions = []string{IdentityPermissionAuditor, IdentityPermissionServiceOperator + ":web"}
+	boltztest.RequireCreate(ctx, identity)
+	boltztest.RequireReload(ctx, identity)
+	boltztest.ValidateBaseline(ctx, identity)
+
+	identity.Permissions = []string{IdentityPermissionEnrollmentOperator}
+	boltztest.RequireUpdate(ctx, identity)
+	boltztest.RequireReload(ctx, identity)
+	ctx.Equal([]string{IdentityPermissionEnrollmentOperator}, identity.Permissions)
+
+	identity.Permissions = []string{"super-admin"}
+	ctx.Error(boltztest.Update(ctx, identity))
+
+	invalid := newIdentity(eid.New(), "")
+	invalid.Permissions = []string{IdentityPermissionServiceOperator + ":"}
+	ctx.Error(boltztest.Create(ctx, invalid))
 }
 
 func (ctx *TestContext) testIdentityServiceConfigs(_ *testing.T) {
@@ -205,6 +205,86 @@ var healthCheckSchema = map[string]interface{}{
 				"expectInBody": map[string]interface{}{"type": "string"},
 			},
 		},
+		"tlsCheck": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required": []interface{}{
+				"interval",
+				"timeout",
+				"address",
+			},
+			"properties": map[string]interface{}{
+				"address":         map[string]interface{}{"type": "string"},
+				"serverName":      map[string]interface{}{"type": "string"},
+				"ca":              map[string]interface{}{"type": "string"},
+				"skipVerify":      map[string]interface{}{"type": "boolean"},
+				"expiryThreshold": map[string]interface{}{"$ref": "#/definitions/duration"},
+				"interval":        map[string]interface{}{"$ref": "#/definitions/duration"},
+				"timeout":         map[string]interface{}{"$ref": "#/definitions/duration"},
+				"actions":         map[string]interface{}{"$ref": "#/definitions/actionList"},
+			},
+		},
+		"grpcCheck": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required": []interface{}{
+				"interval",
+				"timeout",
+				"address",
+			},
+			"properties": map[string]interface{}{
+				"address":    map[string]interface{}{"type": "string"},
+				"service":    map[string]interface{}{"type": "string"},
+				"tls":        map[string]interface{}{"type": "boolean"},
+				"serverName": map[string]interface{}{"type": "string"},
+				"ca":         map[string]interface{}{"type": "string"},
+				"skipVerify": map[string]interface{}{"type": "boolean"},
+				"interval":   map[string]interface{}{"$ref": "#/definitions/duration"},
+				"timeout":    map[string]interface{}{"$ref": "#/definitions/duration"},
+				"actions":    map[string]interface{}{"$ref": "#/definitions/actionList"},
+			},
+		},
+		"dnsCheck": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required": []interface{}{
+				"interval",
+				"timeout",
+				"server",
+				"name",
+			},
+			"properties": map[string]interface{}{
+				"server":   map[string]interface{}{"type": "string"},
+				"name":     map[string]interface{}{"type": "string"},
+				"type":     map[string]interface{}{"type": "string"},
+				"expect":   map[string]interface{}{"type": "string"},
+				"interval": map[string]interface{}{"$ref": "#/definitions/duration"},
+				"timeout":  map[string]interface{}{"$ref": "#/definitions/duration"},
+				"actions":  map[string]interface{}{"$ref": "#/definitions/actionList"},
+			},
+		},
+		"execCheck": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required": []interface{}{
+				"interval",
+				"timeout",
+				"command",
+			},
+			"properties": map[string]interface{}{
+				"command": map[string]interface{}{
+					"type":        "string",
+					"description": "Absolute path of the command to run. Must be allowed by the hosting tunneler",
+				},
+				"args": map[string]interface{}{
+					"type":  "array",
+					"items": map[string]interface{}{"type": "string"},
+				},
+				"interval": map[string]interface{}{"$ref": "#/definitions/duration"},
+				"timeout":  map[string]interface{}{"$ref": "#/definitions/duration"},
+				"actions":  map[string]interface{}{"$ref": "#/definitions/actionList"},
+			},
+		},
 		"portCheckList": map[string]interface{}{
 			"type": "array",
 			"items": map[string]interface{}{
@@ -217,6 +297,30 @@ var healthCheckSchema = map[string]interface{}{
 				"$ref": "#/definitions/httpCheck",
 			},
 		},
+		"tlsCheckList": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"$ref": "#/definitions/tlsCheck",
+			},
+		},
+		"grpcCheckList": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"$ref": "#/definitions/grpcCheck",
+			},
+		},
+		"dnsCheckList": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"$ref": "#/definitions/dnsCheck",
+			},
+		},
+		"execCheckList": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"$ref": "#/definitions/execCheck",
+			},
+		},
 	},
 	"properties": map[string]interface{}{
 		"portChecks": map[string]interface{}{
@@ -225,6 +329,18 @@ var healthCheckSchema = map[string]interface{}{
 		"httpChecks": map[string]interface{}{
 			"$ref": "#/definitions/httpCheckList",
 		},
+		"tlsChecks": map[string]interface{}{
+			"$ref": "#/definitions/tlsCheckList",
+		},
+		"grpcChecks": map[string]interface{}{
+			"$ref": "#/definitions/grpcCheckList",
+		},
+		"dnsChecks": map[string]interface{}{
+			"$ref": "#/definitions/dnsCheckList",
+		},
+		"execChecks": map[string]interface{}{
+			"$ref": "#/definitions/execCheckList",
+		},
 	},
 }
 
@@ -271,7 +387,7 @@ var tunnelDefinitions = map[string]interface{}{
 	},
 	"proxyType": map[string]interface{}{
 		"type":        "string",
-		"enum":        []interface{}{"http"},
+		"enum":        []interface{}{"http", "socks5"},
 		"description": "supported proxy types",
 	},
 	"proxyConfiguration": map[string]interface{}{
@@ -289,6 +405,53 @@ var tunnelDefinitions = map[string]interface{}{
 				"type":        "string",
 				"description": "The address of the proxy in host:port format",
 			},
+			"username": map[string]interface{}{
+				"type":        "string",
+				"description": "The username used to authenticate to the proxy",
+			},
+			"password": map[string]interface{}{
+				"type":        "string",
+				"description": "The password used to authenticate to the proxy",
+			},
+		},
+	},
+	"tlsVerifyMode": map[string]interface{}{
+		"type":        "string",
+		"enum":        []interface{}{"full", "ca", "none"},
+		"description": "full verifies the server certificate chain and name, ca verifies only the chain and none skips verification",
+	},
+	"tlsConfiguration": map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"serverName": map[string]interface{}{
+				"type":        "string",
+				"description": "The server name sent via SNI and verified against the server certificate. Defaults to the dialed address",
+			},
+			"ca": map[string]interface{}{
+				"type":        "string",
+				"description": "PEM encoded CA certificates used to verify the server. Defaults to the system roots",
+			},
+			"caFile": map[string]interface{}{
+				"type":        "string",
+				"description": "Path to a PEM encoded CA bundle on the hosting tunneler used to verify the server",
+			},
+			"certFile": map[string]interface{}{
+				"type":        "string",
+				"description": "Path to a PEM encoded client certificate on the hosting tunneler",
+			},
+			"keyFile": map[string]interface{}{
+				"type":        "string",
+				"description": "Path to the PEM encoded private key for the client certificate on the hosting tunneler",
+			},
+			"verifyMode": map[string]interface{}{
+				"$ref":        "#/definitions/tlsVerifyMode",
+				"description": "How the server certificate is verified. Defaults to full",
+			},
+		},
+		"dependencies": map[string]interface{}{
+			"certFile": []interface{}{"keyFile"},
+			"keyFile":  []interface{}{"certFile"},
 		},
 	},
 }
@@ -396,6 +559,10 @@ var hostV1SchemaSansDefs = map[string]interface{}{
 				"$ref":        "#/definitions/proxyConfiguration",
 				"description": "If defined, outgoing connections will be send through this proxy server",
 			},
+			"tls": map[string]interface{}{
+				"$ref":        "#/definitions/tlsConfiguration",
+				"description": "If defined, outgoing tcp connections will be wrapped in TLS",
+			},
 		},
 	),
 	"additionalProperties": false,
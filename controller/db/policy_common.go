@@ -308,15 +308,52 @@ func UpdateRelatedRoles(ctx *roleAttributeChangeContext, entityId []byte, newRol
 }
 
 func evaluatePolicyAgainstEntity(ctx *roleAttributeChangeContext, semantic string, entityId, policyId []byte, ids, roles, roleAttributes []string, log *logrus.Entry) (bool, bool) {
-	if stringz.Contains(ids, string(entityId)) || stringz.Contains(roles, "all") ||
-		(strings.EqualFold(semantic, SemanticAllOf) && len(roles) > 0 && stringz.ContainsAll(roleAttributes, roles...)) ||
-		(strings.EqualFold(semantic, SemanticAnyOf) && len(roles) > 0 && stringz.ContainsAny(roleAttributes, roles...)) {
+	if isEntityMatchedByRoles(semantic, ids, roles, string(entityId), roleAttributes) {
 		return true, ProcessEntityPolicyMatched(ctx, entityId, policyId, log)
 	} else {
 		return false, ProcessEntityPolicyUnmatched(ctx, entityId, policyId, log)
 	}
 }
 
+func isEntityMatchedByRoles(semantic string, ids, roles []string, entityId string, roleAttributes []string) bool {
+	return stringz.Contains(ids, entityId) || stringz.Contains(roles, "all") ||
+		(strings.EqualFold(semantic, SemanticAllOf) && len(roles) > 0 && stringz.ContainsAll(roleAttributes, roles...)) ||
+		(strings.EqualFold(semantic, SemanticAnyOf) && len(roles) > 0 && stringz.ContainsAny(roleAttributes, roles...))
+}
+
+// RoleMatcher evaluates policy roles against entities outside of the store, using the same rules as policy
+// denormalization
+type RoleMatcher struct {
+	Semantic string
+	Roles    []string
+	Ids      []string
+}
+
+func NewRoleMatcher(semantic string, policyRoles []string) (*RoleMatcher, error) {
+	if semantic == "" {
+		semantic = SemanticAllOf
+	}
+
+	if !isSemanticValid(semantic) {
+		return nil, errorz.NewFieldError("invalid semantic", FieldSemantic, semantic)
+	}
+
+	roles, ids, err := splitRolesAndIds(policyRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RoleMatcher{
+		Semantic: semantic,
+		Roles:    roles,
+		Ids:      ids,
+	}, nil
+}
+
+func (self *RoleMatcher) IsMatch(entityId string, roleAttributes []string) bool {
+	return isEntityMatchedByRoles(self.Semantic, self.Ids, self.Roles, entityId, roleAttributes)
+}
+
 func ProcessEntityPolicyMatched(ctx *roleAttributeChangeContext, entityId, policyId []byte, log *logrus.Entry) bool {
 	// first add it to the denormalize link table from the policy to the entity (ex: service policy -> identity)
 	// If it's already there (in other words, this policy didn't change in relation to the entity,
@@ -0,0 +1,80 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/geoip"
+)
+
+const (
+	FieldPostureCheckSourceIpAllowedCidrs     = "allowedCidrs"
+	FieldPostureCheckSourceIpDeniedCidrs      = "deniedCidrs"
+	FieldPostureCheckSourceIpAllowedCountries = "allowedCountries"
+	FieldPostureCheckSourceIpDeniedCountries  = "deniedCountries"
+)
+
+type PostureCheckSourceIp struct {
+	AllowedCidrs     []string `json:"allowedCidrs"`
+	DeniedCidrs      []string `json:"deniedCidrs"`
+	AllowedCountries []string `json:"allowedCountries"`
+	DeniedCountries  []string `json:"deniedCountries"`
+}
+
+func newPostureCheckSourceIp() PostureCheckSubType {
+	return &PostureCheckSourceIp{}
+}
+
+func (entity *PostureCheckSourceIp) LoadValues(bucket *boltz.TypedBucket) {
+	entity.AllowedCidrs = bucket.GetStringList(FieldPostureCheckSourceIpAllowedCidrs)
+	entity.DeniedCidrs = bucket.GetStringList(FieldPostureCheckSourceIpDeniedCidrs)
+	entity.AllowedCountries = bucket.GetStringList(FieldPostureCheckSourceIpAllowedCountries)
+	entity.DeniedCountries = bucket.GetStringList(FieldPostureCheckSourceIpDeniedCountries)
+}
+
+func (entity *PostureCheckSourceIp) SetValues(ctx *boltz.PersistContext, bucket *boltz.TypedBucket) {
+	rules := &geoip.SourceIpRules{
+		AllowedCidrs:     entity.AllowedCidrs,
+		DeniedCidrs:      entity.DeniedCidrs,
+		AllowedCountries: entity.AllowedCountries,
+		DeniedCountries:  entity.DeniedCountries,
+	}
+	if err := rules.Validate(); err != nil {
+		ctx.Bucket.SetError(err)
+		return
+	}
+
+	entity.AllowedCidrs = normalizeSourceIpValues(entity.AllowedCidrs, geoip.NormalizeCidr)
+	entity.DeniedCidrs = normalizeSourceIpValues(entity.DeniedCidrs, geoip.NormalizeCidr)
+	entity.AllowedCountries = normalizeSourceIpValues(entity.AllowedCountries, geoip.NormalizeCountry)
+	entity.DeniedCountries = normalizeSourceIpValues(entity.DeniedCountries, geoip.NormalizeCountry)
+
+	bucket.SetStringList(FieldPostureCheckSourceIpAllowedCidrs, entity.AllowedCidrs, ctx.FieldChecker)
+	bucket.SetStringList(FieldPostureCheckSourceIpDeniedCidrs, entity.DeniedCidrs, ctx.FieldChecker)
+	bucket.SetStringList(FieldPostureCheckSourceIpAllowedCountries, entity.AllowedCountries, ctx.FieldChecker)
+	bucket.SetStringList(FieldPostureCheckSourceIpDeniedCountries, entity.DeniedCountries, ctx.FieldChecker)
+}
+
+func normalizeSourceIpValues(values []string, normalize func(string) (string, error)) []string {
+	var result []string
+	for _, value := range values {
+		if normalized, err := normalize(value); err == nil {
+			result = append(result, normalized)
+		}
+	}
+	return result
+}
@@ -39,6 +39,7 @@ const (
 	PostureCheckTypeProcessMulti = "PROCESS_MULTI"
 	PostureCheckTypeMAC          = "MAC"
 	PostureCheckTypeMFA          = "MFA"
+	PostureCheckTypeSourceIp     = "SOURCE_IP"
 )
 
 var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
@@ -48,6 +49,7 @@ var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
 	PostureCheckTypeProcessMulti: newPostureCheckProcessMulti,
 	PostureCheckTypeMAC:          newPostureCheckMacAddresses,
 	PostureCheckTypeMFA:          newPostureCheckMfa,
+	PostureCheckTypeSourceIp:     newPostureCheckSourceIp,
 }
 
 type newPostureCheckSubType func() PostureCheckSubType
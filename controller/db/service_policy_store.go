@@ -8,6 +8,7 @@ import (
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
 	"sort"
+	"time"
 )
 
 type PolicyType string
@@ -45,7 +46,15 @@ func GetPolicyTypeForId(policyTypeId int32) PolicyType {
 }
 
 const (
-	FieldServicePolicyType = "type"
+	FieldServicePolicyType       = "type"
+	FieldServicePolicyValidFrom  = "validFrom"
+	FieldServicePolicyValidUntil = "validUntil"
+	FieldServicePolicySchedules  = "schedules"
+	FieldServicePolicyScheduled  = "scheduled"
+
+	FieldServicePolicyScheduleCron     = "cron"
+	FieldServicePolicyScheduleDuration = "duration"
+	FieldServicePolicyScheduleTimezone = "timezone"
 
 	PolicyTypeInvalidName = "Invalid"
 	PolicyTypeDialName    = "Dial"
@@ -58,12 +67,26 @@ const (
 
 type ServicePolicy struct {
 	boltz.BaseExtEntity
-	PolicyType        PolicyType `json:"policyType"`
-	Name              string     `json:"name"`
-	Semantic          string     `json:"semantic"`
-	IdentityRoles     []string   `json:"identityRoles"`
-	ServiceRoles      []string   `json:"serviceRoles"`
-	PostureCheckRoles []string   `json:"postureCheckRoles"`
+	PolicyType        PolicyType               `json:"policyType"`
+	Name              string                   `json:"name"`
+	Semantic          string                   `json:"semantic"`
+	IdentityRoles     []string                 `json:"identityRoles"`
+	ServiceRoles      []string                 `json:"serviceRoles"`
+	PostureCheckRoles []string                 `json:"postureCheckRoles"`
+	ValidFrom         *time.Time               `json:"validFrom"`
+	ValidUntil        *time.Time               `json:"validUntil"`
+	Schedules         []*ServicePolicySchedule `json:"schedules"`
+}
+
+// IsScheduled returns true if the policy is only in effect for some period of time
+func (entity *ServicePolicy) IsScheduled() bool {
+	return entity.ValidFrom != nil || entity.ValidUntil != nil || len(entity.Schedules) > 0
+}
+
+type ServicePolicySchedule struct {
+	Cron     string        `json:"cron"`
+	Duration time.Duration `json:"duration"`
+	Timezone string        `json:"timezone"`
 }
 
 func (entity *ServicePolicy) GetName() string {
@@ -100,6 +123,7 @@ type servicePolicyStoreImpl struct {
 	indexName        boltz.ReadIndex
 	symbolPolicyType boltz.EntitySymbol
 	symbolSemantic   boltz.EntitySymbol
+	symbolScheduled  boltz.EntitySymbol
 
 	symbolIdentityRoles     boltz.EntitySetSymbol
 	symbolServiceRoles      boltz.EntitySetSymbol
@@ -128,6 +152,9 @@ func (store *servicePolicyStoreImpl) initializeLocal() {
 	store.indexName = store.addUniqueNameField()
 	store.symbolPolicyType = store.AddSymbol(FieldServicePolicyType, ast.NodeTypeInt64)
 	store.symbolSemantic = store.AddSymbol(FieldSemantic, ast.NodeTypeString)
+	store.symbolScheduled = store.AddSymbol(FieldServicePolicyScheduled, ast.NodeTypeBool)
+	store.AddSymbol(FieldServicePolicyValidFrom, ast.NodeTypeDatetime)
+	store.AddSymbol(FieldServicePolicyValidUntil, ast.NodeTypeDatetime)
 
 	store.symbolIdentityRoles = store.AddPublicSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
 	store.symbolServiceRoles = store.AddPublicSetSymbol(FieldServiceRoles, ast.NodeTypeString)
@@ -152,6 +179,22 @@ func (store *servicePolicyStoreImpl) FillEntity(entity *ServicePolicy, bucket *b
 	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
 	entity.ServiceRoles = bucket.GetStringList(FieldServiceRoles)
 	entity.PostureCheckRoles = bucket.GetStringList(FieldPostureCheckRoles)
+	entity.ValidFrom = bucket.GetTime(FieldServicePolicyValidFrom)
+	entity.ValidUntil = bucket.GetTime(FieldServicePolicyValidUntil)
+	entity.Schedules = nil
+
+	if schedulesBucket := bucket.GetBucket(FieldServicePolicySchedules); schedulesBucket != nil {
+		cursor := schedulesBucket.Cursor()
+		for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+			if scheduleBucket := schedulesBucket.GetBucket(string(key)); scheduleBucket != nil {
+				entity.Schedules = append(entity.Schedules, &ServicePolicySchedule{
+					Cron:     scheduleBucket.GetStringOrError(FieldServicePolicyScheduleCron),
+					Duration: time.Duration(scheduleBucket.GetInt64WithDefault(FieldServicePolicyScheduleDuration, 0)),
+					Timezone: scheduleBucket.GetStringWithDefault(FieldServicePolicyScheduleTimezone, ""),
+				})
+			}
+		}
+	}
 }
 
 func (store *servicePolicyStoreImpl) PersistEntity(entity *ServicePolicy, ctx *boltz.PersistContext) {
@@ -187,6 +230,35 @@ func (store *servicePolicyStoreImpl) PersistEntity(entity *ServicePolicy, ctx *b
 	ctx.SetRequiredString(FieldName, entity.Name)
 	ctx.SetInt32(FieldServicePolicyType, entity.PolicyType.Id())
 	ctx.SetRequiredString(FieldSemantic, entity.Semantic)
+	ctx.SetTimeP(FieldServicePolicyValidFrom, entity.ValidFrom)
+	ctx.SetTimeP(FieldServicePolicyValidUntil, entity.ValidUntil)
+
+	if ctx.ProceedWithSet(FieldServicePolicySchedules) {
+		schedulesBucket, err := ctx.Bucket.EmptyBucket(FieldServicePolicySchedules)
+		if err != nil {
+			ctx.Bucket.SetError(err)
+			return
+		}
+		for i, schedule := range entity.Schedules {
+			if schedule == nil {
+				continue
+			}
+			// zero pad, so windows are loaded back in the order they were given
+			scheduleBucket := schedulesBucket.GetOrCreateBucket(fmt.Sprintf("%04d", i))
+			scheduleBucket.SetString(FieldServicePolicyScheduleCron, schedule.Cron, nil)
+			scheduleBucket.SetInt64(FieldServicePolicyScheduleDuration, int64(schedule.Duration), nil)
+			scheduleBucket.SetString(FieldServicePolicyScheduleTimezone, schedule.Timezone, nil)
+		}
+	}
+
+	// tracked separately so policy evaluation can cheaply tell if any scheduled policies exist
+	if ctx.ProceedWithSet(FieldServicePolicyValidFrom) || ctx.ProceedWithSet(FieldServicePolicyValidUntil) || ctx.ProceedWithSet(FieldServicePolicySchedules) {
+		scheduled := ctx.Bucket.GetTime(FieldServicePolicyValidFrom) != nil ||
+			ctx.Bucket.GetTime(FieldServicePolicyValidUntil) != nil ||
+			hasEntries(ctx.Bucket.GetBucket(FieldServicePolicySchedules))
+		ctx.Bucket.SetBool(FieldServicePolicyScheduled, scheduled, nil)
+	}
+
 	servicePolicyStore := ctx.Store.(*servicePolicyStoreImpl)
 
 	sort.Strings(entity.ServiceRoles)
@@ -363,3 +435,11 @@ func (store *servicePolicyStoreImpl) CheckIntegrity(mutateCtx boltz.MutateContex
 
 	return store.BaseStore.CheckIntegrity(mutateCtx, fix, errorSink)
 }
+
+func hasEntries(bucket *boltz.TypedBucket) bool {
+	if bucket == nil {
+		return false
+	}
+	key, _ := bucket.Cursor().First()
+	return key != nil
+}
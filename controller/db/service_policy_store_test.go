@@ -11,6 +11,7 @@ import (
 	"math/rand"
 	"sort"
 	"testing"
+	"time"
 )
 
 func Test_ServicePolicyStore(t *testing.T) {
@@ -19,6 +20,7 @@ func Test_ServicePolicyStore(t *testing.T) {
 	ctx.Init()
 
 	t.Run("test create service policies", ctx.testCreateServicePolicy)
+	t.Run("test service policy schedules", ctx.testServicePolicySchedules)
 	t.Run("test create/update service policies with invalid entity refs", ctx.testServicePolicyInvalidValues)
 	t.Run("test service policy evaluation", ctx.testServicePolicyRoleEvaluation)
 	t.Run("test update/delete referenced entities", ctx.testServicePolicyUpdateDeleteRefs)
@@ -59,6 +61,58 @@ func (ctx *TestContext) testCreateServicePolicy(_ *testing.T) {
 	ctx.NoError(err)
 }
 
+func (ctx *TestContext) testServicePolicySchedules(_ *testing.T) {
+	ctx.CleanupAll()
+
+	validFrom := time.Now().UTC().Truncate(time.Second)
+	validUntil := validFrom.Add(24 * time.Hour)
+
+	policy := newServicePolicy(eid.New())
+	policy.ValidFrom = &validFrom
+	policy.ValidUntil = &validUntil
+	for i := 0; i < 12; i++ {
+		policy.Schedules = append(policy.Schedules, &ServicePolicySchedule{
+			Cron:     fmt.Sprintf("%d 9 * * MON-FRI", i),
+			Duration: time.Duration(i+1) * time.Hour,
+			Timezone: "America/New_York",
+		})
+	}
+	boltztest.RequireCreate(ctx, policy)
+
+	isScheduled := func(tx *bbolt.Tx) bool {
+		scheduled := boltz.FieldToBool(ctx.stores.ServicePolicy.GetSymbol(FieldServicePolicyScheduled).Eval(tx, []byte(policy.Id)))
+		return scheduled != nil && *scheduled
+	}
+
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		testPolicy, err := ctx.stores.ServicePolicy.LoadById(tx, policy.Id)
+		ctx.NoError(err)
+		ctx.True(validFrom.Equal(*testPolicy.ValidFrom))
+		ctx.True(validUntil.Equal(*testPolicy.ValidUntil))
+		ctx.Equal(policy.Schedules, testPolicy.Schedules)
+		ctx.True(testPolicy.IsScheduled())
+		ctx.True(isScheduled(tx))
+		return nil
+	})
+	ctx.NoError(err)
+
+	policy.ValidFrom = nil
+	policy.ValidUntil = nil
+	policy.Schedules = nil
+	boltztest.RequireUpdate(ctx, policy)
+
+	err = ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		testPolicy, err := ctx.stores.ServicePolicy.LoadById(tx, policy.Id)
+		ctx.NoError(err)
+		ctx.Nil(testPolicy.ValidFrom)
+		ctx.Nil(testPolicy.ValidUntil)
+		ctx.Empty(testPolicy.Schedules)
+		ctx.False(isScheduled(tx))
+		return nil
+	})
+	ctx.NoError(err)
+}
+
 func (ctx *TestContext) testServicePolicyInvalidValues(_ *testing.T) {
 	ctx.CleanupAll()
 
@@ -17,8 +17,11 @@
 package db
 
 import (
+	"fmt"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/compression"
 	"github.com/openziti/ziti/controller/xt"
 	"github.com/openziti/ziti/controller/xt_smartrouting"
 	"go.etcd.io/bbolt"
@@ -29,6 +32,26 @@ const (
 	EntityTypeServices             = "services"
 	FieldServiceTerminatorStrategy = "terminatorStrategy"
 	FieldServiceMaxIdleTime        = "maxIdleTime"
+	FieldServiceMultipath          = "multipath"
+	FieldServicePriority           = "priority"
+	FieldServiceCompression        = "compression"
+
+	// ServiceMultipathDuplicate routes circuits for the service over two link-disjoint paths, sending every payload
+	// down both and discarding whichever copy arrives second
+	ServiceMultipathDuplicate = "duplicate"
+
+	// ServiceMultipathFailover routes circuits for the service over two link-disjoint paths, only using the alternate
+	// path when forwarding over the primary fails
+	ServiceMultipathFailover = "failover"
+
+	// ServicePriorityInteractive schedules traffic for the service's circuits ahead of default and bulk traffic on links
+	ServicePriorityInteractive = "interactive"
+
+	// ServicePriorityDefault is the priority class used by services which don't set one
+	ServicePriorityDefault = "default"
+
+	// ServicePriorityBulk schedules traffic for the service's circuits after interactive and default traffic on links
+	ServicePriorityBulk = "bulk"
 )
 
 type Service struct {
@@ -36,6 +59,9 @@ type Service struct {
 	Name               string        `json:"name"`
 	MaxIdleTime        time.Duration `json:"maxIdleTime"`
 	TerminatorStrategy string        `json:"terminatorStrategy"`
+	Multipath          string        `json:"multipath"`
+	Priority           string        `json:"priority"`
+	Compression        string        `json:"compression"`
 }
 
 func (entity *Service) GetEntityType() string {
@@ -76,6 +102,9 @@ func (store *serviceStoreImpl) initializeLocal() {
 	store.indexName = store.AddUniqueIndex(symbolName)
 
 	store.AddSymbol(FieldServiceTerminatorStrategy, ast.NodeTypeString)
+	store.AddSymbol(FieldServiceMultipath, ast.NodeTypeString)
+	store.AddSymbol(FieldServicePriority, ast.NodeTypeString)
+	store.AddSymbol(FieldServiceCompression, ast.NodeTypeString)
 	store.terminatorsSymbol = store.AddFkSetSymbol(EntityTypeTerminators, store.stores.terminator)
 }
 
@@ -95,6 +124,9 @@ func (store *serviceStoreImpl) FillEntity(entity *Service, bucket *boltz.TypedBu
 	entity.Name = bucket.GetStringOrError(FieldName)
 	entity.TerminatorStrategy = bucket.GetStringWithDefault(FieldServiceTerminatorStrategy, "")
 	entity.MaxIdleTime = time.Duration(bucket.GetInt64WithDefault(FieldServiceMaxIdleTime, 0))
+	entity.Multipath = bucket.GetStringWithDefault(FieldServiceMultipath, "")
+	entity.Priority = bucket.GetStringWithDefault(FieldServicePriority, "")
+	entity.Compression = bucket.GetStringWithDefault(FieldServiceCompression, "")
 }
 
 func (store *serviceStoreImpl) PersistEntity(entity *Service, ctx *boltz.PersistContext) {
@@ -102,6 +134,26 @@ func (store *serviceStoreImpl) PersistEntity(entity *Service, ctx *boltz.Persist
 	ctx.SetString(FieldName, entity.Name)
 	ctx.SetInt64(FieldServiceMaxIdleTime, int64(entity.MaxIdleTime))
 
+	if entity.Multipath != "" && entity.Multipath != ServiceMultipathDuplicate && entity.Multipath != ServiceMultipathFailover {
+		ctx.Bucket.SetError(errorz.NewFieldError(fmt.Sprintf("invalid multipath mode, must be one of '%v' or '%v'",
+			ServiceMultipathDuplicate, ServiceMultipathFailover), FieldServiceMultipath, entity.Multipath))
+		return
+	}
+	ctx.SetString(FieldServiceMultipath, entity.Multipath)
+
+	if entity.Priority != "" && entity.Priority != ServicePriorityInteractive && entity.Priority != ServicePriorityDefault && entity.Priority != ServicePriorityBulk {
+		ctx.Bucket.SetError(errorz.NewFieldError(fmt.Sprintf("invalid priority, must be one of '%v', '%v' or '%v'",
+			ServicePriorityInteractive, ServicePriorityDefault, ServicePriorityBulk), FieldServicePriority, entity.Priority))
+		return
+	}
+	ctx.SetString(FieldServicePriority, entity.Priority)
+
+	if err := compression.Validate(entity.Compression); err != nil {
+		ctx.Bucket.SetError(errorz.NewFieldError(err.Error(), FieldServiceCompression, entity.Compression))
+		return
+	}
+	ctx.SetString(FieldServiceCompression, entity.Compression)
+
 	if entity.TerminatorStrategy == "" {
 		entity.TerminatorStrategy = xt_smartrouting.Name
 	}
@@ -62,6 +62,14 @@ func (ctx *TestContext) testCreateInvalidServices(t *testing.T) {
 	service.TerminatorStrategy = uuid.New().String()
 	err = boltztest.Create(ctx, service)
 	ctx.EqualError(err, fmt.Sprintf("terminatorStrategy with name %v not found", service.TerminatorStrategy))
+
+	service.TerminatorStrategy = ""
+	service.Priority = "urgent"
+	ctx.Error(boltztest.Create(ctx, service))
+
+	service.Priority = ""
+	service.Compression = "gzip"
+	ctx.Error(boltztest.Create(ctx, service))
 }
 
 func (ctx *TestContext) testCreateServices(t *testing.T) {
@@ -74,6 +82,15 @@ func (ctx *TestContext) testCreateServices(t *testing.T) {
 	}
 	boltztest.RequireCreate(ctx, service)
 	boltztest.ValidateBaseline(ctx, service)
+
+	service = &Service{
+		BaseExtEntity: boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:          uuid.New().String(),
+		Priority:      ServicePriorityInteractive,
+		Compression:   "zstd",
+	}
+	boltztest.RequireCreate(ctx, service)
+	boltztest.ValidateBaseline(ctx, service)
 }
 
 type serviceTestEntities struct {
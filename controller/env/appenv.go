@@ -49,6 +49,7 @@ import (
 	"github.com/openziti/ziti/common"
 	"github.com/openziti/ziti/common/cert"
 	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/common/geoip"
 	"github.com/openziti/ziti/controller/api"
 	"github.com/openziti/ziti/controller/command"
 	"github.com/openziti/ziti/controller/config"
@@ -487,6 +488,7 @@ func (ae *AppEnv) ProcessZtSession(rc *response.RequestContext, ztSession string
 			rc.ActivePermissions = append(rc.ActivePermissions, permissions.PartiallyAuthenticatePermission)
 		} else {
 			rc.ActivePermissions = append(rc.ActivePermissions, permissions.AuthenticatedPermission)
+			rc.ActivePermissions = append(rc.ActivePermissions, rc.Identity.Permissions...)
 		}
 
 		if rc.Identity.IsAdmin || rc.Identity.IsDefaultAdmin {
@@ -564,6 +566,7 @@ func (ae *AppEnv) ProcessJwt(rc *response.RequestContext, token *jwt.Token) erro
 	}
 
 	rc.ActivePermissions = append(rc.ActivePermissions, permissions.AuthenticatedPermission)
+	rc.ActivePermissions = append(rc.ActivePermissions, rc.Identity.Permissions...)
 
 	if rc.Identity.IsAdmin || rc.Identity.IsDefaultAdmin {
 		rc.ActivePermissions = append(rc.ActivePermissions, permissions.AdminPermission)
@@ -752,6 +755,10 @@ func NewAppEnv(host HostController) (*AppEnv, error) {
 		ae.ApiClientCsrSigner = cert.NewClientSigner(enrollmentCert.Leaf, enrollmentCert.PrivateKey)
 		ae.ApiServerCsrSigner = cert.NewServerSigner(enrollmentCert.Leaf, enrollmentCert.PrivateKey)
 		ae.ControlClientCsrSigner = cert.NewClientSigner(enrollmentCert.Leaf, enrollmentCert.PrivateKey)
+
+		if err := geoip.Configure(host.GetConfig().Edge.GeoIpDatabase); err != nil {
+			return nil, err
+		}
 	}
 
 	ae.FingerprintGenerator = cert.NewFingerprintGenerator()
@@ -16,6 +16,8 @@
 
 package env
 
+import "net/http"
+
 var routerFuncs []AddRouterFunc
 
 type AddRouterFunc func(ae *AppEnv)
@@ -31,3 +33,21 @@ func AddRouter(rf ApiRouter) {
 func GetRouters() []AddRouterFunc {
 	return routerFuncs
 }
+
+// ManagementApiMiddleware wraps edge management API operations. It runs after the request is routed to an operation
+// and before the operation binds its parameters, so it can handle bodies the published API model can't express.
+type ManagementApiMiddleware func(ae *AppEnv, next http.Handler) http.Handler
+
+var managementApiMiddleware []ManagementApiMiddleware
+
+func AddManagementApiMiddleware(m ManagementApiMiddleware) {
+	managementApiMiddleware = append(managementApiMiddleware, m)
+}
+
+// WrapManagementApiOperations applies all registered management API middleware to the given operation handler
+func WrapManagementApiOperations(ae *AppEnv, next http.Handler) http.Handler {
+	for _, m := range managementApiMiddleware {
+		next = m(ae, next)
+	}
+	return next
+}
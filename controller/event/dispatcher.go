@@ -53,6 +53,13 @@ type FormattedEventSink interface {
 	AcceptFormattedEvent(eventType string, formattedEvent []byte)
 }
 
+// A KeyedFormattedEventSink accepts formatted events along with a key identifying the entity the event
+// pertains to, such as a circuit or identity id. Sinks can use the key to preserve per-entity ordering
+type KeyedFormattedEventSink interface {
+	FormattedEventSink
+	AcceptKeyedFormattedEvent(eventType string, key string, formattedEvent []byte)
+}
+
 // A FormatterFactory returns a formatter which will send events to the given FormattedEventSink
 type FormatterFactory interface {
 	NewFormatter(sink FormattedEventSink) io.Closer
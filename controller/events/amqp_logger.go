@@ -98,7 +98,7 @@ func (wc *amqpWriteCloser) sendMessage(message []byte) {
 		default:
 		}
 		err := wc.ch.PublishWithContext(context.Background(), "", wc.queue.Name, false, false, amqp.Publishing{
-			ContentType: "application/json",
+			ContentType: wc.config.contentType,
 			Body:        message,
 		})
 		if err == nil {
@@ -167,13 +167,14 @@ func (wc amqpWriteCloser) Close() error {
 }
 
 type amqpConfig struct {
-	url        string
-	queueName  string
-	durable    bool
-	autoDelete bool
-	exclusive  bool
-	noWait     bool
-	bufferSize int
+	url         string
+	queueName   string
+	durable     bool
+	autoDelete  bool
+	exclusive   bool
+	noWait      bool
+	bufferSize  int
+	contentType string
 }
 
 func parseAMQPConfig(config map[interface{}]interface{}) (*amqpConfig, error) {
@@ -245,6 +246,7 @@ func NewAMQPEventLogger(formatterFactory LoggingHandlerFactory, config map[inter
 
 	if value, found := config["format"]; found {
 		if format, ok := value.(string); ok {
+			conf.contentType = eventFormatContentType(format)
 			return formatterFactory.NewLoggingHandler(format, bufferSize, newAMQPWriteCloser(conf))
 		}
 		return nil, errors.New("invalid 'format' for event amqp log")
@@ -68,13 +68,23 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 	result.RegisterEventTypeFunctions(event.EntityCountEventNS, result.registerEntityCountEventHandler, result.unregisterEntityCountEventHandler)
 	result.RegisterEventTypeFunctions(event.SessionEventNS, result.registerSessionEventHandler, result.unregisterSessionEventHandler)
 
-	result.RegisterFormatterFactory("json", event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
+	result.RegisterFormatterFactory(FormatJson, event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
 		return NewJsonFormatter(16, sink)
 	}))
 
+	for format := range eventEncoders {
+		encoderFactory := eventEncoders[format]
+		result.RegisterFormatterFactory(format, event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
+			return NewEncodingFormatter(16, sink, encoderFactory())
+		}))
+	}
+
 	result.RegisterEventHandlerFactory("file", FileEventLoggerFactory{})
 	result.RegisterEventHandlerFactory("stdout", StdOutLoggerFactory{})
 	result.RegisterEventHandlerFactory("amqp", AMQPEventLoggerFactory{})
+	result.RegisterEventHandlerFactory("http", HTTPEventLoggerFactory{})
+	result.RegisterEventHandlerFactory("kafka", KafkaEventLoggerFactory{})
+	result.RegisterEventHandlerFactory("otlp", OtlpEventLoggerFactory{})
 
 	return result
 }
@@ -185,6 +195,10 @@ events:
       - type: fabric.circuits
         include:
           - created
+        filter: "serviceId = 'x' and clientId in ('a', 'b')"
+      - type: fabric.usage
+        version: 3
+        filter: "usage.ingress.tx > 1MB"
       - type: edge.sessions
         include:
           - created
@@ -100,6 +100,18 @@ func (self *Dispatcher) registerApiSessionEventHandler(val interface{}, config m
 		}
 	}
 
+	filter, err := parseEventFilter[event.ApiSessionEvent](event.ApiSessionEventNS, config)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &apiSessionEventFilterHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	if len(includeList) == 0 || (len(includeList) == 2 && stringz.ContainsAll(includeList, event.ApiSessionEventTypeCreated, event.ApiSessionEventTypeDeleted)) {
 		self.AddApiSessionEventHandler(handler)
 	} else {
@@ -144,3 +156,24 @@ func (self *apiSessionEventAdapter) IsWrapping(value event.ApiSessionEventHandle
 	}
 	return false
 }
+
+type apiSessionEventFilterHandler struct {
+	filter  *eventFilter
+	wrapped event.ApiSessionEventHandler
+}
+
+func (self *apiSessionEventFilterHandler) IsWrapping(value event.ApiSessionEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ApiSessionEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *apiSessionEventFilterHandler) AcceptApiSessionEvent(event *event.ApiSessionEvent) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptApiSessionEvent(event)
+	}
+}
@@ -67,6 +67,18 @@ func (self *Dispatcher) registerCircuitEventHandler(val interface{}, config map[
 		}
 	}
 
+	filter, err := parseEventFilter[event.CircuitEvent](event.CircuitEventsNs, config)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &circuitEventFilterHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	if len(includeList) == 0 {
 		self.AddCircuitEventHandler(handler)
 		return nil
@@ -120,3 +132,24 @@ func (self *filteredCircuitEventHandler) AcceptCircuitEvent(event *event.Circuit
 		self.wrapped.AcceptCircuitEvent(event)
 	}
 }
+
+type circuitEventFilterHandler struct {
+	filter  *eventFilter
+	wrapped event.CircuitEventHandler
+}
+
+func (self *circuitEventFilterHandler) IsWrapping(value event.CircuitEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.CircuitEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *circuitEventFilterHandler) AcceptCircuitEvent(event *event.CircuitEvent) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptCircuitEvent(event)
+	}
+}
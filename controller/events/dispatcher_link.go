@@ -27,7 +27,15 @@ func (self *Dispatcher) AddLinkEventHandler(handler event.LinkEventHandler) {
 }
 
 func (self *Dispatcher) RemoveLinkEventHandler(handler event.LinkEventHandler) {
-	self.linkEventHandlers.Delete(handler)
+	self.linkEventHandlers.DeleteIf(func(val event.LinkEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.LinkEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptLinkEvent(event *event.LinkEvent) {
@@ -38,14 +46,26 @@ func (self *Dispatcher) AcceptLinkEvent(event *event.LinkEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerLinkEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerLinkEventHandler(val interface{}, options map[string]interface{}) error {
 	handler, ok := val.(event.LinkEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/LinkEventHandler interface.", reflect.TypeOf(val))
 	}
 
-	self.linkEventHandlers.Append(handler)
+	filter, err := parseEventFilter[event.LinkEvent](event.LinkEventsNs, options)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &linkEventFilterHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
+	self.AddLinkEventHandler(handler)
 
 	return nil
 }
@@ -55,3 +75,24 @@ func (self *Dispatcher) unregisterLinkEventHandler(val interface{}) {
 		self.RemoveLinkEventHandler(handler)
 	}
 }
+
+type linkEventFilterHandler struct {
+	filter  *eventFilter
+	wrapped event.LinkEventHandler
+}
+
+func (self *linkEventFilterHandler) IsWrapping(value event.LinkEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.LinkEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *linkEventFilterHandler) AcceptLinkEvent(event *event.LinkEvent) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptLinkEvent(event)
+	}
+}
@@ -30,7 +30,15 @@ func (self *Dispatcher) AddRouterEventHandler(handler event.RouterEventHandler)
 }
 
 func (self *Dispatcher) RemoveRouterEventHandler(handler event.RouterEventHandler) {
-	self.routerEventHandlers.Delete(handler)
+	self.routerEventHandlers.DeleteIf(func(val event.RouterEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.RouterEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptRouterEvent(event *event.RouterEvent) {
@@ -48,13 +56,25 @@ func (self *Dispatcher) initRouterEvents(n *network.Network) {
 	n.AddRouterPresenceHandler(routerEvtAdapter)
 }
 
-func (self *Dispatcher) registerRouterEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerRouterEventHandler(val interface{}, options map[string]interface{}) error {
 	handler, ok := val.(event.RouterEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/RouterEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := parseEventFilter[event.RouterEvent](event.RouterEventsNs, options)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &routerEventFilterHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	self.AddRouterEventHandler(handler)
 
 	return nil
@@ -90,3 +110,24 @@ func (self *routerEventAdapter) routerChange(eventType event.RouterEventType, r
 
 	self.Dispatcher.AcceptRouterEvent(evt)
 }
+
+type routerEventFilterHandler struct {
+	filter  *eventFilter
+	wrapped event.RouterEventHandler
+}
+
+func (self *routerEventFilterHandler) IsWrapping(value event.RouterEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.RouterEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *routerEventFilterHandler) AcceptRouterEvent(event *event.RouterEvent) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptRouterEvent(event)
+	}
+}
@@ -30,7 +30,15 @@ func (self *Dispatcher) AddServiceEventHandler(handler event.ServiceEventHandler
 }
 
 func (self *Dispatcher) RemoveServiceEventHandler(handler event.ServiceEventHandler) {
-	self.serviceEventHandlers.Delete(handler)
+	self.serviceEventHandlers.DeleteIf(func(val event.ServiceEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.ServiceEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptServiceEvent(event *event.ServiceEvent) {
@@ -41,12 +49,24 @@ func (self *Dispatcher) AcceptServiceEvent(event *event.ServiceEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerServiceEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerServiceEventHandler(val interface{}, options map[string]interface{}) error {
 	handler, ok := val.(event.ServiceEventHandler)
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/edge/event/ServiceEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := parseEventFilter[event.ServiceEvent](event.ServiceEventsNs, options)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &serviceEventFilterHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	self.AddServiceEventHandler(handler)
 	return nil
 }
@@ -93,3 +113,24 @@ func (self *serviceEventAdapter) AcceptMetrics(message *metrics_pb.MetricsMessag
 		}
 	}
 }
+
+type serviceEventFilterHandler struct {
+	filter  *eventFilter
+	wrapped event.ServiceEventHandler
+}
+
+func (self *serviceEventFilterHandler) IsWrapping(value event.ServiceEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ServiceEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *serviceEventFilterHandler) AcceptServiceEvent(event *event.ServiceEvent) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptServiceEvent(event)
+	}
+}
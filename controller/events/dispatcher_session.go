@@ -103,6 +103,18 @@ func (self *Dispatcher) registerSessionEventHandler(val interface{}, config map[
 		}
 	}
 
+	filter, err := parseEventFilter[event.SessionEvent](event.SessionEventNS, config)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &sessionEventFilterHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	if len(includeList) == 0 || (len(includeList) == 2 && stringz.ContainsAll(includeList, event.SessionEventTypeCreated, event.SessionEventTypeDeleted)) {
 		self.AddSessionEventHandler(handler)
 	} else {
@@ -147,3 +159,24 @@ func (self *sessionEventAdapter) IsWrapping(value event.SessionEventHandler) boo
 	}
 	return false
 }
+
+type sessionEventFilterHandler struct {
+	filter  *eventFilter
+	wrapped event.SessionEventHandler
+}
+
+func (self *sessionEventFilterHandler) IsWrapping(value event.SessionEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.SessionEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *sessionEventFilterHandler) AcceptSessionEvent(event *event.SessionEvent) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptSessionEvent(event)
+	}
+}
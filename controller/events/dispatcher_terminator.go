@@ -73,6 +73,18 @@ func (self *Dispatcher) registerTerminatorEventHandler(val interface{}, options
 		}
 	}
 
+	filter, err := parseEventFilter[event.TerminatorEvent](event.TerminatorEventsNs, options)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &terminatorEventFilterHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	if propagateAlways {
 		self.AddTerminatorEventHandler(handler)
 	} else {
@@ -221,3 +233,24 @@ func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.Termin
 
 	self.Dispatcher.AcceptTerminatorEvent(evt)
 }
+
+type terminatorEventFilterHandler struct {
+	filter  *eventFilter
+	wrapped event.TerminatorEventHandler
+}
+
+func (self *terminatorEventFilterHandler) IsWrapping(value event.TerminatorEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.TerminatorEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *terminatorEventFilterHandler) AcceptTerminatorEvent(event *event.TerminatorEvent) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptTerminatorEvent(event)
+	}
+}
@@ -29,7 +29,15 @@ func (self *Dispatcher) AddUsageEventHandler(handler event.UsageEventHandler) {
 }
 
 func (self *Dispatcher) RemoveUsageEventHandler(handler event.UsageEventHandler) {
-	self.usageEventHandlers.Delete(handler)
+	self.usageEventHandlers.DeleteIf(func(val event.UsageEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.UsageEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AddUsageEventV3Handler(handler event.UsageEventV3Handler) {
@@ -83,6 +91,19 @@ func (self *Dispatcher) registerUsageEventHandler(val interface{}, config map[st
 		if !ok {
 			return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/UsageEventHandler interface.", reflect.TypeOf(val))
 		}
+
+		filter, err := parseEventFilter[event.UsageEvent](event.UsageEventsNs, config)
+		if err != nil {
+			return err
+		}
+
+		if filter != nil {
+			handler = &usageEventFilterHandler{
+				filter:  filter,
+				wrapped: handler,
+			}
+		}
+
 		self.AddUsageEventHandler(handler)
 	} else if version == 3 {
 		handler, ok := val.(event.UsageEventV3Handler)
@@ -114,6 +135,19 @@ func (self *Dispatcher) registerUsageEventHandler(val interface{}, config map[st
 			}
 		}
 
+		// the filter is applied to the whole event, before any usage types are removed by the include list
+		filter, err := parseEventFilter[event.UsageEventV3](event.UsageEventsNs, config)
+		if err != nil {
+			return err
+		}
+
+		if filter != nil {
+			handler = &usageEventV3FilterHandler{
+				filter:  filter,
+				wrapped: handler,
+			}
+		}
+
 		self.AddUsageEventV3Handler(handler)
 	} else {
 		return errors.Errorf("unsupported usage version: %v", version)
@@ -259,3 +293,45 @@ func (self *filteredUsageV3EventHandler) AcceptUsageEventV3(event *event.UsageEv
 	newEvent.Usage = usage
 	self.wrapped.AcceptUsageEventV3(&newEvent)
 }
+
+type usageEventFilterHandler struct {
+	filter  *eventFilter
+	wrapped event.UsageEventHandler
+}
+
+func (self *usageEventFilterHandler) IsWrapping(value event.UsageEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.UsageEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *usageEventFilterHandler) AcceptUsageEvent(event *event.UsageEvent) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptUsageEvent(event)
+	}
+}
+
+type usageEventV3FilterHandler struct {
+	filter  *eventFilter
+	wrapped event.UsageEventV3Handler
+}
+
+func (self *usageEventV3FilterHandler) IsWrapping(value event.UsageEventV3Handler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.UsageEventV3HandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *usageEventV3FilterHandler) AcceptUsageEventV3(event *event.UsageEventV3) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptUsageEventV3(event)
+	}
+}
@@ -0,0 +1,435 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openziti/storage/ast"
+	"github.com/pkg/errors"
+)
+
+const eventFilterOption = "filter"
+
+// eventFilter evaluates a subscription filter expression against events of a single type. Filters use the
+// same query language as the REST API, ex: `serviceId = "x" and identityId in ["a", "b"]`. Fields are
+// referenced by their json name, in either snake or camel case. Nested fields and maps use dotted names,
+// ex: `path.ingressId` or `usage.ingress.tx`.
+type eventFilter struct {
+	expression string
+	query      ast.Query
+	types      *eventFilterSymbolTypes
+}
+
+// parseEventFilter returns the filter configured in the subscription options, or nil if no filter is configured
+func parseEventFilter[T any](ns string, options map[string]interface{}) (*eventFilter, error) {
+	val, found := options[eventFilterOption]
+	if !found {
+		return nil, nil
+	}
+
+	expression, ok := val.(string)
+	if !ok {
+		return nil, errors.Errorf("invalid type %v for %v filter, must be a string", reflect.TypeOf(val), ns)
+	}
+
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+
+	result, err := newEventFilter(reflect.TypeOf((*T)(nil)).Elem(), expression)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %v filter '%v'", ns, expression)
+	}
+	return result, nil
+}
+
+func newEventFilter(eventType reflect.Type, expression string) (*eventFilter, error) {
+	types := newEventFilterSymbolTypes(eventType)
+	query, err := ast.Parse(types, normalizeEventFilter(expression))
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventFilter{
+		expression: expression,
+		query:      query,
+		types:      types,
+	}, nil
+}
+
+func (self *eventFilter) matches(evt any) bool {
+	v := reflect.ValueOf(evt)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+
+	return self.query.EvalBool(&eventFilterSymbols{
+		eventFilterSymbolTypes: self.types,
+		evt:                    v,
+	})
+}
+
+var (
+	eventFilterSizeRegex = regexp.MustCompile(`(?i)\b(\d+(?:\.\d+)?)\s*(kib|mib|gib|tib|kb|mb|gb|tb)\b`)
+	eventFilterInRegex   = regexp.MustCompile(`(?i)^in\s*\(`)
+
+	eventFilterSizeUnits = map[string]float64{
+		"kb":  1e3,
+		"mb":  1e6,
+		"gb":  1e9,
+		"tb":  1e12,
+		"kib": 1 << 10,
+		"mib": 1 << 20,
+		"gib": 1 << 30,
+		"tib": 1 << 40,
+	}
+)
+
+// normalizeEventFilter converts the more forgiving filter syntax accepted in config files into the query
+// language understood by the parser. Single quoted strings become double quoted, `in (...)` lists become
+// `in [...]` and size literals such as 1MB (decimal) or 1MiB (binary) become plain numbers.
+func normalizeEventFilter(expression string) string {
+	result := &strings.Builder{}
+	inList := false
+	start := 0
+
+	for i := 0; i < len(expression); i++ {
+		quote := expression[i]
+		if quote != '\'' && quote != '"' {
+			continue
+		}
+
+		inList = normalizeEventFilterUnquoted(expression[start:i], inList, result)
+
+		end := i + 1
+		var value strings.Builder
+		for end < len(expression) && expression[end] != quote {
+			if expression[end] == '\\' && end+1 < len(expression) {
+				end++
+			}
+			value.WriteByte(expression[end])
+			end++
+		}
+		result.WriteString(strconv.Quote(value.String()))
+		i = end
+		start = end + 1
+	}
+
+	if start < len(expression) {
+		normalizeEventFilterUnquoted(expression[start:], inList, result)
+	}
+
+	return result.String()
+}
+
+func normalizeEventFilterUnquoted(s string, inList bool, result *strings.Builder) bool {
+	s = eventFilterSizeRegex.ReplaceAllStringFunc(s, func(match string) string {
+		parts := eventFilterSizeRegex.FindStringSubmatch(match)
+		val, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return match
+		}
+		val *= eventFilterSizeUnits[strings.ToLower(parts[2])]
+		if val == math.Trunc(val) {
+			return strconv.FormatInt(int64(val), 10)
+		}
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	})
+
+	for i := 0; i < len(s); i++ {
+		if inList {
+			if s[i] == ')' {
+				result.WriteByte(']')
+				inList = false
+				continue
+			}
+		} else if i == 0 || !isEventFilterIdentChar(s[i-1]) {
+			if loc := eventFilterInRegex.FindStringIndex(s[i:]); loc != nil {
+				result.WriteString(s[i : i+loc[1]-1])
+				result.WriteByte('[')
+				i += loc[1] - 1
+				inList = true
+				continue
+			}
+		}
+		result.WriteByte(s[i])
+	}
+
+	return inList
+}
+
+func isEventFilterIdentChar(c byte) bool {
+	return c == '_' || c == '.' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func normalizeEventFilterSymbol(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+type eventFilterSymbol struct {
+	path     []int
+	nodeType ast.NodeType
+	isMap    bool
+}
+
+// eventFilterSymbolTypes exposes the json fields of an event type as query symbols
+type eventFilterSymbolTypes struct {
+	symbols map[string]*eventFilterSymbol
+}
+
+func newEventFilterSymbolTypes(eventType reflect.Type) *eventFilterSymbolTypes {
+	result := &eventFilterSymbolTypes{
+		symbols: map[string]*eventFilterSymbol{},
+	}
+	result.addFields(eventType, "", nil)
+	return result
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func (self *eventFilterSymbolTypes) addFields(t reflect.Type, prefix string, path []int) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tag := field.Tag.Get("json"); tag != "" {
+			if tagName, _, _ := strings.Cut(tag, ","); tagName == "-" {
+				continue
+			} else if tagName != "" {
+				name = tagName
+			}
+		}
+
+		fieldPath := append(append([]int(nil), path...), i)
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && fieldType.Kind() == reflect.Struct && field.Tag.Get("json") == "" {
+			self.addFields(fieldType, prefix, fieldPath)
+			continue
+		}
+
+		name = prefix + normalizeEventFilterSymbol(name)
+
+		if fieldType.Kind() == reflect.Map && fieldType.Key().Kind() == reflect.String {
+			if nodeType, ok := getEventFilterNodeType(fieldType.Elem()); ok {
+				self.symbols[name] = &eventFilterSymbol{path: fieldPath, nodeType: nodeType, isMap: true}
+			}
+			continue
+		}
+
+		if fieldType.Kind() == reflect.Struct && fieldType != timeType {
+			self.addFields(fieldType, name+".", fieldPath)
+			continue
+		}
+
+		if nodeType, ok := getEventFilterNodeType(fieldType); ok {
+			self.symbols[name] = &eventFilterSymbol{path: fieldPath, nodeType: nodeType}
+		}
+	}
+}
+
+func getEventFilterNodeType(t reflect.Type) (ast.NodeType, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return ast.NodeTypeDatetime, true
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return ast.NodeTypeString, true
+	case reflect.Bool:
+		return ast.NodeTypeBool, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return ast.NodeTypeInt64, true
+	case reflect.Float32, reflect.Float64:
+		return ast.NodeTypeFloat64, true
+	default:
+		return 0, false
+	}
+}
+
+// getSymbol returns the symbol for the given name and, for map symbols, the map key. Map keys are matched
+// exactly, since they may themselves contain dots, ex: usage.ingress.tx
+func (self *eventFilterSymbolTypes) getSymbol(name string) (*eventFilterSymbol, string) {
+	if symbol, found := self.symbols[normalizeEventFilterSymbol(name)]; found && !symbol.isMap {
+		return symbol, ""
+	}
+
+	for i := 0; i < len(name); i++ {
+		if name[i] != '.' {
+			continue
+		}
+		if symbol, found := self.symbols[normalizeEventFilterSymbol(name[:i])]; found && symbol.isMap {
+			return symbol, name[i+1:]
+		}
+	}
+
+	return nil, ""
+}
+
+func (self *eventFilterSymbolTypes) GetSymbolType(name string) (ast.NodeType, bool) {
+	if symbol, _ := self.getSymbol(name); symbol != nil {
+		return symbol.nodeType, true
+	}
+	return 0, false
+}
+
+func (self *eventFilterSymbolTypes) GetSetSymbolTypes(string) ast.SymbolTypes {
+	return nil
+}
+
+func (self *eventFilterSymbolTypes) IsSet(name string) (bool, bool) {
+	symbol, _ := self.getSymbol(name)
+	return false, symbol != nil
+}
+
+// eventFilterSymbols evaluates symbols against a single event
+type eventFilterSymbols struct {
+	*eventFilterSymbolTypes
+	evt reflect.Value
+}
+
+func (self *eventFilterSymbols) eval(name string) reflect.Value {
+	symbol, key := self.getSymbol(name)
+	if symbol == nil {
+		return reflect.Value{}
+	}
+
+	v := self.evt
+	for _, idx := range symbol.path {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.Field(idx)
+	}
+
+	if symbol.isMap {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key()))
+	}
+
+	for v.IsValid() && v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+
+	return v
+}
+
+func (self *eventFilterSymbols) EvalBool(name string) *bool {
+	if v := self.eval(name); v.IsValid() && v.Kind() == reflect.Bool {
+		result := v.Bool()
+		return &result
+	}
+	return nil
+}
+
+func (self *eventFilterSymbols) EvalString(name string) *string {
+	if v := self.eval(name); v.IsValid() && v.Kind() == reflect.String {
+		result := v.String()
+		return &result
+	}
+	return nil
+}
+
+func (self *eventFilterSymbols) EvalInt64(name string) *int64 {
+	v := self.eval(name)
+	if !v.IsValid() {
+		return nil
+	}
+
+	var result int64
+	switch {
+	case v.CanInt():
+		result = v.Int()
+	case v.CanUint():
+		result = int64(v.Uint())
+	default:
+		return nil
+	}
+	return &result
+}
+
+func (self *eventFilterSymbols) EvalFloat64(name string) *float64 {
+	v := self.eval(name)
+	if !v.IsValid() {
+		return nil
+	}
+
+	var result float64
+	switch {
+	case v.CanFloat():
+		result = v.Float()
+	case v.CanInt():
+		result = float64(v.Int())
+	case v.CanUint():
+		result = float64(v.Uint())
+	default:
+		return nil
+	}
+	return &result
+}
+
+func (self *eventFilterSymbols) EvalDatetime(name string) *time.Time {
+	if v := self.eval(name); v.IsValid() && v.Type() == timeType {
+		result := v.Interface().(time.Time)
+		return &result
+	}
+	return nil
+}
+
+func (self *eventFilterSymbols) IsNil(name string) bool {
+	return !self.eval(name).IsValid()
+}
+
+func (self *eventFilterSymbols) OpenSetCursor(string) ast.SetCursor {
+	return nil
+}
+
+func (self *eventFilterSymbols) OpenSetCursorForQuery(string, ast.Query) ast.SetCursor {
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NormalizeEventFilter(t *testing.T) {
+	req := require.New(t)
+
+	req.Equal(`serviceId = "x" and identityId in ["a", "b"]`,
+		normalizeEventFilter(`serviceId = 'x' and identityId in ('a', 'b')`))
+	req.Equal(`usage.ingress.tx > 1000000 and usage.egress.tx < 1536`,
+		normalizeEventFilter(`usage.ingress.tx > 1MB and usage.egress.tx < 1.5KiB`))
+	req.Equal(`tags.name = "login (1MB)" and x in ["it's"]`,
+		normalizeEventFilter(`tags.name = 'login (1MB)' and x in ("it's")`))
+	req.Equal(`domain = "x"`, normalizeEventFilter(`domain = 'x'`))
+}
+
+func Test_EventFilter_CircuitEvents(t *testing.T) {
+	req := require.New(t)
+
+	filter, err := parseEventFilter[event.CircuitEvent](event.CircuitEventsNs, map[string]interface{}{
+		"filter": `serviceId = 'svc1' and clientId in ('c1', 'c2') and path.ingressId = 'r1' and tags.env = 'prod'`,
+	})
+	req.NoError(err)
+
+	evt := &event.CircuitEvent{
+		ServiceId: "svc1",
+		ClientId:  "c2",
+		Path:      event.CircuitPath{IngressId: "r1"},
+		Tags:      map[string]string{"env": "prod"},
+	}
+	req.True(filter.matches(evt))
+
+	evt.ClientId = "c3"
+	req.False(filter.matches(evt))
+
+	evt.ClientId = "c1"
+	evt.Tags = nil
+	req.False(filter.matches(evt))
+
+	duration := 5 * time.Second
+	filter, err = parseEventFilter[event.CircuitEvent](event.CircuitEventsNs, map[string]interface{}{
+		"filter": `event_type = "deleted" and duration > 1000000000 and failure_cause = null`,
+	})
+	req.NoError(err)
+	req.True(filter.matches(&event.CircuitEvent{EventType: event.CircuitDeleted, Duration: &duration}))
+	req.False(filter.matches(&event.CircuitEvent{EventType: event.CircuitDeleted}))
+}
+
+func Test_EventFilter_UsageEvents(t *testing.T) {
+	req := require.New(t)
+
+	filter, err := parseEventFilter[event.UsageEventV3](event.UsageEventsNs, map[string]interface{}{
+		"filter": `usage.ingress.tx > 1MB`,
+	})
+	req.NoError(err)
+
+	req.True(filter.matches(&event.UsageEventV3{Usage: map[string]uint64{"ingress.tx": 2_000_000}}))
+	req.False(filter.matches(&event.UsageEventV3{Usage: map[string]uint64{"ingress.tx": 1000}}))
+	req.False(filter.matches(&event.UsageEventV3{Usage: map[string]uint64{"egress.tx": 2_000_000}}))
+
+	filter, err = parseEventFilter[event.UsageEvent](event.UsageEventsNs, map[string]interface{}{
+		"filter": `eventType = "usage.ingress.tx" and usage >= 1KiB`,
+	})
+	req.NoError(err)
+	req.True(filter.matches(&event.UsageEvent{EventType: "usage.ingress.tx", Usage: 1024}))
+	req.False(filter.matches(&event.UsageEvent{EventType: "usage.ingress.tx", Usage: 1023}))
+}
+
+func Test_EventFilter_Invalid(t *testing.T) {
+	req := require.New(t)
+
+	filter, err := parseEventFilter[event.SessionEvent](event.SessionEventNS, map[string]interface{}{})
+	req.NoError(err)
+	req.Nil(filter)
+
+	_, err = parseEventFilter[event.SessionEvent](event.SessionEventNS, map[string]interface{}{
+		"filter": `noSuchField = "x"`,
+	})
+	req.Error(err)
+
+	_, err = parseEventFilter[event.SessionEvent](event.SessionEventNS, map[string]interface{}{
+		"filter": 5,
+	})
+	req.Error(err)
+}
+
+type testSessionEventHandler struct {
+	events []*event.SessionEvent
+}
+
+func (self *testSessionEventHandler) AcceptSessionEvent(evt *event.SessionEvent) {
+	self.events = append(self.events, evt)
+}
+
+func Test_Dispatcher_FiltersSubscriptions(t *testing.T) {
+	req := require.New(t)
+
+	dispatcher := &Dispatcher{}
+	handler := &testSessionEventHandler{}
+
+	err := dispatcher.registerSessionEventHandler(handler, map[string]interface{}{
+		"include": "created",
+		"filter":  `identityId = 'i1'`,
+	})
+	req.NoError(err)
+
+	for _, h := range dispatcher.sessionEventHandlers.Value() {
+		h.AcceptSessionEvent(&event.SessionEvent{EventType: event.SessionEventTypeCreated, IdentityId: "i1"})
+		h.AcceptSessionEvent(&event.SessionEvent{EventType: event.SessionEventTypeCreated, IdentityId: "i2"})
+		h.AcceptSessionEvent(&event.SessionEvent{EventType: event.SessionEventTypeDeleted, IdentityId: "i1"})
+	}
+
+	req.Len(handler.events, 1)
+	req.Equal("i1", handler.events[0].IdentityId)
+
+	dispatcher.unregisterSessionEventHandler(handler)
+	req.Empty(dispatcher.sessionEventHandlers.Value())
+}
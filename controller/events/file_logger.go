@@ -19,6 +19,7 @@ package events
 import (
 	"fmt"
 	"github.com/natefinch/lumberjack"
+	"github.com/openziti/ziti/controller/event"
 	"github.com/pkg/errors"
 	"io"
 	"os"
@@ -28,11 +29,21 @@ import (
 type fabricFormatterFactory struct{}
 
 func (f fabricFormatterFactory) NewLoggingHandler(format string, buffer int, out io.WriteCloser) (interface{}, error) {
-	if strings.EqualFold(format, "json") {
-		return NewJsonFormatter(buffer, NewWriterEventSink(out)), nil
+	sink, ok := out.(event.FormattedEventSink)
+	if !ok {
+		sink = NewWriterEventSink(out)
 	}
 
-	return nil, errors.Errorf("invalid 'format' for event log output file: %v", format)
+	if strings.EqualFold(format, FormatJson) {
+		return NewJsonFormatter(buffer, sink), nil
+	}
+
+	if encoder, ok := getEventEncoder(format); ok {
+		return NewEncodingFormatter(buffer, sink, encoder), nil
+	}
+
+	return nil, errors.Errorf("invalid 'format' for event log output file: %v, valid formats are: %v",
+		format, strings.Join(EventFormats(), ", "))
 }
 
 type StdOutLoggerFactory struct{}
@@ -104,6 +115,10 @@ func NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, con
 
 	if value, found := config["format"]; found {
 		if format, ok := value.(string); ok {
+			if isBinaryEventFormat(format) {
+				// binary formats are self delimiting, so don't add newlines
+				return formatterFactory.NewLoggingHandler(format, bufferSize, output.out)
+			}
 			return formatterFactory.NewLoggingHandler(format, bufferSize, output)
 		}
 		return nil, errors.New("invalid 'format' for event log output file")
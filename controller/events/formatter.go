@@ -51,21 +51,28 @@ type FormatterEvent interface {
 	Format() ([]byte, error)
 }
 
+// A KeyedFormatterEvent is a FormatterEvent which can report the id of the entity it pertains to
+type KeyedFormatterEvent interface {
+	FormatterEvent
+	GetEventKey() string
+}
+
 type BaseFormatter struct {
 	closed      atomic.Bool
 	closeNotify chan struct{}
 	events      chan FormatterEvent
 	sink        event.FormattedEventSink
+	encoder     EventEncoder
 }
 
 func (f *BaseFormatter) Run() {
 	for {
 		select {
 		case evt := <-f.events:
-			if formattedEvent, err := evt.Format(); err != nil {
+			if formattedEvent, err := f.format(evt); err != nil {
 				pfxlog.Logger().WithError(err).Errorf("failed to output event of type %v", reflect.TypeOf(evt))
 			} else {
-				f.sink.AcceptFormattedEvent(evt.GetEventType(), formattedEvent)
+				f.acceptFormattedEvent(evt, formattedEvent)
 			}
 		case <-f.closeNotify:
 			return
@@ -73,6 +80,24 @@ func (f *BaseFormatter) Run() {
 	}
 }
 
+func (f *BaseFormatter) format(evt FormatterEvent) ([]byte, error) {
+	formattedEvent, err := evt.Format()
+	if err != nil || f.encoder == nil {
+		return formattedEvent, err
+	}
+	return f.encoder.Encode(evt.GetEventType(), formattedEvent)
+}
+
+func (f *BaseFormatter) acceptFormattedEvent(evt FormatterEvent, formattedEvent []byte) {
+	if keyedSink, ok := f.sink.(event.KeyedFormattedEventSink); ok {
+		if keyedEvent, ok := evt.(KeyedFormatterEvent); ok {
+			keyedSink.AcceptKeyedFormattedEvent(evt.GetEventType(), keyedEvent.GetEventKey(), formattedEvent)
+			return
+		}
+	}
+	f.sink.AcceptFormattedEvent(evt.GetEventType(), formattedEvent)
+}
+
 func (f *BaseFormatter) Close() error {
 	if f.closed.CompareAndSwap(false, true) {
 		close(f.closeNotify)
@@ -105,6 +130,10 @@ func (event *JsonCircuitEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonCircuitEvent) GetEventKey() string {
+	return event.CircuitId
+}
+
 type JsonLinkEvent event.LinkEvent
 
 func (event *JsonLinkEvent) GetEventType() string {
@@ -115,6 +144,10 @@ func (event *JsonLinkEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonLinkEvent) GetEventKey() string {
+	return event.LinkId
+}
+
 type JsonMetricsEvent event.MetricsEvent
 
 func (event *JsonMetricsEvent) GetEventType() string {
@@ -135,6 +168,10 @@ func (event *JsonRouterEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonRouterEvent) GetEventKey() string {
+	return event.RouterId
+}
+
 type JsonServiceEvent event.ServiceEvent
 
 func (event *JsonServiceEvent) GetEventType() string {
@@ -145,6 +182,10 @@ func (event *JsonServiceEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonServiceEvent) GetEventKey() string {
+	return event.ServiceId
+}
+
 type JsonTerminatorEvent event.TerminatorEvent
 
 func (event *JsonTerminatorEvent) GetEventType() string {
@@ -155,6 +196,10 @@ func (event *JsonTerminatorEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonTerminatorEvent) GetEventKey() string {
+	return event.TerminatorId
+}
+
 type JsonUsageEvent event.UsageEvent
 
 func (event *JsonUsageEvent) GetEventType() string {
@@ -165,6 +210,10 @@ func (event *JsonUsageEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonUsageEvent) GetEventKey() string {
+	return event.CircuitId
+}
+
 func (event *JsonUsageEventV3) GetEventType() string {
 	return "usage.v3"
 }
@@ -175,6 +224,10 @@ func (event *JsonUsageEventV3) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonUsageEventV3) GetEventKey() string {
+	return event.CircuitId
+}
+
 type JsonClusterEvent event.ClusterEvent
 
 func (event *JsonClusterEvent) GetEventType() string {
@@ -195,6 +248,15 @@ func (event *JsonEntityChangeEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonEntityChangeEvent) GetEventKey() string {
+	for _, state := range []any{event.FinalState, event.InitialState} {
+		if entity, ok := state.(interface{ GetId() string }); ok {
+			return entity.GetId()
+		}
+	}
+	return ""
+}
+
 type JsonSessionEvent event.SessionEvent
 
 func (event *JsonSessionEvent) GetEventType() string {
@@ -205,6 +267,10 @@ func (event *JsonSessionEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonSessionEvent) GetEventKey() string {
+	return event.IdentityId
+}
+
 type JsonApiSessionEvent event.ApiSessionEvent
 
 func (event *JsonApiSessionEvent) GetEventType() string {
@@ -215,6 +281,10 @@ func (event *JsonApiSessionEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonApiSessionEvent) GetEventKey() string {
+	return event.IdentityId
+}
+
 type JsonEntityCountEvent event.EntityCountEvent
 
 func (event *JsonEntityCountEvent) GetEventType() string {
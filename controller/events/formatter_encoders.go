@@ -0,0 +1,390 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openziti/ziti/common/version"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	FormatJson     = "json"
+	FormatCef      = "cef"
+	FormatSyslog   = "syslog"
+	FormatLogfmt   = "logfmt"
+	FormatProtobuf = "protobuf"
+)
+
+// An EventEncoder converts the json representation of an event into another format
+type EventEncoder interface {
+	Encode(eventType string, jsonEvent []byte) ([]byte, error)
+}
+
+var eventEncoders = map[string]func() EventEncoder{
+	FormatCef:      func() EventEncoder { return cefEncoder{} },
+	FormatSyslog:   newSyslogEncoder,
+	FormatLogfmt:   func() EventEncoder { return logfmtEncoder{} },
+	FormatProtobuf: func() EventEncoder { return protobufEncoder{} },
+}
+
+// EventFormats returns the names of all supported event formats
+func EventFormats() []string {
+	result := []string{FormatJson}
+	for k := range eventEncoders {
+		result = append(result, k)
+	}
+	sort.Strings(result[1:])
+	return result
+}
+
+func getEventEncoder(format string) (EventEncoder, bool) {
+	if f, ok := eventEncoders[strings.ToLower(format)]; ok {
+		return f(), true
+	}
+	return nil, false
+}
+
+// isBinaryEventFormat returns true for formats which carry their own framing, and so shouldn't be newline delimited
+func isBinaryEventFormat(format string) bool {
+	return strings.EqualFold(format, FormatProtobuf)
+}
+
+func eventFormatContentType(format string) string {
+	switch strings.ToLower(format) {
+	case FormatJson:
+		return "application/json"
+	case FormatProtobuf:
+		return "application/x-protobuf"
+	default:
+		return "text/plain"
+	}
+}
+
+// NewEncodingFormatter returns a formatter which re-encodes the json representation of each event using
+// the given encoder
+func NewEncodingFormatter(queueDepth int, sink event.FormattedEventSink, encoder EventEncoder) *JsonFormatter {
+	result := &JsonFormatter{
+		BaseFormatter: BaseFormatter{
+			events:      make(chan FormatterEvent, queueDepth),
+			closeNotify: make(chan struct{}),
+			sink:        sink,
+			encoder:     encoder,
+		},
+	}
+	go result.Run()
+	return result
+}
+
+func decodeJsonEvent(jsonEvent []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonEvent))
+	decoder.UseNumber()
+	result := map[string]any{}
+	if err := decoder.Decode(&result); err != nil {
+		return nil, errors.Wrap(err, "unable to decode json event")
+	}
+	return result, nil
+}
+
+// flattenEvent turns nested objects into dotted keys, ex: path.ingress_id. Lists of simple values are
+// joined with commas, other lists are rendered as json
+func flattenEvent(prefix string, m map[string]any, result map[string]string) {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case nil:
+		case map[string]any:
+			flattenEvent(key, val, result)
+		case []any:
+			result[key] = flattenList(val)
+		default:
+			result[key] = fmt.Sprintf("%v", val)
+		}
+	}
+}
+
+func flattenList(list []any) string {
+	var values []string
+	for _, v := range list {
+		switch v.(type) {
+		case map[string]any, []any:
+			buf, _ := json.Marshal(list)
+			return string(buf)
+		default:
+			values = append(values, fmt.Sprintf("%v", v))
+		}
+	}
+	return strings.Join(values, ",")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getEventTimestamp returns the event timestamp, if the event has one, or the current time otherwise
+func getEventTimestamp(fields map[string]string) time.Time {
+	for _, key := range []string{"timestamp", "event_src_timestamp"} {
+		if v, ok := fields[key]; ok {
+			if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+				return t
+			}
+		}
+	}
+	return time.Now()
+}
+
+func getEventSubType(fields map[string]string) string {
+	for _, key := range []string{"event_type", "eventType"} {
+		if v, ok := fields[key]; ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func isFailureEvent(fields map[string]string) bool {
+	subType := getEventSubType(fields)
+	return strings.Contains(strings.ToLower(subType), "fail")
+}
+
+type logfmtEncoder struct{}
+
+func (logfmtEncoder) Encode(eventType string, jsonEvent []byte) ([]byte, error) {
+	m, err := decodeJsonEvent(jsonEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{}
+	flattenEvent("", m, fields)
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("type=")
+	buf.WriteString(logfmtValue(eventType))
+
+	for _, k := range sortedKeys(fields) {
+		buf.WriteByte(' ')
+		buf.WriteString(k)
+		buf.WriteByte('=')
+		buf.WriteString(logfmtValue(fields[k]))
+	}
+
+	return buf.Bytes(), nil
+}
+
+func logfmtValue(v string) string {
+	if v == "" || strings.ContainsAny(v, " =\"\\") || strings.IndexFunc(v, func(r rune) bool { return r < ' ' }) >= 0 {
+		return strconv.Quote(v)
+	}
+	return v
+}
+
+// cefEncoder outputs ArcSight Common Event Format. Event fields are emitted as extension key/value pairs,
+// with dots and other punctuation in field names replaced by underscores
+type cefEncoder struct{}
+
+func (cefEncoder) Encode(eventType string, jsonEvent []byte) ([]byte, error) {
+	m, err := decodeJsonEvent(jsonEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{}
+	flattenEvent("", m, fields)
+
+	return []byte(formatCef(eventType, fields)), nil
+}
+
+func formatCef(eventType string, fields map[string]string) string {
+	signatureId := eventType
+	name := eventType
+	if subType := getEventSubType(fields); subType != "" {
+		signatureId = eventType + "." + subType
+		name = eventType + " " + subType
+	}
+
+	severity := 3
+	if isFailureEvent(fields) {
+		severity = 7
+	}
+
+	buf := &strings.Builder{}
+	_, _ = fmt.Fprintf(buf, "CEF:0|%s|%s|%s|%s|%s|%d|",
+		cefHeaderEscape("OpenZiti"), cefHeaderEscape("ziti-controller"), cefHeaderEscape(version.GetVersion()),
+		cefHeaderEscape(signatureId), cefHeaderEscape(name), severity)
+
+	_, _ = fmt.Fprintf(buf, "rt=%d", getEventTimestamp(fields).UnixMilli())
+
+	for _, k := range sortedKeys(fields) {
+		buf.WriteByte(' ')
+		buf.WriteString(cefKey(k))
+		buf.WriteByte('=')
+		buf.WriteString(cefExtensionEscape(fields[k]))
+	}
+
+	return buf.String()
+}
+
+func cefHeaderEscape(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	return strings.ReplaceAll(v, "|", `\|`)
+}
+
+func cefExtensionEscape(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, "=", `\=`)
+	v = strings.ReplaceAll(v, "\r", `\r`)
+	return strings.ReplaceAll(v, "\n", `\n`)
+}
+
+func cefKey(k string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, k)
+}
+
+// syslogEncoder outputs RFC 5424 syslog messages, with the event type as the MSGID and the json event as
+// the message body
+type syslogEncoder struct {
+	hostname string
+	procId   string
+}
+
+const (
+	syslogFacilityLocal0  = 16
+	syslogSeverityWarning = 4
+	syslogSeverityInfo    = 6
+)
+
+func newSyslogEncoder() EventEncoder {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &syslogEncoder{
+		hostname: syslogHeaderValue(hostname, 255),
+		procId:   strconv.Itoa(os.Getpid()),
+	}
+}
+
+func (self *syslogEncoder) Encode(eventType string, jsonEvent []byte) ([]byte, error) {
+	m, err := decodeJsonEvent(jsonEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{}
+	flattenEvent("", m, fields)
+
+	severity := syslogSeverityInfo
+	if isFailureEvent(fields) {
+		severity = syslogSeverityWarning
+	}
+
+	buf := &bytes.Buffer{}
+	_, _ = fmt.Fprintf(buf, "<%d>1 %s %s ziti-controller %s %s - ",
+		syslogFacilityLocal0*8+severity,
+		getEventTimestamp(fields).UTC().Format(time.RFC3339Nano),
+		self.hostname,
+		self.procId,
+		syslogHeaderValue(eventType, 32))
+	buf.Write(jsonEvent)
+
+	return buf.Bytes(), nil
+}
+
+// syslogHeaderValue ensures a header value contains only printable US-ASCII and fits the max length
+func syslogHeaderValue(v string, maxLen int) string {
+	v = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, v)
+	if len(v) > maxLen {
+		v = v[:maxLen]
+	}
+	if v == "" {
+		return "-"
+	}
+	return v
+}
+
+// protobufEncoder outputs each event as a varint length prefixed google.protobuf.Struct, with the same
+// field names as the json format
+type protobufEncoder struct{}
+
+func (protobufEncoder) Encode(_ string, jsonEvent []byte) ([]byte, error) {
+	m, err := decodeJsonEvent(jsonEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := structpb.NewStruct(toProtobufValues(m).(map[string]any))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to convert event to protobuf struct")
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err = protodelim.MarshalTo(buf, msg); err != nil {
+		return nil, errors.Wrap(err, "unable to marshal protobuf event")
+	}
+	return buf.Bytes(), nil
+}
+
+// toProtobufValues converts json numbers, which structpb doesn't understand, to float64
+func toProtobufValues(v any) any {
+	switch val := v.(type) {
+	case json.Number:
+		f, _ := val.Float64()
+		return f
+	case map[string]any:
+		for k, child := range val {
+			val[k] = toProtobufValues(child)
+		}
+		return val
+	case []any:
+		for i, child := range val {
+			val[i] = toProtobufValues(child)
+		}
+		return val
+	default:
+		return v
+	}
+}
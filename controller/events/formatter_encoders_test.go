@@ -0,0 +1,132 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newTestCircuitEvent() *JsonCircuitEvent {
+	return &JsonCircuitEvent{
+		Namespace: event.CircuitEventsNs,
+		EventType: event.CircuitFailed,
+		CircuitId: "circuit1",
+		Timestamp: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		ServiceId: "svc=1",
+		Path: event.CircuitPath{
+			Nodes: []string{"r1", "r2"},
+		},
+		Tags: map[string]string{
+			"note": "two words",
+		},
+	}
+}
+
+func encodeTestEvent(t *testing.T, format string, evt FormatterEvent) []byte {
+	req := require.New(t)
+	encoder, ok := getEventEncoder(format)
+	req.True(ok)
+	jsonEvent, err := evt.Format()
+	req.NoError(err)
+	result, err := encoder.Encode(evt.GetEventType(), jsonEvent)
+	req.NoError(err)
+	return result
+}
+
+func Test_LogfmtEncoder(t *testing.T) {
+	req := require.New(t)
+	result := string(encodeTestEvent(t, FormatLogfmt, newTestCircuitEvent()))
+
+	req.True(strings.HasPrefix(result, "type=circuit "))
+	req.Contains(result, " circuit_id=circuit1 ")
+	req.Contains(result, " event_type=failed ")
+	req.Contains(result, " path.nodes=r1,r2 ")
+	req.Contains(result, ` service_id="svc=1" `)
+	req.Contains(result, ` tags.note="two words"`)
+}
+
+func Test_CefEncoder(t *testing.T) {
+	req := require.New(t)
+	result := string(encodeTestEvent(t, FormatCef, newTestCircuitEvent()))
+
+	req.True(strings.HasPrefix(result, "CEF:0|OpenZiti|ziti-controller|"), result)
+	req.Contains(result, "|circuit.failed|circuit failed|7|rt=1714564800000 ")
+	req.Contains(result, " path_nodes=r1,r2")
+	req.Contains(result, ` service_id=svc\=1`)
+}
+
+func Test_SyslogEncoder(t *testing.T) {
+	req := require.New(t)
+	evt := newTestCircuitEvent()
+	result := string(encodeTestEvent(t, FormatSyslog, evt))
+
+	req.True(strings.HasPrefix(result, "<132>1 2024-05-01T12:00:00Z "), result)
+	req.Contains(result, " ziti-controller ")
+
+	jsonEvent, err := evt.Format()
+	req.NoError(err)
+	req.True(strings.HasSuffix(result, " circuit - "+string(jsonEvent)))
+}
+
+func Test_ProtobufEncoder(t *testing.T) {
+	req := require.New(t)
+
+	buf := &bytes.Buffer{}
+	buf.Write(encodeTestEvent(t, FormatProtobuf, newTestCircuitEvent()))
+	buf.Write(encodeTestEvent(t, FormatProtobuf, &JsonUsageEvent{CircuitId: "circuit2", Usage: 1024}))
+
+	first := &structpb.Struct{}
+	req.NoError(protodelim.UnmarshalFrom(buf, first))
+	req.Equal("circuit1", first.Fields["circuit_id"].GetStringValue())
+	req.Equal("r2", first.Fields["path"].GetStructValue().Fields["nodes"].GetListValue().Values[1].GetStringValue())
+
+	second := &structpb.Struct{}
+	req.NoError(protodelim.UnmarshalFrom(buf, second))
+	req.Equal("circuit2", second.Fields["circuit_id"].GetStringValue())
+	req.Equal(float64(1024), second.Fields["usage"].GetNumberValue())
+}
+
+func Test_FormatterFactoryFormats(t *testing.T) {
+	req := require.New(t)
+
+	for _, format := range EventFormats() {
+		handler, err := fabricFormatterFactory{}.NewLoggingHandler(format, 1, &newlineWriter{out: nopWriteCloser{}})
+		req.NoError(err, format)
+		_ = handler.(*JsonFormatter).Close()
+	}
+
+	_, err := fabricFormatterFactory{}.NewLoggingHandler("xml", 1, &newlineWriter{out: nopWriteCloser{}})
+	req.Error(err)
+}
+
+type nopWriteCloser struct{}
+
+func (nopWriteCloser) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
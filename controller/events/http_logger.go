@@ -0,0 +1,702 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+)
+
+const spoolFileSuffix = ".batch"
+
+type HTTPEventLoggerFactory struct{}
+
+func (HTTPEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewHTTPEventLogger(fabricFormatterFactory{}, config)
+}
+
+type httpConfig struct {
+	url                string
+	contentType        string
+	headers            map[string]string
+	bearerToken        string
+	certFile           string
+	keyFile            string
+	caFile             string
+	insecureSkipVerify bool
+	queueSize          int
+	batchSize          int
+	batchInterval      time.Duration
+	timeout            time.Duration
+	maxRetries         int
+	initialBackoff     time.Duration
+	maxBackoff         time.Duration
+	spoolDir           string
+	spoolMaxSizeMb     int
+	binary             bool
+}
+
+func parseHTTPConfig(config map[interface{}]interface{}) (*httpConfig, error) {
+	ret := &httpConfig{
+		contentType:    "application/x-ndjson",
+		headers:        map[string]string{},
+		queueSize:      100,
+		batchSize:      50,
+		batchInterval:  time.Second,
+		timeout:        10 * time.Second,
+		maxRetries:     5,
+		initialBackoff: 500 * time.Millisecond,
+		maxBackoff:     30 * time.Second,
+		spoolMaxSizeMb: 100,
+	}
+
+	if value, found := config["url"]; !found {
+		return nil, errors.New("missing http url")
+	} else if u, ok := value.(string); ok && u != "" {
+		ret.url = u
+	} else {
+		return nil, errors.New("invalid http url")
+	}
+
+	var err error
+	stringFields := map[string]*string{
+		"contentType": &ret.contentType,
+		"bearerToken": &ret.bearerToken,
+		"cert":        &ret.certFile,
+		"key":         &ret.keyFile,
+		"ca":          &ret.caFile,
+		"spoolDir":    &ret.spoolDir,
+	}
+
+	for k, target := range stringFields {
+		value, found := config[k]
+		if !found {
+			continue
+		}
+		if s, ok := value.(string); ok {
+			*target = s
+		} else {
+			return nil, errors.Errorf("invalid value for http event handler '%s', must be a string", k)
+		}
+	}
+
+	if value, found := config["bearerTokenEnv"]; found {
+		if envVar, ok := value.(string); ok {
+			ret.bearerToken = os.Getenv(envVar)
+		} else {
+			return nil, errors.New("invalid value for http event handler 'bearerTokenEnv', must be a string")
+		}
+	}
+
+	if (ret.certFile == "") != (ret.keyFile == "") {
+		return nil, errors.New("http event handler 'cert' and 'key' must be specified together")
+	}
+
+	if value, found := config["headers"]; found {
+		headers, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("invalid value for http event handler 'headers', must be a map")
+		}
+		for k, v := range headers {
+			ret.headers[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	if value, found := config["insecureSkipVerify"]; found {
+		if b, ok := value.(bool); ok {
+			ret.insecureSkipVerify = b
+		} else {
+			return nil, errors.New("invalid value for http event handler 'insecureSkipVerify', must be a boolean")
+		}
+	}
+
+	intFields := map[string]*int{
+		"queueSize":      &ret.queueSize,
+		"batchSize":      &ret.batchSize,
+		"maxRetries":     &ret.maxRetries,
+		"spoolMaxSizeMb": &ret.spoolMaxSizeMb,
+	}
+
+	for k, target := range intFields {
+		if value, found := config[k]; found {
+			if i, ok := value.(int); ok && i >= 0 {
+				*target = i
+			} else {
+				return nil, errors.Errorf("invalid value for http event handler '%s', must be a non-negative integer", k)
+			}
+		}
+	}
+
+	if ret.batchSize < 1 {
+		ret.batchSize = 1
+	}
+
+	if ret.queueSize < 1 {
+		ret.queueSize = 1
+	}
+
+	if ret.batchInterval, err = parseDurationConfig(config, "batchInterval", ret.batchInterval); err != nil {
+		return nil, err
+	}
+	if ret.timeout, err = parseDurationConfig(config, "timeout", ret.timeout); err != nil {
+		return nil, err
+	}
+	if ret.initialBackoff, err = parseDurationConfig(config, "initialBackoff", ret.initialBackoff); err != nil {
+		return nil, err
+	}
+	if ret.maxBackoff, err = parseDurationConfig(config, "maxBackoff", ret.maxBackoff); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func parseDurationConfig(config map[interface{}]interface{}, key string, defaultValue time.Duration) (time.Duration, error) {
+	value, found := config[key]
+	if !found {
+		return defaultValue, nil
+	}
+	if s, ok := value.(string); ok {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid duration for '%s'", key)
+		}
+		return d, nil
+	}
+	return 0, errors.Errorf("invalid value for '%s', must be a duration string, ex: 5s", key)
+}
+
+// newEventHandlerTlsConfig builds the tls configuration used by network based event handlers. The ca file is optional,
+// as is the client certificate, which is used for mTLS when provided
+func newEventHandlerTlsConfig(caFile, certFile, keyFile string, insecureSkipVerify bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read event handler ca file '%s'", caFile)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in event handler ca file '%s'", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load event handler client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+func (self *httpConfig) newClient() (*http.Client, error) {
+	tlsConfig, err := newEventHandlerTlsConfig(self.caFile, self.certFile, self.keyFile, self.insecureSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   self.timeout,
+	}, nil
+}
+
+// httpWriteCloser collects formatted events into batches and POSTs them as newline delimited bodies to
+// the configured url. Delivery and retries happen on a separate goroutine from batching, so that events
+// keep being accepted while the endpoint is slow or down. Batches which can't be handed off or delivered
+// are written to the spool, if one is configured, and are delivered in order once the endpoint recovers.
+type httpWriteCloser struct {
+	config   *httpConfig
+	client   *http.Client
+	spool    *eventSpool
+	ctx      context.Context
+	cancel   context.CancelFunc
+	messages chan []byte
+	batches  chan *eventBatch
+	closed   atomic.Bool
+	done     sync.WaitGroup
+}
+
+type eventBatch struct {
+	body    []byte
+	created time.Time
+}
+
+func newHTTPWriteCloser(config *httpConfig) (*httpWriteCloser, error) {
+	client, err := config.newClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var spool *eventSpool
+	if config.spoolDir != "" {
+		if spool, err = newEventSpool(config.spoolDir, int64(config.spoolMaxSizeMb)*1024*1024); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := &httpWriteCloser{
+		config:   config,
+		client:   client,
+		spool:    spool,
+		ctx:      ctx,
+		cancel:   cancel,
+		messages: make(chan []byte, config.queueSize),
+		batches:  make(chan *eventBatch, 1),
+	}
+
+	result.done.Add(2)
+	go result.runBatcher()
+	go result.runSender()
+
+	return result, nil
+}
+
+func (self *httpWriteCloser) runBatcher() {
+	defer self.done.Done()
+
+	ticker := time.NewTicker(self.config.batchInterval)
+	defer ticker.Stop()
+
+	var batch [][]byte
+
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		b := self.newBatch(batch)
+		batch = nil
+
+		select {
+		case self.batches <- b:
+		default:
+			// the sender is busy, most likely retrying, so don't block accepting new events
+			self.spoolOrDrop(b, errors.New("http event delivery in progress"))
+		}
+	}
+
+	for {
+		select {
+		case m := <-self.messages:
+			batch = append(batch, m)
+			if len(batch) >= self.config.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-self.ctx.Done():
+			// pick up anything which was queued before close and spool it
+			for {
+				select {
+				case m := <-self.messages:
+					batch = append(batch, m)
+				default:
+					if len(batch) > 0 {
+						self.spoolOrDrop(self.newBatch(batch), errors.New("event handler closed"))
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+func (self *httpWriteCloser) runSender() {
+	defer self.done.Done()
+
+	ticker := time.NewTicker(self.config.batchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case b := <-self.batches:
+			self.deliver(b)
+		case <-ticker.C:
+			// a waiting batch may be older than what's in the spool, so it needs to go through deliver first
+			select {
+			case b := <-self.batches:
+				self.deliver(b)
+			default:
+				self.drainSpool()
+			}
+		case <-self.ctx.Done():
+			select {
+			case b := <-self.batches:
+				self.spoolOrDrop(b, errors.New("event handler closed"))
+			default:
+			}
+			return
+		}
+	}
+}
+
+func (self *httpWriteCloser) newBatch(batch [][]byte) *eventBatch {
+	buf := &bytes.Buffer{}
+	for _, m := range batch {
+		buf.Write(m)
+		if !self.config.binary {
+			buf.WriteByte('\n')
+		}
+	}
+	return &eventBatch{
+		body:    buf.Bytes(),
+		created: time.Now(),
+	}
+}
+
+func (self *httpWriteCloser) deliver(b *eventBatch) {
+	// keep ordering: if there's anything in the spool, this batch goes in behind or, if it was created
+	// earlier, ahead of what's already there, and the spool is drained in order
+	if self.spool != nil && self.spool.hasPending() {
+		self.spoolOrDrop(b, errors.New("spooled events pending delivery"))
+		self.drainSpool()
+		return
+	}
+
+	if err := self.sendWithRetry(b.body); err != nil {
+		if isEventsRejectedError(err) {
+			pfxlog.Logger().WithError(err).WithField("url", self.config.url).
+				Errorf("events rejected by http endpoint, dropping %d bytes of events", len(b.body))
+			return
+		}
+		self.spoolOrDrop(b, err)
+	}
+}
+
+// drainSpool attempts to deliver all spooled batches, returning true if the spool is empty when done
+func (self *httpWriteCloser) drainSpool() bool {
+	if self.spool == nil {
+		return true
+	}
+
+	log := pfxlog.Logger().WithField("url", self.config.url)
+
+	for {
+		if self.ctx.Err() != nil {
+			return false
+		}
+
+		path, body, err := self.spool.next()
+		if path == "" {
+			if err != nil {
+				log.WithError(err).Error("unable to list spooled events")
+				return false
+			}
+			return true
+		}
+
+		if err == nil {
+			if err = self.send(body); err != nil {
+				if !isEventsRejectedError(err) {
+					log.WithError(err).Debug("http endpoint still unavailable, leaving events spooled")
+					return false
+				}
+				log.WithError(err).WithField("path", path).Error("spooled events rejected by http endpoint, discarding")
+			}
+		} else {
+			log.WithError(err).WithField("path", path).Error("unable to read spooled events, discarding")
+		}
+
+		if err = self.spool.remove(path); err != nil {
+			log.WithError(err).WithField("path", path).Error("unable to remove spooled events")
+			return false
+		}
+	}
+}
+
+func (self *httpWriteCloser) spoolOrDrop(b *eventBatch, cause error) {
+	log := pfxlog.Logger().WithField("url", self.config.url)
+	if self.spool == nil {
+		log.WithError(cause).Errorf("unable to deliver events to http endpoint, dropping %d bytes of events", len(b.body))
+		return
+	}
+
+	if err := self.spool.store(b.body, b.created); err != nil {
+		log.WithError(err).Errorf("unable to spool events, dropping %d bytes of events", len(b.body))
+		return
+	}
+	log.WithError(cause).Debug("events spooled for later delivery")
+}
+
+func (self *httpWriteCloser) sendWithRetry(body []byte) error {
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = self.config.initialBackoff
+	expBackoff.MaxInterval = self.config.maxBackoff
+	expBackoff.MaxElapsedTime = 0
+
+	policy := backoff.WithContext(backoff.WithMaxRetries(expBackoff, uint64(self.config.maxRetries)), self.ctx)
+
+	return backoff.Retry(func() error {
+		return self.send(body)
+	}, policy)
+}
+
+func (self *httpWriteCloser) send(body []byte) error {
+	req, err := http.NewRequestWithContext(self.ctx, http.MethodPost, self.config.url, bytes.NewReader(body))
+	if err != nil {
+		return backoff.Permanent(eventsRejectedError{error: err})
+	}
+
+	req.Header.Set("Content-Type", self.config.contentType)
+	for k, v := range self.config.headers {
+		req.Header.Set(k, v)
+	}
+	if self.config.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+self.config.bearerToken)
+	}
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	err = errors.Errorf("http event endpoint returned status %d", resp.StatusCode)
+
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return backoff.Permanent(eventsRejectedError{error: err})
+	}
+
+	return err
+}
+
+// eventsRejectedError indicates a batch which will never be accepted by the endpoint, so shouldn't be
+// retried or spooled
+type eventsRejectedError struct {
+	error
+}
+
+func (self eventsRejectedError) Unwrap() error {
+	return self.error
+}
+
+func isEventsRejectedError(err error) bool {
+	var rejectedErr eventsRejectedError
+	return errors.As(err, &rejectedErr)
+}
+
+func (self *httpWriteCloser) Write(data []byte) (int, error) {
+	if self.closed.Load() {
+		return 0, errors.New("http event handler closed")
+	}
+	select {
+	case self.messages <- data:
+		return len(data), nil
+	default:
+		return 0, errors.New("http event queue full, dropping event")
+	}
+}
+
+func (self *httpWriteCloser) Close() error {
+	if self.closed.CompareAndSwap(false, true) {
+		self.cancel()
+		self.done.Wait()
+	}
+	return nil
+}
+
+// eventSpool is a directory of undelivered event batches, one per file, bounded by total size. When the
+// size limit is exceeded, the oldest batches are discarded.
+type eventSpool struct {
+	sync.Mutex
+	dir     string
+	maxSize int64
+	seq     atomic.Uint64
+}
+
+func newEventSpool(dir string, maxSize int64) (*eventSpool, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "unable to create event spool directory '%s'", dir)
+	}
+	return &eventSpool{
+		dir:     dir,
+		maxSize: maxSize,
+	}, nil
+}
+
+// store writes a batch to the spool. Batches are named, and so delivered, in order of creation time
+func (self *eventSpool) store(body []byte, created time.Time) error {
+	self.Lock()
+	defer self.Unlock()
+
+	name := fmt.Sprintf("%020d-%010d%s", created.UnixNano(), self.seq.Add(1), spoolFileSuffix)
+	tmpPath := filepath.Join(self.dir, name+".tmp")
+	if err := os.WriteFile(tmpPath, body, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, filepath.Join(self.dir, name)); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	return self.enforceMaxSize()
+}
+
+func (self *eventSpool) enforceMaxSize() error {
+	entries, err := self.list()
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	for _, entry := range entries {
+		total += entry.size
+	}
+
+	for len(entries) > 0 && total > self.maxSize {
+		oldest := entries[0]
+		pfxlog.Logger().WithField("path", oldest.path).Warnf("event spool size limit exceeded, discarding %d bytes of oldest events", oldest.size)
+		if err = os.Remove(oldest.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		total -= oldest.size
+		entries = entries[1:]
+	}
+
+	return nil
+}
+
+type spoolEntry struct {
+	path string
+	size int64
+}
+
+func (self *eventSpool) list() ([]spoolEntry, error) {
+	dirEntries, err := os.ReadDir(self.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []spoolEntry
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), spoolFileSuffix) {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		result = append(result, spoolEntry{
+			path: filepath.Join(self.dir, dirEntry.Name()),
+			size: info.Size(),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].path < result[j].path
+	})
+
+	return result, nil
+}
+
+// next returns the oldest spooled batch. If the spool is empty, the returned path will be empty
+func (self *eventSpool) next() (string, []byte, error) {
+	self.Lock()
+	defer self.Unlock()
+
+	entries, err := self.list()
+	if err != nil || len(entries) == 0 {
+		return "", nil, err
+	}
+
+	body, err := os.ReadFile(entries[0].path)
+	return entries[0].path, body, err
+}
+
+func (self *eventSpool) hasPending() bool {
+	self.Lock()
+	defer self.Unlock()
+
+	entries, err := self.list()
+	return err != nil || len(entries) > 0
+}
+
+func (self *eventSpool) remove(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func NewHTTPEventLogger(formatterFactory LoggingHandlerFactory, config map[interface{}]interface{}) (interface{}, error) {
+	bufferSize := 10
+	if value, found := config["bufferSize"]; found {
+		if size, ok := value.(int); ok {
+			bufferSize = size
+		}
+	}
+
+	conf, err := parseHTTPConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse http event handler config")
+	}
+
+	if value, found := config["format"]; found {
+		if format, ok := value.(string); ok {
+			conf.binary = isBinaryEventFormat(format)
+			if _, found := config["contentType"]; !found && !strings.EqualFold(format, FormatJson) {
+				conf.contentType = eventFormatContentType(format)
+			}
+			out, err := newHTTPWriteCloser(conf)
+			if err != nil {
+				return nil, err
+			}
+			handler, err := formatterFactory.NewLoggingHandler(format, bufferSize, out)
+			if err != nil {
+				_ = out.Close()
+				return nil, err
+			}
+			return handler, nil
+		}
+		return nil, errors.New("invalid 'format' for event http handler")
+	}
+	return nil, errors.New("'format' must be specified for event handler")
+}
@@ -0,0 +1,276 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testHttpCollector struct {
+	sync.Mutex
+	available atomic.Bool
+	status    atomic.Int32
+	requests  atomic.Int32
+	lines     []string
+	auth      []string
+}
+
+func (self *testHttpCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	self.requests.Add(1)
+	if status := self.status.Load(); status != 0 {
+		w.WriteHeader(int(status))
+		return
+	}
+	if !self.available.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	self.Lock()
+	defer self.Unlock()
+	self.auth = append(self.auth, r.Header.Get("Authorization"))
+	for _, line := range strings.Split(strings.TrimSpace(string(body)), "\n") {
+		self.lines = append(self.lines, line)
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (self *testHttpCollector) getLines() []string {
+	self.Lock()
+	defer self.Unlock()
+	return append([]string(nil), self.lines...)
+}
+
+func Test_HttpEventLogger_Batches(t *testing.T) {
+	req := require.New(t)
+
+	collector := &testHttpCollector{}
+	collector.available.Store(true)
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	conf, err := parseHTTPConfig(map[interface{}]interface{}{
+		"url":           server.URL,
+		"bearerToken":   "secret",
+		"batchSize":     3,
+		"batchInterval": "50ms",
+	})
+	req.NoError(err)
+
+	out, err := newHTTPWriteCloser(conf)
+	req.NoError(err)
+	defer func() { _ = out.Close() }()
+
+	for _, m := range []string{"a", "b", "c", "d"} {
+		_, err = out.Write([]byte(m))
+		req.NoError(err)
+	}
+
+	req.Eventually(func() bool {
+		return len(collector.getLines()) == 4
+	}, 2*time.Second, 10*time.Millisecond)
+
+	req.Equal([]string{"a", "b", "c", "d"}, collector.getLines())
+
+	collector.Lock()
+	defer collector.Unlock()
+	for _, auth := range collector.auth {
+		req.Equal("Bearer secret", auth)
+	}
+}
+
+func Test_HttpEventLogger_SpoolsWhileUnavailable(t *testing.T) {
+	req := require.New(t)
+
+	collector := &testHttpCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	spoolDir := t.TempDir()
+
+	conf, err := parseHTTPConfig(map[interface{}]interface{}{
+		"url":            server.URL,
+		"batchSize":      1,
+		"batchInterval":  "20ms",
+		"maxRetries":     1,
+		"initialBackoff": "1ms",
+		"spoolDir":       spoolDir,
+	})
+	req.NoError(err)
+
+	out, err := newHTTPWriteCloser(conf)
+	req.NoError(err)
+	defer func() { _ = out.Close() }()
+
+	for _, m := range []string{"1", "2", "3"} {
+		_, err = out.Write([]byte(m))
+		req.NoError(err)
+	}
+
+	req.Eventually(func() bool {
+		entries, _ := os.ReadDir(spoolDir)
+		return len(entries) == 3
+	}, 2*time.Second, 10*time.Millisecond)
+
+	collector.available.Store(true)
+
+	req.Eventually(func() bool {
+		return len(collector.getLines()) == 3
+	}, 2*time.Second, 10*time.Millisecond)
+
+	req.Equal([]string{"1", "2", "3"}, collector.getLines())
+
+	entries, err := os.ReadDir(spoolDir)
+	req.NoError(err)
+	req.Empty(entries)
+}
+
+func Test_EventSpool_EnforcesMaxSize(t *testing.T) {
+	req := require.New(t)
+
+	spool, err := newEventSpool(t.TempDir(), 10)
+	req.NoError(err)
+
+	now := time.Now()
+	req.NoError(spool.store([]byte("12345"), now))
+	req.NoError(spool.store([]byte("abcde"), now.Add(2*time.Second)))
+	// created before the previous batch, so should be ordered ahead of it
+	req.NoError(spool.store([]byte("67890"), now.Add(time.Second)))
+
+	path, body, err := spool.next()
+	req.NoError(err)
+	req.Equal("67890", string(body))
+	req.NoError(spool.remove(path))
+
+	path, body, err = spool.next()
+	req.NoError(err)
+	req.Equal("abcde", string(body))
+	req.NoError(spool.remove(path))
+
+	path, _, err = spool.next()
+	req.NoError(err)
+	req.Equal("", path)
+}
+
+func Test_HttpEventLogger_DropsRejectedBatches(t *testing.T) {
+	req := require.New(t)
+
+	collector := &testHttpCollector{}
+	collector.status.Store(http.StatusBadRequest)
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	spoolDir := t.TempDir()
+
+	conf, err := parseHTTPConfig(map[interface{}]interface{}{
+		"url":            server.URL,
+		"batchSize":      1,
+		"batchInterval":  "20ms",
+		"initialBackoff": "1ms",
+		"spoolDir":       spoolDir,
+	})
+	req.NoError(err)
+
+	out, err := newHTTPWriteCloser(conf)
+	req.NoError(err)
+	defer func() { _ = out.Close() }()
+
+	_, err = out.Write([]byte("bad"))
+	req.NoError(err)
+
+	req.Eventually(func() bool {
+		return collector.requests.Load() > 0
+	}, 2*time.Second, 10*time.Millisecond)
+
+	time.Sleep(50 * time.Millisecond)
+
+	// a 4xx is permanent, so the batch should be neither retried nor spooled
+	req.Equal(int32(1), collector.requests.Load())
+	entries, err := os.ReadDir(spoolDir)
+	req.NoError(err)
+	req.Empty(entries)
+}
+
+func Test_HttpEventLogger_AcceptsEventsWhileRetrying(t *testing.T) {
+	req := require.New(t)
+
+	collector := &testHttpCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	spoolDir := t.TempDir()
+
+	conf, err := parseHTTPConfig(map[interface{}]interface{}{
+		"url":            server.URL,
+		"queueSize":      2,
+		"batchSize":      1,
+		"batchInterval":  "10ms",
+		"maxRetries":     100,
+		"initialBackoff": "50ms",
+		"maxBackoff":     "50ms",
+		"spoolDir":       spoolDir,
+	})
+	req.NoError(err)
+
+	out, err := newHTTPWriteCloser(conf)
+	req.NoError(err)
+	defer func() { _ = out.Close() }()
+
+	var expected []string
+	for i := 0; i < 20; i++ {
+		m := fmt.Sprintf("%d", i)
+		expected = append(expected, m)
+		_, err = out.Write([]byte(m))
+		req.NoError(err)
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	collector.available.Store(true)
+
+	req.Eventually(func() bool {
+		return len(collector.getLines()) == len(expected)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	req.Equal(expected, collector.getLines())
+}
+
+func Test_HttpEventLogger_InvalidFormatClosesWriter(t *testing.T) {
+	req := require.New(t)
+
+	_, err := NewHTTPEventLogger(fabricFormatterFactory{}, map[interface{}]interface{}{
+		"url":    "http://localhost:1",
+		"format": "xml",
+	})
+	req.Error(err)
+
+	_, err = parseHTTPConfig(map[interface{}]interface{}{
+		"url":                "http://localhost:1",
+		"insecureSkipVerify": "yes",
+	})
+	req.Error(err)
+}
@@ -0,0 +1,396 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+)
+
+// formattedEventNamespaces maps the event types reported by formatters to the event namespaces used in subscriptions
+var formattedEventNamespaces = map[string]string{
+	"circuit":       event.CircuitEventsNs,
+	"link":          event.LinkEventsNs,
+	"metrics":       event.MetricsEventsNs,
+	"router":        event.RouterEventsNs,
+	"service":       event.ServiceEventsNs,
+	"terminator":    event.TerminatorEventsNs,
+	"usage":         event.UsageEventsNs,
+	"usage.v3":      event.UsageEventsNs,
+	"cluster":       event.ClusterEventsNs,
+	"entity.change": event.EntityChangeEventsNs,
+	"session":       event.SessionEventNS,
+	"apiSession":    event.ApiSessionEventNS,
+	"entityCount":   event.EntityCountEventNS,
+}
+
+type KafkaEventLoggerFactory struct{}
+
+func (KafkaEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewKafkaEventLogger(fabricFormatterFactory{}, config)
+}
+
+type kafkaConfig struct {
+	brokers            []string
+	defaultTopic       string
+	topics             map[string]string
+	clientId           string
+	batchSize          int
+	batchTimeout       time.Duration
+	writeTimeout       time.Duration
+	requiredAcks       kafka.RequiredAcks
+	autoCreateTopics   bool
+	tls                bool
+	caFile             string
+	certFile           string
+	keyFile            string
+	insecureSkipVerify bool
+	saslMechanism      string
+	saslUsername       string
+	saslPassword       string
+}
+
+func parseKafkaConfig(config map[interface{}]interface{}) (*kafkaConfig, error) {
+	ret := &kafkaConfig{
+		topics:       map[string]string{},
+		clientId:     "ziti-controller",
+		batchSize:    100,
+		batchTimeout: time.Second,
+		writeTimeout: 10 * time.Second,
+		requiredAcks: kafka.RequireOne,
+	}
+
+	value, found := config["brokers"]
+	if !found {
+		return nil, errors.New("missing kafka brokers")
+	}
+
+	switch brokers := value.(type) {
+	case string:
+		ret.brokers = strings.Split(brokers, ",")
+	case []interface{}:
+		for _, broker := range brokers {
+			ret.brokers = append(ret.brokers, fmt.Sprintf("%v", broker))
+		}
+	default:
+		return nil, errors.New("invalid kafka brokers, must be a list of host:port addresses")
+	}
+
+	if len(ret.brokers) == 0 {
+		return nil, errors.New("no kafka brokers provided")
+	}
+
+	if value, found = config["topic"]; found {
+		if topic, ok := value.(string); ok {
+			ret.defaultTopic = topic
+		} else {
+			return nil, errors.New("invalid kafka 'topic', must be a string")
+		}
+	}
+
+	if value, found = config["topics"]; found {
+		topics, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("invalid kafka 'topics', must be a map of event namespace to topic")
+		}
+		for k, v := range topics {
+			ret.topics[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	if ret.defaultTopic == "" && len(ret.topics) == 0 {
+		return nil, errors.New("kafka event handler requires either 'topic' or 'topics' to be specified")
+	}
+
+	if value, found = config["clientId"]; found {
+		if clientId, ok := value.(string); ok {
+			ret.clientId = clientId
+		} else {
+			return nil, errors.New("invalid kafka 'clientId', must be a string")
+		}
+	}
+
+	if value, found = config["batchSize"]; found {
+		if batchSize, ok := value.(int); ok && batchSize > 0 {
+			ret.batchSize = batchSize
+		} else {
+			return nil, errors.New("invalid kafka 'batchSize', must be a positive integer")
+		}
+	}
+
+	var err error
+	if ret.batchTimeout, err = parseDurationConfig(config, "batchTimeout", ret.batchTimeout); err != nil {
+		return nil, err
+	}
+
+	if ret.writeTimeout, err = parseDurationConfig(config, "writeTimeout", ret.writeTimeout); err != nil {
+		return nil, err
+	}
+
+	if value, found = config["requiredAcks"]; found {
+		switch fmt.Sprintf("%v", value) {
+		case "none", "0":
+			ret.requiredAcks = kafka.RequireNone
+		case "one", "1":
+			ret.requiredAcks = kafka.RequireOne
+		case "all", "-1":
+			ret.requiredAcks = kafka.RequireAll
+		default:
+			return nil, errors.Errorf("invalid kafka 'requiredAcks' value %v, must be one of none, one or all", value)
+		}
+	}
+
+	boolFields := map[string]*bool{
+		"autoCreateTopics":   &ret.autoCreateTopics,
+		"tls":                &ret.tls,
+		"insecureSkipVerify": &ret.insecureSkipVerify,
+	}
+
+	for k, target := range boolFields {
+		if value, found = config[k]; found {
+			if b, ok := value.(bool); ok {
+				*target = b
+			} else {
+				return nil, errors.Errorf("invalid kafka '%s', must be a boolean", k)
+			}
+		}
+	}
+
+	stringFields := map[string]*string{
+		"ca":   &ret.caFile,
+		"cert": &ret.certFile,
+		"key":  &ret.keyFile,
+	}
+
+	for k, target := range stringFields {
+		if value, found = config[k]; found {
+			if s, ok := value.(string); ok {
+				*target = s
+				ret.tls = true
+			} else {
+				return nil, errors.Errorf("invalid kafka '%s', must be a string", k)
+			}
+		}
+	}
+
+	if (ret.certFile == "") != (ret.keyFile == "") {
+		return nil, errors.New("kafka 'cert' and 'key' must be specified together")
+	}
+
+	if value, found = config["sasl"]; found {
+		saslMap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("invalid kafka 'sasl', must be a map")
+		}
+		mechanism, found := saslMap["mechanism"]
+		if !found {
+			return nil, errors.New("kafka 'sasl' requires a 'mechanism'")
+		}
+		if mechanismStr, ok := mechanism.(string); ok && mechanismStr != "" {
+			ret.saslMechanism = strings.ToLower(mechanismStr)
+		} else {
+			return nil, errors.New("invalid kafka sasl 'mechanism', must be a string")
+		}
+		if v, found := saslMap["username"]; found {
+			ret.saslUsername = fmt.Sprintf("%v", v)
+		}
+		if v, found := saslMap["password"]; found {
+			ret.saslPassword = fmt.Sprintf("%v", v)
+		}
+		if _, err = ret.newSaslMechanism(); err != nil {
+			return nil, err
+		}
+	}
+
+	return ret, nil
+}
+
+func (self *kafkaConfig) newSaslMechanism() (sasl.Mechanism, error) {
+	switch self.saslMechanism {
+	case "":
+		return nil, nil
+	case "plain":
+		return plain.Mechanism{
+			Username: self.saslUsername,
+			Password: self.saslPassword,
+		}, nil
+	case "scram-sha-256":
+		return scram.Mechanism(scram.SHA256, self.saslUsername, self.saslPassword)
+	case "scram-sha-512":
+		return scram.Mechanism(scram.SHA512, self.saslUsername, self.saslPassword)
+	default:
+		return nil, errors.Errorf("unsupported kafka sasl mechanism '%s', must be one of plain, scram-sha-256 or scram-sha-512", self.saslMechanism)
+	}
+}
+
+func (self *kafkaConfig) newWriter() (*kafka.Writer, error) {
+	transport := &kafka.Transport{
+		ClientID: self.clientId,
+	}
+
+	if self.tls {
+		tlsConfig, err := newEventHandlerTlsConfig(self.caFile, self.certFile, self.keyFile, self.insecureSkipVerify)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLS = tlsConfig
+	}
+
+	mechanism, err := self.newSaslMechanism()
+	if err != nil {
+		return nil, err
+	}
+	transport.SASL = mechanism
+
+	return &kafka.Writer{
+		Addr:                   kafka.TCP(self.brokers...),
+		Balancer:               &kafka.Hash{},
+		BatchSize:              self.batchSize,
+		BatchTimeout:           self.batchTimeout,
+		WriteTimeout:           self.writeTimeout,
+		RequiredAcks:           self.requiredAcks,
+		AllowAutoTopicCreation: self.autoCreateTopics,
+		Async:                  true,
+		Transport:              transport,
+	}, nil
+}
+
+func (self *kafkaConfig) getTopic(eventType string) string {
+	if ns, found := formattedEventNamespaces[eventType]; found {
+		if topic, found := self.topics[ns]; found {
+			return topic
+		}
+	}
+
+	// also allow topics to be configured by the formatted event type, ex: usage.v3
+	if topic, found := self.topics[eventType]; found {
+		return topic
+	}
+
+	return self.defaultTopic
+}
+
+// kafkaEventSink publishes formatted events to kafka. Events are keyed by the id of the entity they pertain
+// to, such as the circuit or identity, so that all events for a given entity land on the same partition and
+// are consumed in order.
+type kafkaEventSink struct {
+	config *kafkaConfig
+	writer *kafka.Writer
+	closed atomic.Bool
+}
+
+func newKafkaEventSink(config *kafkaConfig) (*kafkaEventSink, error) {
+	writer, err := config.newWriter()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &kafkaEventSink{
+		config: config,
+		writer: writer,
+	}
+
+	writer.Completion = result.onCompletion
+
+	return result, nil
+}
+
+func (self *kafkaEventSink) onCompletion(messages []kafka.Message, err error) {
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("brokers", self.config.brokers).
+			Errorf("failed to publish %d events to kafka", len(messages))
+	}
+}
+
+func (self *kafkaEventSink) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	self.AcceptKeyedFormattedEvent(eventType, "", formattedEvent)
+}
+
+func (self *kafkaEventSink) AcceptKeyedFormattedEvent(eventType string, key string, formattedEvent []byte) {
+	if self.closed.Load() {
+		return
+	}
+
+	topic := self.config.getTopic(eventType)
+	if topic == "" {
+		pfxlog.Logger().WithField("eventType", eventType).Debug("no kafka topic configured for event type, dropping event")
+		return
+	}
+
+	msg := kafka.Message{
+		Topic: topic,
+		Value: formattedEvent,
+	}
+
+	if key != "" {
+		msg.Key = []byte(key)
+	}
+
+	// writer is async, so this only fails if the writer is closed or the message is invalid
+	if err := self.writer.WriteMessages(context.Background(), msg); err != nil {
+		pfxlog.Logger().WithError(err).WithField("topic", topic).Error("failed to queue event for kafka")
+	}
+}
+
+func (self *kafkaEventSink) Write(data []byte) (int, error) {
+	self.AcceptFormattedEvent("", data)
+	return len(data), nil
+}
+
+func (self *kafkaEventSink) Close() error {
+	if self.closed.CompareAndSwap(false, true) {
+		return self.writer.Close()
+	}
+	return nil
+}
+
+func NewKafkaEventLogger(formatterFactory LoggingHandlerFactory, config map[interface{}]interface{}) (interface{}, error) {
+	bufferSize := 10
+	if value, found := config["bufferSize"]; found {
+		if size, ok := value.(int); ok {
+			bufferSize = size
+		}
+	}
+
+	conf, err := parseKafkaConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse kafka config")
+	}
+
+	if value, found := config["format"]; found {
+		if format, ok := value.(string); ok {
+			sink, err := newKafkaEventSink(conf)
+			if err != nil {
+				return nil, err
+			}
+			return formatterFactory.NewLoggingHandler(format, bufferSize, sink)
+		}
+		return nil, errors.New("invalid 'format' for event kafka handler")
+	}
+	return nil, errors.New("'format' must be specified for event handler")
+}
@@ -0,0 +1,209 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/protocol"
+	"github.com/segmentio/kafka-go/protocol/metadata"
+	"github.com/segmentio/kafka-go/protocol/produce"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseKafkaConfig(t *testing.T) {
+	req := require.New(t)
+
+	conf, err := parseKafkaConfig(map[interface{}]interface{}{
+		"brokers": []interface{}{"k1:9092", "k2:9092"},
+		"topic":   "ziti-events",
+		"topics": map[interface{}]interface{}{
+			"fabric.circuits": "ziti-circuits",
+			"fabric.usage":    "ziti-usage",
+		},
+		"requiredAcks": "all",
+		"batchTimeout": "250ms",
+	})
+	req.NoError(err)
+	req.Equal([]string{"k1:9092", "k2:9092"}, conf.brokers)
+	req.Equal(kafka.RequireAll, conf.requiredAcks)
+	req.Equal(250*time.Millisecond, conf.batchTimeout)
+
+	req.Equal("ziti-circuits", conf.getTopic("circuit"))
+	req.Equal("ziti-usage", conf.getTopic("usage"))
+	req.Equal("ziti-usage", conf.getTopic("usage.v3"))
+	req.Equal("ziti-events", conf.getTopic("session"))
+
+	_, err = parseKafkaConfig(map[interface{}]interface{}{
+		"brokers": "k1:9092",
+	})
+	req.Error(err)
+
+	_, err = parseKafkaConfig(map[interface{}]interface{}{
+		"topic": "ziti-events",
+	})
+	req.Error(err)
+
+	_, err = parseKafkaConfig(map[interface{}]interface{}{
+		"brokers": "k1:9092",
+		"topic":   "ziti-events",
+		"sasl": map[interface{}]interface{}{
+			"username": "user",
+		},
+	})
+	req.ErrorContains(err, "mechanism")
+
+	_, err = parseKafkaConfig(map[interface{}]interface{}{
+		"brokers":  "k1:9092",
+		"topic":    "ziti-events",
+		"clientId": 5,
+	})
+	req.Error(err)
+}
+
+type testKeyedSink struct {
+	keys chan string
+}
+
+func (self *testKeyedSink) AcceptFormattedEvent(string, []byte) {
+	self.keys <- ""
+}
+
+func (self *testKeyedSink) AcceptKeyedFormattedEvent(_ string, key string, _ []byte) {
+	self.keys <- key
+}
+
+func Test_FormatterPassesEventKeys(t *testing.T) {
+	req := require.New(t)
+
+	sink := &testKeyedSink{keys: make(chan string, 1)}
+	formatter := NewJsonFormatter(1, sink)
+	defer func() { _ = formatter.Close() }()
+
+	formatter.AcceptCircuitEvent(&event.CircuitEvent{CircuitId: "circuit1"})
+	req.Equal("circuit1", <-sink.keys)
+
+	formatter.AcceptSessionEvent(&event.SessionEvent{Id: "session1", IdentityId: "identity1"})
+	req.Equal("identity1", <-sink.keys)
+
+	formatter.AcceptMetricsEvent(&event.MetricsEvent{})
+	req.Equal("", <-sink.keys)
+}
+
+type producedMessage struct {
+	topic string
+	key   string
+	value string
+}
+
+// testKafkaTransport is a kafka.RoundTripper which answers metadata requests for any topic and records
+// produced messages, so the writer can be exercised without a broker
+type testKafkaTransport struct {
+	messages chan producedMessage
+}
+
+func (self *testKafkaTransport) RoundTrip(_ context.Context, _ net.Addr, req kafka.Request) (kafka.Response, error) {
+	switch r := req.(type) {
+	case *metadata.Request:
+		resp := &metadata.Response{
+			Brokers: []metadata.ResponseBroker{{NodeID: 1, Host: "localhost", Port: 9092}},
+		}
+		for _, topic := range r.TopicNames {
+			resp.Topics = append(resp.Topics, metadata.ResponseTopic{
+				Name: topic,
+				Partitions: []metadata.ResponsePartition{
+					{PartitionIndex: 0, LeaderID: 1},
+					{PartitionIndex: 1, LeaderID: 1},
+				},
+			})
+		}
+		return resp, nil
+	case *produce.Request:
+		resp := &produce.Response{}
+		for _, topic := range r.Topics {
+			respTopic := produce.ResponseTopic{Topic: topic.Topic}
+			for _, partition := range topic.Partitions {
+				for {
+					record, err := partition.RecordSet.Records.ReadRecord()
+					if err != nil {
+						break
+					}
+					key, _ := protocol.ReadAll(record.Key)
+					value, _ := protocol.ReadAll(record.Value)
+					self.messages <- producedMessage{topic: topic.Topic, key: string(key), value: string(value)}
+				}
+				respTopic.Partitions = append(respTopic.Partitions, produce.ResponsePartition{Partition: partition.Partition})
+			}
+			resp.Topics = append(resp.Topics, respTopic)
+		}
+		return resp, nil
+	}
+	return nil, fmt.Errorf("unexpected kafka request type %T", req)
+}
+
+func Test_KafkaEventSink_RoutesAndKeysEvents(t *testing.T) {
+	req := require.New(t)
+
+	conf, err := parseKafkaConfig(map[interface{}]interface{}{
+		"brokers":      "localhost:9092",
+		"batchSize":    1,
+		"batchTimeout": "10ms",
+		"topics": map[interface{}]interface{}{
+			"fabric.circuits": "ziti-circuits",
+			"edge.sessions":   "ziti-sessions",
+		},
+	})
+	req.NoError(err)
+
+	sink, err := newKafkaEventSink(conf)
+	req.NoError(err)
+	defer func() { _ = sink.Close() }()
+
+	transport := &testKafkaTransport{messages: make(chan producedMessage, 10)}
+	sink.writer.Transport = transport
+
+	next := func() producedMessage {
+		select {
+		case msg := <-transport.messages:
+			return msg
+		case <-time.After(2 * time.Second):
+			req.FailNow("timed out waiting for kafka message")
+			return producedMessage{}
+		}
+	}
+
+	sink.AcceptKeyedFormattedEvent("circuit", "circuit1", []byte("c1"))
+	req.Equal(producedMessage{topic: "ziti-circuits", key: "circuit1", value: "c1"}, next())
+
+	// no topic configured for metrics and no default topic, so this should be dropped
+	sink.AcceptKeyedFormattedEvent("metrics", "", []byte("m1"))
+
+	sink.AcceptKeyedFormattedEvent("session", "identity1", []byte("s1"))
+	req.Equal(producedMessage{topic: "ziti-sessions", key: "identity1", value: "s1"}, next())
+
+	select {
+	case msg := <-transport.messages:
+		req.FailNow("unexpected kafka message", "%+v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
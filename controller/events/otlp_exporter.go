@@ -0,0 +1,173 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// otlpExporter sends OTLP requests to a collector
+type otlpExporter interface {
+	exportMetrics(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) error
+	exportLogs(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error
+	exportTraces(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) error
+	close() error
+}
+
+type otlpGrpcExporter struct {
+	conn    *grpc.ClientConn
+	headers metadata.MD
+	metrics colmetricspb.MetricsServiceClient
+	logs    collogspb.LogsServiceClient
+	traces  coltracepb.TraceServiceClient
+}
+
+func newOtlpGrpcExporter(config *otlpConfig) (*otlpGrpcExporter, error) {
+	creds := insecure.NewCredentials()
+	if !config.insecure {
+		tlsConfig, err := newEventHandlerTlsConfig(config.caFile, config.certFile, config.keyFile, config.insecureSkipVerify)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.NewClient(config.endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create otlp grpc client for %v", config.endpoint)
+	}
+
+	return &otlpGrpcExporter{
+		conn:    conn,
+		headers: metadata.New(config.headers),
+		metrics: colmetricspb.NewMetricsServiceClient(conn),
+		logs:    collogspb.NewLogsServiceClient(conn),
+		traces:  coltracepb.NewTraceServiceClient(conn),
+	}, nil
+}
+
+func (self *otlpGrpcExporter) withHeaders(ctx context.Context) context.Context {
+	if len(self.headers) == 0 {
+		return ctx
+	}
+	return metadata.NewOutgoingContext(ctx, self.headers)
+}
+
+func (self *otlpGrpcExporter) exportMetrics(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) error {
+	_, err := self.metrics.Export(self.withHeaders(ctx), req)
+	return err
+}
+
+func (self *otlpGrpcExporter) exportLogs(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	_, err := self.logs.Export(self.withHeaders(ctx), req)
+	return err
+}
+
+func (self *otlpGrpcExporter) exportTraces(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) error {
+	_, err := self.traces.Export(self.withHeaders(ctx), req)
+	return err
+}
+
+func (self *otlpGrpcExporter) close() error {
+	return self.conn.Close()
+}
+
+// otlpHttpExporter posts binary protobuf encoded requests to the standard OTLP/HTTP paths under the endpoint
+type otlpHttpExporter struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+}
+
+func newOtlpHttpExporter(config *otlpConfig) (*otlpHttpExporter, error) {
+	tlsConfig, err := newEventHandlerTlsConfig(config.caFile, config.certFile, config.keyFile, config.insecureSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &otlpHttpExporter{
+		endpoint: strings.TrimSuffix(config.endpoint, "/"),
+		headers:  config.headers,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   config.timeout,
+		},
+	}, nil
+}
+
+func (self *otlpHttpExporter) post(ctx context.Context, path string, msg proto.Message) error {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal otlp request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, self.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "unable to create otlp request")
+	}
+
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range self.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("otlp collector at %v returned status %v", self.endpoint+path, resp.Status)
+	}
+	return nil
+}
+
+func (self *otlpHttpExporter) exportMetrics(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) error {
+	return self.post(ctx, "/v1/metrics", req)
+}
+
+func (self *otlpHttpExporter) exportLogs(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	return self.post(ctx, "/v1/logs", req)
+}
+
+func (self *otlpHttpExporter) exportTraces(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) error {
+	return self.post(ctx, "/v1/traces", req)
+}
+
+func (self *otlpHttpExporter) close() error {
+	self.client.CloseIdleConnections()
+	return nil
+}
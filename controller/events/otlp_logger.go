@@ -0,0 +1,548 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/common/version"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+const (
+	OtlpProtocolGrpc = "grpc"
+	OtlpProtocolHttp = "http"
+)
+
+type OtlpEventLoggerFactory struct{}
+
+func (OtlpEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewOtlpEventLogger(config)
+}
+
+type otlpConfig struct {
+	protocol           string
+	endpoint           string
+	insecure           bool
+	caFile             string
+	certFile           string
+	keyFile            string
+	insecureSkipVerify bool
+	headers            map[string]string
+	interval           time.Duration
+	timeout            time.Duration
+	maxQueueSize       int
+	serviceName        string
+	resourceAttributes map[string]string
+}
+
+func parseOtlpConfig(config map[interface{}]interface{}) (*otlpConfig, error) {
+	ret := &otlpConfig{
+		protocol:           OtlpProtocolGrpc,
+		headers:            map[string]string{},
+		interval:           10 * time.Second,
+		timeout:            10 * time.Second,
+		maxQueueSize:       2048,
+		serviceName:        "ziti-controller",
+		resourceAttributes: map[string]string{},
+	}
+
+	if value, found := config["protocol"]; found {
+		protocol, ok := value.(string)
+		if !ok {
+			return nil, errors.New("invalid otlp 'protocol', must be a string")
+		}
+		ret.protocol = strings.ToLower(protocol)
+		if ret.protocol != OtlpProtocolGrpc && ret.protocol != OtlpProtocolHttp {
+			return nil, errors.Errorf("invalid otlp 'protocol' %v, must be one of grpc or http", protocol)
+		}
+	}
+
+	if ret.protocol == OtlpProtocolGrpc {
+		ret.endpoint = "localhost:4317"
+	} else {
+		ret.endpoint = "http://localhost:4318"
+	}
+
+	stringFields := map[string]*string{
+		"endpoint":    &ret.endpoint,
+		"ca":          &ret.caFile,
+		"cert":        &ret.certFile,
+		"key":         &ret.keyFile,
+		"serviceName": &ret.serviceName,
+	}
+
+	for k, target := range stringFields {
+		if value, found := config[k]; found {
+			if s, ok := value.(string); ok {
+				*target = s
+			} else {
+				return nil, errors.Errorf("invalid otlp '%s', must be a string", k)
+			}
+		}
+	}
+
+	if (ret.certFile == "") != (ret.keyFile == "") {
+		return nil, errors.New("otlp 'cert' and 'key' must be specified together")
+	}
+
+	boolFields := map[string]*bool{
+		"insecure":           &ret.insecure,
+		"insecureSkipVerify": &ret.insecureSkipVerify,
+	}
+
+	for k, target := range boolFields {
+		if value, found := config[k]; found {
+			if b, ok := value.(bool); ok {
+				*target = b
+			} else {
+				return nil, errors.Errorf("invalid otlp '%s', must be a boolean", k)
+			}
+		}
+	}
+
+	mapFields := map[string]map[string]string{
+		"headers":            ret.headers,
+		"resourceAttributes": ret.resourceAttributes,
+	}
+
+	for k, target := range mapFields {
+		if value, found := config[k]; found {
+			m, ok := value.(map[interface{}]interface{})
+			if !ok {
+				return nil, errors.Errorf("invalid otlp '%s', must be a map", k)
+			}
+			for mk, mv := range m {
+				target[fmt.Sprintf("%v", mk)] = fmt.Sprintf("%v", mv)
+			}
+		}
+	}
+
+	if value, found := config["maxQueueSize"]; found {
+		if size, ok := value.(int); ok && size > 0 {
+			ret.maxQueueSize = size
+		} else {
+			return nil, errors.New("invalid otlp 'maxQueueSize', must be a positive integer")
+		}
+	}
+
+	var err error
+	if ret.interval, err = parseDurationConfig(config, "interval", ret.interval); err != nil {
+		return nil, err
+	}
+
+	if ret.timeout, err = parseDurationConfig(config, "timeout", ret.timeout); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (self *otlpConfig) newExporter() (otlpExporter, error) {
+	if self.protocol == OtlpProtocolHttp {
+		return newOtlpHttpExporter(self)
+	}
+	return newOtlpGrpcExporter(self)
+}
+
+// otlpEventLogger pushes metrics, circuit and link events to an OpenTelemetry collector. Metrics events are
+// exported as OTLP metrics, circuit and link events as OTLP logs and circuit lifecycles as spans. All
+// records for a circuit share a trace id derived from the circuit id, so logs and spans can be correlated.
+//
+// Records are queued and exported every interval. If the queue is full, or the collector can't be reached,
+// records are dropped and a warning is logged.
+type otlpEventLogger struct {
+	config    *otlpConfig
+	exporter  otlpExporter
+	resource  *resourcepb.Resource
+	scope     *commonpb.InstrumentationScope
+	startTime uint64
+
+	lock    sync.Mutex
+	metrics []*metricspb.Metric
+	logs    []*logspb.LogRecord
+	spans   []*tracepb.Span
+	dropped uint64
+
+	closeNotify chan struct{}
+	closed      atomic.Bool
+	done        sync.WaitGroup
+}
+
+func NewOtlpEventLogger(config map[interface{}]interface{}) (*otlpEventLogger, error) {
+	conf, err := parseOtlpConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse otlp config")
+	}
+	return newOtlpEventLogger(conf)
+}
+
+func newOtlpEventLogger(config *otlpConfig) (*otlpEventLogger, error) {
+	exporter, err := config.newExporter()
+	if err != nil {
+		return nil, err
+	}
+
+	resource := &resourcepb.Resource{
+		Attributes: []*commonpb.KeyValue{
+			otlpStringAttr("service.name", config.serviceName),
+			otlpStringAttr("service.version", version.GetVersion()),
+		},
+	}
+
+	if hostname, err := os.Hostname(); err == nil {
+		resource.Attributes = append(resource.Attributes, otlpStringAttr("host.name", hostname))
+	}
+	resource.Attributes = append(resource.Attributes, otlpStringAttrs(config.resourceAttributes)...)
+
+	result := &otlpEventLogger{
+		config:   config,
+		exporter: exporter,
+		resource: resource,
+		scope: &commonpb.InstrumentationScope{
+			Name:    "github.com/openziti/ziti/controller/events",
+			Version: version.GetVersion(),
+		},
+		startTime:   uint64(time.Now().UnixNano()),
+		closeNotify: make(chan struct{}),
+	}
+
+	result.done.Add(1)
+	go result.run()
+
+	return result, nil
+}
+
+func (self *otlpEventLogger) run() {
+	defer self.done.Done()
+
+	ticker := time.NewTicker(self.config.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.flush()
+		case <-self.closeNotify:
+			self.flush()
+			return
+		}
+	}
+}
+
+func (self *otlpEventLogger) Close() error {
+	if self.closed.CompareAndSwap(false, true) {
+		close(self.closeNotify)
+		self.done.Wait()
+		return self.exporter.close()
+	}
+	return nil
+}
+
+func (self *otlpEventLogger) flush() {
+	self.lock.Lock()
+	metrics, logs, spans, dropped := self.metrics, self.logs, self.spans, self.dropped
+	self.metrics, self.logs, self.spans, self.dropped = nil, nil, nil, 0
+	self.lock.Unlock()
+
+	log := pfxlog.Logger().WithField("endpoint", self.config.endpoint)
+
+	if dropped > 0 {
+		log.Warnf("otlp export queue full, dropped %d records", dropped)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), self.config.timeout)
+	defer cancel()
+
+	if len(metrics) > 0 {
+		err := self.exporter.exportMetrics(ctx, &colmetricspb.ExportMetricsServiceRequest{
+			ResourceMetrics: []*metricspb.ResourceMetrics{{
+				Resource:     self.resource,
+				ScopeMetrics: []*metricspb.ScopeMetrics{{Scope: self.scope, Metrics: metrics}},
+			}},
+		})
+		if err != nil {
+			log.WithError(err).Warnf("failed to export %d metrics, dropping", len(metrics))
+		}
+	}
+
+	if len(logs) > 0 {
+		err := self.exporter.exportLogs(ctx, &collogspb.ExportLogsServiceRequest{
+			ResourceLogs: []*logspb.ResourceLogs{{
+				Resource:  self.resource,
+				ScopeLogs: []*logspb.ScopeLogs{{Scope: self.scope, LogRecords: logs}},
+			}},
+		})
+		if err != nil {
+			log.WithError(err).Warnf("failed to export %d log records, dropping", len(logs))
+		}
+	}
+
+	if len(spans) > 0 {
+		err := self.exporter.exportTraces(ctx, &coltracepb.ExportTraceServiceRequest{
+			ResourceSpans: []*tracepb.ResourceSpans{{
+				Resource:   self.resource,
+				ScopeSpans: []*tracepb.ScopeSpans{{Scope: self.scope, Spans: spans}},
+			}},
+		})
+		if err != nil {
+			log.WithError(err).Warnf("failed to export %d spans, dropping", len(spans))
+		}
+	}
+}
+
+func (self *otlpEventLogger) queueSize() int {
+	return len(self.metrics) + len(self.logs) + len(self.spans)
+}
+
+func (self *otlpEventLogger) addMetrics(metrics ...*metricspb.Metric) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if self.queueSize()+len(metrics) > self.config.maxQueueSize {
+		self.dropped += uint64(len(metrics))
+		return
+	}
+	self.metrics = append(self.metrics, metrics...)
+}
+
+func (self *otlpEventLogger) addLogRecord(record *logspb.LogRecord, spans ...*tracepb.Span) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if self.queueSize()+1+len(spans) > self.config.maxQueueSize {
+		self.dropped += uint64(1 + len(spans))
+		return
+	}
+	self.logs = append(self.logs, record)
+	self.spans = append(self.spans, spans...)
+}
+
+func (self *otlpEventLogger) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	attrs := []*commonpb.KeyValue{otlpStringAttr("ziti.source_id", evt.SourceAppId)}
+	if evt.SourceEntityId != "" {
+		attrs = append(attrs, otlpStringAttr("ziti.source_entity_id", evt.SourceEntityId))
+	}
+	attrs = append(attrs, otlpStringAttrs(evt.Tags)...)
+
+	ts := uint64(evt.Timestamp.UnixNano())
+
+	var metrics []*metricspb.Metric
+	for key, value := range evt.Metrics {
+		dataPoint := &metricspb.NumberDataPoint{
+			Attributes:        attrs,
+			StartTimeUnixNano: self.startTime,
+			TimeUnixNano:      ts,
+		}
+
+		switch v := value.(type) {
+		case int64:
+			dataPoint.Value = &metricspb.NumberDataPoint_AsInt{AsInt: v}
+		case uint64:
+			dataPoint.Value = &metricspb.NumberDataPoint_AsInt{AsInt: int64(v)}
+		case int32:
+			dataPoint.Value = &metricspb.NumberDataPoint_AsInt{AsInt: int64(v)}
+		case int:
+			dataPoint.Value = &metricspb.NumberDataPoint_AsInt{AsInt: int64(v)}
+		case float64:
+			dataPoint.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: v}
+		case float32:
+			dataPoint.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: float64(v)}
+		default:
+			continue
+		}
+
+		metric := &metricspb.Metric{Name: evt.Metric}
+		if key != "value" {
+			metric.Name += "." + key
+		}
+
+		// meter, timer and histogram counts only ever go up, everything else is a point in time value
+		if key == "count" {
+			metric.Data = &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+				DataPoints:             []*metricspb.NumberDataPoint{dataPoint},
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+			}}
+		} else {
+			metric.Data = &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+				DataPoints: []*metricspb.NumberDataPoint{dataPoint},
+			}}
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	if len(metrics) > 0 {
+		self.addMetrics(metrics...)
+	}
+}
+
+func (self *otlpEventLogger) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	attrs := []*commonpb.KeyValue{
+		otlpStringAttr("ziti.circuit_id", evt.CircuitId),
+		otlpStringAttr("ziti.client_id", evt.ClientId),
+		otlpStringAttr("ziti.service_id", evt.ServiceId),
+		otlpStringAttr("ziti.terminator_id", evt.TerminatorId),
+	}
+	if evt.Path.IngressId != "" {
+		attrs = append(attrs, otlpStringAttr("ziti.ingress_router_id", evt.Path.IngressId))
+	}
+	if evt.Path.EgressId != "" {
+		attrs = append(attrs, otlpStringAttr("ziti.egress_router_id", evt.Path.EgressId))
+	}
+
+	traceId := otlpCircuitTraceId(evt.CircuitId)
+	circuitSpanId := otlpCircuitSpanId(evt.CircuitId, "circuit")
+	createSpanId := otlpCircuitSpanId(evt.CircuitId, "create")
+	end := uint64(evt.Timestamp.UnixNano())
+
+	var spans []*tracepb.Span
+	logSpanId := circuitSpanId
+
+	switch evt.EventType {
+	case event.CircuitCreated, event.CircuitFailed:
+		span := &tracepb.Span{
+			TraceId:           traceId,
+			SpanId:            createSpanId,
+			Name:              "circuit.create",
+			Kind:              tracepb.Span_SPAN_KIND_INTERNAL,
+			StartTimeUnixNano: otlpSpanStart(evt.Timestamp, evt.CreationTimespan),
+			EndTimeUnixNano:   end,
+			Attributes:        attrs,
+		}
+		if evt.EventType == event.CircuitFailed {
+			span.Status = &tracepb.Status{Code: tracepb.Status_STATUS_CODE_ERROR}
+			if evt.FailureCause != nil {
+				span.Status.Message = *evt.FailureCause
+			}
+		} else {
+			// the circuit span is only reported once the circuit is torn down, but we know its id up front
+			span.ParentSpanId = circuitSpanId
+			span.Status = &tracepb.Status{Code: tracepb.Status_STATUS_CODE_OK}
+		}
+		spans = append(spans, span)
+		logSpanId = createSpanId
+	case event.CircuitDeleted:
+		spans = append(spans, &tracepb.Span{
+			TraceId:           traceId,
+			SpanId:            circuitSpanId,
+			Name:              "circuit",
+			Kind:              tracepb.Span_SPAN_KIND_INTERNAL,
+			StartTimeUnixNano: otlpSpanStart(evt.Timestamp, evt.Duration),
+			EndTimeUnixNano:   end,
+			Attributes:        attrs,
+			Status:            &tracepb.Status{Code: tracepb.Status_STATUS_CODE_OK},
+		})
+	}
+
+	record := self.newLogRecord(evt.Namespace, string(evt.EventType), evt.Timestamp, evt.EventType == event.CircuitFailed, evt, attrs)
+	record.TraceId = traceId
+	record.SpanId = logSpanId
+
+	self.addLogRecord(record, spans...)
+}
+
+func (self *otlpEventLogger) AcceptLinkEvent(evt *event.LinkEvent) {
+	attrs := []*commonpb.KeyValue{
+		otlpStringAttr("ziti.link_id", evt.LinkId),
+		otlpStringAttr("ziti.src_router_id", evt.SrcRouterId),
+		otlpStringAttr("ziti.dst_router_id", evt.DstRouterId),
+	}
+	self.addLogRecord(self.newLogRecord(evt.Namespace, string(evt.EventType), evt.Timestamp, evt.EventType == event.LinkFault, evt, attrs))
+}
+
+func (self *otlpEventLogger) newLogRecord(namespace, eventType string, ts time.Time, warn bool, evt any, attrs []*commonpb.KeyValue) *logspb.LogRecord {
+	body, err := json.Marshal(evt)
+	if err != nil {
+		body = []byte(fmt.Sprintf("%v", evt))
+	}
+
+	result := &logspb.LogRecord{
+		TimeUnixNano:         uint64(ts.UnixNano()),
+		ObservedTimeUnixNano: uint64(time.Now().UnixNano()),
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		SeverityText:         "INFO",
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: string(body)}},
+		Attributes: append([]*commonpb.KeyValue{
+			otlpStringAttr("event.namespace", namespace),
+			otlpStringAttr("event.type", eventType),
+		}, attrs...),
+	}
+
+	if warn {
+		result.SeverityNumber = logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+		result.SeverityText = "WARN"
+	}
+
+	return result
+}
+
+func otlpSpanStart(end time.Time, duration *time.Duration) uint64 {
+	if duration != nil {
+		return uint64(end.Add(-*duration).UnixNano())
+	}
+	return uint64(end.UnixNano())
+}
+
+func otlpCircuitTraceId(circuitId string) []byte {
+	hash := sha256.Sum256([]byte(circuitId))
+	return hash[:16]
+}
+
+func otlpCircuitSpanId(circuitId string, name string) []byte {
+	hash := sha256.Sum256([]byte(circuitId + "/" + name))
+	return hash[:8]
+}
+
+func otlpStringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func otlpStringAttrs(m map[string]string) []*commonpb.KeyValue {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var result []*commonpb.KeyValue
+	for _, k := range keys {
+		result = append(result, otlpStringAttr(k, m[k]))
+	}
+	return result
+}
@@ -0,0 +1,308 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// testOtlpCollector is a stand-in for an OpenTelemetry collector, accepting OTLP over both gRPC and HTTP
+type testOtlpCollector struct {
+	sync.Mutex
+	metrics []*metricspb.Metric
+	logs    []*logspb.LogRecord
+	spans   []*tracepb.Span
+	headers []string
+}
+
+func (self *testOtlpCollector) addMetrics(req *colmetricspb.ExportMetricsServiceRequest) {
+	self.Lock()
+	defer self.Unlock()
+	for _, rm := range req.ResourceMetrics {
+		for _, sm := range rm.ScopeMetrics {
+			self.metrics = append(self.metrics, sm.Metrics...)
+		}
+	}
+}
+
+func (self *testOtlpCollector) addLogs(req *collogspb.ExportLogsServiceRequest) {
+	self.Lock()
+	defer self.Unlock()
+	for _, rl := range req.ResourceLogs {
+		for _, sl := range rl.ScopeLogs {
+			self.logs = append(self.logs, sl.LogRecords...)
+		}
+	}
+}
+
+func (self *testOtlpCollector) addTraces(req *coltracepb.ExportTraceServiceRequest) {
+	self.Lock()
+	defer self.Unlock()
+	for _, rs := range req.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			self.spans = append(self.spans, ss.Spans...)
+		}
+	}
+}
+
+func (self *testOtlpCollector) addHeader(v string) {
+	self.Lock()
+	defer self.Unlock()
+	self.headers = append(self.headers, v)
+}
+
+func (self *testOtlpCollector) counts() (int, int, int) {
+	self.Lock()
+	defer self.Unlock()
+	return len(self.metrics), len(self.logs), len(self.spans)
+}
+
+func (self *testOtlpCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil || r.Header.Get("Content-Type") != "application/x-protobuf" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	self.addHeader(r.Header.Get("X-Api-Key"))
+
+	switch r.URL.Path {
+	case "/v1/metrics":
+		req := &colmetricspb.ExportMetricsServiceRequest{}
+		err = proto.Unmarshal(body, req)
+		self.addMetrics(req)
+	case "/v1/logs":
+		req := &collogspb.ExportLogsServiceRequest{}
+		err = proto.Unmarshal(body, req)
+		self.addLogs(req)
+	case "/v1/traces":
+		req := &coltracepb.ExportTraceServiceRequest{}
+		err = proto.Unmarshal(body, req)
+		self.addTraces(req)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+type testOtlpMetricsService struct {
+	colmetricspb.UnimplementedMetricsServiceServer
+	*testOtlpCollector
+}
+
+func (self testOtlpMetricsService) Export(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	self.addHeader(strings.Join(md.Get("x-api-key"), ","))
+	self.addMetrics(req)
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+type testOtlpLogsService struct {
+	collogspb.UnimplementedLogsServiceServer
+	*testOtlpCollector
+}
+
+func (self testOtlpLogsService) Export(_ context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	self.addLogs(req)
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+type testOtlpTraceService struct {
+	coltracepb.UnimplementedTraceServiceServer
+	*testOtlpCollector
+}
+
+func (self testOtlpTraceService) Export(_ context.Context, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	self.addTraces(req)
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+func sendOtlpTestEvents(otlpLogger *otlpEventLogger) {
+	now := time.Now()
+	setup := 20 * time.Millisecond
+	duration := time.Minute
+
+	otlpLogger.AcceptMetricsEvent(&event.MetricsEvent{
+		SourceAppId: "ctrl1",
+		Timestamp:   now,
+		Metric:      "link.latency",
+		Metrics:     map[string]interface{}{"count": int64(10), "mean": 1.5},
+		Tags:        map[string]string{"linkId": "l1"},
+	})
+
+	otlpLogger.AcceptCircuitEvent(&event.CircuitEvent{
+		Namespace:        event.CircuitEventsNs,
+		EventType:        event.CircuitCreated,
+		CircuitId:        "c1",
+		Timestamp:        now,
+		CreationTimespan: &setup,
+	})
+
+	otlpLogger.AcceptCircuitEvent(&event.CircuitEvent{
+		Namespace: event.CircuitEventsNs,
+		EventType: event.CircuitDeleted,
+		CircuitId: "c1",
+		Timestamp: now.Add(duration),
+		Duration:  &duration,
+	})
+
+	otlpLogger.AcceptLinkEvent(&event.LinkEvent{
+		Namespace: event.LinkEventsNs,
+		EventType: event.LinkFault,
+		LinkId:    "l1",
+		Timestamp: now,
+	})
+}
+
+func verifyOtlpTestEvents(req *require.Assertions, collector *testOtlpCollector) {
+	req.Eventually(func() bool {
+		m, l, s := collector.counts()
+		return m == 2 && l == 3 && s == 2
+	}, 2*time.Second, 10*time.Millisecond)
+
+	collector.Lock()
+	defer collector.Unlock()
+
+	metrics := map[string]*metricspb.Metric{}
+	for _, m := range collector.metrics {
+		metrics[m.Name] = m
+	}
+	req.Equal(int64(10), metrics["link.latency.count"].GetSum().DataPoints[0].GetAsInt())
+	req.True(metrics["link.latency.count"].GetSum().IsMonotonic)
+	req.Equal(1.5, metrics["link.latency.mean"].GetGauge().DataPoints[0].GetAsDouble())
+
+	create, lifetime := collector.spans[0], collector.spans[1]
+	req.Equal("circuit.create", create.Name)
+	req.Equal("circuit", lifetime.Name)
+	req.Equal(create.TraceId, lifetime.TraceId)
+	req.Equal(lifetime.SpanId, create.ParentSpanId)
+	req.Equal(uint64(20*time.Millisecond), create.EndTimeUnixNano-create.StartTimeUnixNano)
+	req.Equal(uint64(time.Minute), lifetime.EndTimeUnixNano-lifetime.StartTimeUnixNano)
+
+	req.Equal(create.TraceId, collector.logs[0].TraceId)
+	req.Equal(logspb.SeverityNumber_SEVERITY_NUMBER_WARN, collector.logs[2].SeverityNumber)
+	req.Contains(collector.logs[2].Body.GetStringValue(), `"link_id":"l1"`)
+
+	for _, header := range collector.headers {
+		req.Equal("secret", header)
+	}
+}
+
+func Test_OtlpEventLogger_Grpc(t *testing.T) {
+	req := require.New(t)
+
+	collector := &testOtlpCollector{}
+	server := grpc.NewServer()
+	colmetricspb.RegisterMetricsServiceServer(server, testOtlpMetricsService{testOtlpCollector: collector})
+	collogspb.RegisterLogsServiceServer(server, testOtlpLogsService{testOtlpCollector: collector})
+	coltracepb.RegisterTraceServiceServer(server, testOtlpTraceService{testOtlpCollector: collector})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	req.NoError(err)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	otlpLogger, err := NewOtlpEventLogger(map[interface{}]interface{}{
+		"endpoint": listener.Addr().String(),
+		"insecure": true,
+		"interval": "20ms",
+		"headers":  map[interface{}]interface{}{"x-api-key": "secret"},
+	})
+	req.NoError(err)
+	defer func() { _ = otlpLogger.Close() }()
+
+	sendOtlpTestEvents(otlpLogger)
+	verifyOtlpTestEvents(req, collector)
+}
+
+func Test_OtlpEventLogger_Http(t *testing.T) {
+	req := require.New(t)
+
+	collector := &testOtlpCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	otlpLogger, err := NewOtlpEventLogger(map[interface{}]interface{}{
+		"protocol": "http",
+		"endpoint": server.URL,
+		"interval": "1h",
+		"headers":  map[interface{}]interface{}{"X-Api-Key": "secret"},
+	})
+	req.NoError(err)
+
+	sendOtlpTestEvents(otlpLogger)
+
+	// closing flushes anything still queued
+	req.NoError(otlpLogger.Close())
+	verifyOtlpTestEvents(req, collector)
+}
+
+func Test_OtlpEventLogger_DropsWhenQueueFull(t *testing.T) {
+	req := require.New(t)
+
+	conf, err := parseOtlpConfig(map[interface{}]interface{}{
+		"protocol":     "http",
+		"endpoint":     "http://127.0.0.1:1",
+		"interval":     "1h",
+		"maxQueueSize": 2,
+	})
+	req.NoError(err)
+
+	otlpLogger, err := newOtlpEventLogger(conf)
+	req.NoError(err)
+	defer func() { _ = otlpLogger.Close() }()
+
+	for i := 0; i < 3; i++ {
+		otlpLogger.AcceptLinkEvent(&event.LinkEvent{LinkId: "l1"})
+	}
+
+	otlpLogger.lock.Lock()
+	req.Len(otlpLogger.logs, 2)
+	req.Equal(uint64(1), otlpLogger.dropped)
+	otlpLogger.lock.Unlock()
+
+	_, err = parseOtlpConfig(map[interface{}]interface{}{"protocol": "thrift"})
+	req.Error(err)
+
+	_, err = parseOtlpConfig(map[interface{}]interface{}{"insecure": "yes"})
+	req.Error(err)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/openziti/ziti/controller/xt"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
+	"time"
 )
 
 type routeResultHandler struct {
@@ -61,7 +62,7 @@ func (self *routeResultHandler) handleRouteResult(msg *channel.Message) {
 			circuitId := string(msg.Body)
 			peerData := xt.PeerData{}
 			for k, v := range msg.Headers {
-				if k > 0 && (k < ctrl_msg.RouteResultSuccessHeader || k > ctrl_msg.RouteResultErrorCodeHeader) {
+				if k > 0 && (k < ctrl_msg.RouteResultSuccessHeader || k > ctrl_msg.RouteResultDialDurationHeader) {
 					peerData[uint32(k)] = v
 				}
 			}
@@ -79,6 +80,10 @@ func (self *routeResultHandler) handleRouteResult(msg *channel.Message) {
 				rs.ErrorCode = &errCode
 			}
 
+			if dialDuration, hasDialDuration := msg.GetUint64Header(ctrl_msg.RouteResultDialDurationHeader); hasDialDuration {
+				rs.DialDuration = time.Duration(dialDuration)
+			}
+
 			routing := self.network.RouteResult(rs)
 			if !routing && attempt != network.SmartRerouteAttempt {
 				go self.notRoutingCircuit(circuitId)
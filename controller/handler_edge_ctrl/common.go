@@ -14,6 +14,7 @@ import (
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/common"
 	"github.com/openziti/ziti/common/logcontext"
+	"github.com/openziti/ziti/common/bandwidth"
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/db"
@@ -604,6 +605,25 @@ func (self *baseSessionRequestContext) createCircuit(terminatorInstanceId string
 	return circuit, returnPeerData
 }
 
+// addBandwidthTags adds the bandwidth limits of the service and of the dialing and hosting identities to the circuit
+// tags, so the routers at either end of the circuit can enforce them
+func (self *baseSessionRequestContext) addBandwidthTags(tags map[string]string, clientId, hostId string) {
+	if self.service != nil {
+		self.service.BandwidthLimits.AddToTags(tags, bandwidth.TagService)
+	}
+
+	identityManager := self.handler.getAppEnv().Managers.Identity
+	if client, _ := identityManager.Read(clientId); client != nil {
+		client.BandwidthLimits.AddToTags(tags, bandwidth.TagClient)
+	}
+
+	if hostId != "" {
+		if host, _ := identityManager.Read(hostId); host != nil {
+			host.BandwidthLimits.AddToTags(tags, bandwidth.TagHost)
+		}
+	}
+}
+
 type sessionCircuitParams struct {
 	serviceId    string
 	sourceRouter *model.Router
@@ -625,6 +645,10 @@ func (self *sessionCircuitParams) GetClientId() *identity.TokenId {
 	return self.clientId
 }
 
+func (self *sessionCircuitParams) GetIdentityId() string {
+	return self.reqCtx.session.IdentityId
+}
+
 func (self *sessionCircuitParams) GetCircuitTags(t xt.CostedTerminator) map[string]string {
 	if t == nil {
 		return map[string]string{
@@ -634,11 +658,13 @@ func (self *sessionCircuitParams) GetCircuitTags(t xt.CostedTerminator) map[stri
 	}
 
 	hostId := t.GetHostId()
-	return map[string]string{
+	tags := map[string]string{
 		"serviceId": self.serviceId,
 		"clientId":  self.reqCtx.session.IdentityId,
 		"hostId":    hostId,
 	}
+	self.reqCtx.addBandwidthTags(tags, self.reqCtx.session.IdentityId, hostId)
+	return tags
 }
 
 func (self *sessionCircuitParams) GetLogContext() logcontext.Context {
@@ -670,6 +696,10 @@ func (self *tunnelCircuitParams) GetClientId() *identity.TokenId {
 	return self.clientId
 }
 
+func (self *tunnelCircuitParams) GetIdentityId() string {
+	return self.sourceRouter.Id
+}
+
 func (self *tunnelCircuitParams) GetCircuitTags(t xt.CostedTerminator) map[string]string {
 	if t == nil {
 		return map[string]string{
@@ -679,11 +709,13 @@ func (self *tunnelCircuitParams) GetCircuitTags(t xt.CostedTerminator) map[strin
 	}
 
 	hostId := t.GetHostId()
-	return map[string]string{
+	tags := map[string]string{
 		"serviceId": self.serviceId,
 		"clientId":  self.sourceRouter.Id,
 		"hostId":    hostId,
 	}
+	self.reqCtx.addBandwidthTags(tags, self.sourceRouter.Id, hostId)
+	return tags
 }
 
 func (self *tunnelCircuitParams) GetLogContext() logcontext.Context {
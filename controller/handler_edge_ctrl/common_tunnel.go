@@ -270,6 +270,10 @@ func (self *baseTunnelRequestContext) ensureSessionForService(sessionId, session
 			Type:         sessionType,
 		}
 
+		// tunneler sessions are created on behalf of the router, so the router's address is the observed source
+		remoteAddr := self.handler.getChannel().Underlay().GetRemoteAddr().String()
+		self.handler.getAppEnv().GetManagers().PostureResponse.SetSourceIp(self.identity.Id, self.apiSession.Id, remoteAddr)
+
 		id, err := self.handler.getAppEnv().Managers.Session.Create(session, self.newTunnelChangeContext())
 		if err != nil {
 			self.err = internalError(err)
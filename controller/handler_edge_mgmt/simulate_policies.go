@@ -0,0 +1,136 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_edge_mgmt
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/controller/env"
+	"github.com/openziti/ziti/controller/model"
+	"google.golang.org/protobuf/proto"
+)
+
+type simulatePoliciesHandler struct {
+	appEnv *env.AppEnv
+}
+
+func NewSimulatePoliciesHandler(appEnv *env.AppEnv) channel.TypedReceiveHandler {
+	return &simulatePoliciesHandler{appEnv: appEnv}
+}
+
+func (*simulatePoliciesHandler) ContentType() int32 {
+	return int32(mgmt_pb.ContentType_PolicySimulationRequestType)
+}
+
+func (handler *simulatePoliciesHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label())
+	request := &mgmt_pb.PolicySimulationRequest{}
+
+	response := &mgmt_pb.PolicySimulationResponse{}
+	if err := proto.Unmarshal(msg.Body, request); err != nil {
+		response.Message = "failed to unmarshall request: " + err.Error()
+	} else if result, err := handler.appEnv.Managers.PolicyAdvisor.SimulatePolicyChanges(toPolicySimulation(request)); err != nil {
+		response.Message = err.Error()
+	} else {
+		response.Success = true
+		response.Gained = toReachabilityChanges(result.Gained)
+		response.Lost = toReachabilityChanges(result.Lost)
+		response.Warnings = result.Warnings
+	}
+
+	body, err := proto.Marshal(response)
+	if err != nil {
+		log.WithError(err).Error("unexpected error serializing PolicySimulationResponse")
+		return
+	}
+
+	responseMsg := channel.NewMessage(int32(mgmt_pb.ContentType_PolicySimulationResponseType), body)
+	responseMsg.ReplyTo(msg)
+	if err = ch.Send(responseMsg); err != nil {
+		log.WithError(err).Error("unexpected error sending PolicySimulationResponse")
+	}
+}
+
+func toPolicySimulation(request *mgmt_pb.PolicySimulationRequest) *model.PolicySimulation {
+	result := &model.PolicySimulation{
+		DeletedServicePolicies:           request.DeletedServicePolicies,
+		DeletedEdgeRouterPolicies:        request.DeletedEdgeRouterPolicies,
+		DeletedServiceEdgeRouterPolicies: request.DeletedServiceEdgeRouterPolicies,
+		IdentityRoleAttributes:           toRoleAttributesMap(request.IdentityRoleAttributes),
+		ServiceRoleAttributes:            toRoleAttributesMap(request.ServiceRoleAttributes),
+		EdgeRouterRoleAttributes:         toRoleAttributesMap(request.EdgeRouterRoleAttributes),
+	}
+
+	for _, policy := range request.ServicePolicies {
+		servicePolicy := &model.ServicePolicy{
+			Name:          policy.Name,
+			PolicyType:    policy.PolicyType,
+			Semantic:      policy.Semantic,
+			IdentityRoles: policy.IdentityRoles,
+			ServiceRoles:  policy.ServiceRoles,
+		}
+		servicePolicy.Id = policy.Id
+		result.ServicePolicies = append(result.ServicePolicies, servicePolicy)
+	}
+
+	for _, policy := range request.EdgeRouterPolicies {
+		edgeRouterPolicy := &model.EdgeRouterPolicy{
+			Name:            policy.Name,
+			Semantic:        policy.Semantic,
+			IdentityRoles:   policy.IdentityRoles,
+			EdgeRouterRoles: policy.EdgeRouterRoles,
+		}
+		edgeRouterPolicy.Id = policy.Id
+		result.EdgeRouterPolicies = append(result.EdgeRouterPolicies, edgeRouterPolicy)
+	}
+
+	for _, policy := range request.ServiceEdgeRouterPolicies {
+		serviceEdgeRouterPolicy := &model.ServiceEdgeRouterPolicy{
+			Name:            policy.Name,
+			Semantic:        policy.Semantic,
+			ServiceRoles:    policy.ServiceRoles,
+			EdgeRouterRoles: policy.EdgeRouterRoles,
+		}
+		serviceEdgeRouterPolicy.Id = policy.Id
+		result.ServiceEdgeRouterPolicies = append(result.ServiceEdgeRouterPolicies, serviceEdgeRouterPolicy)
+	}
+
+	return result
+}
+
+func toRoleAttributesMap(list []*mgmt_pb.SimulatedRoleAttributes) map[string][]string {
+	result := map[string][]string{}
+	for _, entry := range list {
+		result[entry.Entity] = entry.RoleAttributes
+	}
+	return result
+}
+
+func toReachabilityChanges(list []*model.AdvisorReachabilityChange) []*mgmt_pb.ReachabilityChange {
+	var result []*mgmt_pb.ReachabilityChange
+	for _, change := range list {
+		result = append(result, &mgmt_pb.ReachabilityChange{
+			IdentityId:   change.IdentityId,
+			IdentityName: change.IdentityName,
+			ServiceId:    change.ServiceId,
+			ServiceName:  change.ServiceName,
+			PolicyType:   change.PolicyType,
+		})
+	}
+	return result
+}
@@ -16,10 +16,16 @@
 
 package permissions
 
+import "github.com/openziti/ziti/controller/db"
+
 const (
 	AdminPermission                 = "ADMIN"
 	AuthenticatedPermission         = "AUTHENTICATED"
 	PartiallyAuthenticatePermission = "PARTIAL_AUTH"
+
+	AuditorPermission            = db.IdentityPermissionAuditor
+	ServiceOperatorPermission    = db.IdentityPermissionServiceOperator
+	EnrollmentOperatorPermission = db.IdentityPermissionEnrollmentOperator
 )
 
 type Resolver interface {
@@ -0,0 +1,130 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package permissions
+
+import "strings"
+
+// roleGrant lists the entity types a role may read and modify. Entity types are the management API path names, e.g.
+// 'services' or 'config-types'.
+type roleGrant struct {
+	readAll bool
+	read    map[string]struct{}
+	write   map[string]struct{}
+}
+
+func (self *roleGrant) allows(entityType string, write bool) bool {
+	if _, found := self.write[entityType]; found {
+		return true
+	}
+	if write {
+		return false
+	}
+	if self.readAll {
+		if _, secret := secretEntityTypes[entityType]; !secret {
+			return true
+		}
+	}
+	_, found := self.read[entityType]
+	return found
+}
+
+func entityTypes(types ...string) map[string]struct{} {
+	result := map[string]struct{}{}
+	for _, entityType := range types {
+		result[entityType] = struct{}{}
+	}
+	return result
+}
+
+// secretEntityTypes hold credentials which can be used to enroll as an identity or router, so they're left out of
+// read-all grants
+var secretEntityTypes = entityTypes("enrollments")
+
+var roleGrants = map[string]*roleGrant{
+	AuditorPermission: {
+		readAll: true,
+	},
+	ServiceOperatorPermission: {
+		read:  entityTypes("config-types"),
+		write: entityTypes("services", "configs", "terminators"),
+	},
+	EnrollmentOperatorPermission: {
+		read:  entityTypes("identities", "identity-types", "cas"),
+		write: entityTypes("enrollments", "authenticators"),
+	},
+}
+
+// RequireEntityAccess allows admins, as well as identities holding a role which grants the required access to the
+// entity type
+type RequireEntityAccess struct {
+	entityType string
+	write      bool
+}
+
+// CanRead requires permission to list and view entities of the given type
+func CanRead(entityType string) *RequireEntityAccess {
+	return &RequireEntityAccess{
+		entityType: entityType,
+	}
+}
+
+// CanWrite requires permission to create, update and delete entities of the given type
+func CanWrite(entityType string) *RequireEntityAccess {
+	return &RequireEntityAccess{
+		entityType: entityType,
+		write:      true,
+	}
+}
+
+func (self *RequireEntityAccess) IsAllowed(identityPerms ...string) bool {
+	for _, p := range identityPerms {
+		if p == AdminPermission {
+			return true
+		}
+
+		role, _, _ := strings.Cut(p, ":")
+		if grant, found := roleGrants[role]; found && grant.allows(self.entityType, self.write) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetServiceOperatorScope returns the role attributes which limit the services a service operator may manage. If
+// unrestricted is true, the identity may manage any service.
+func GetServiceOperatorScope(identityPerms ...string) (attributes []string, unrestricted bool) {
+	for _, p := range identityPerms {
+		if p == AdminPermission || p == ServiceOperatorPermission {
+			return nil, true
+		}
+		if attr, found := strings.CutPrefix(p, ServiceOperatorPermission+":"); found {
+			attributes = append(attributes, attr)
+		}
+	}
+	return attributes, false
+}
+
+// CanManageCredentialsOf returns true if an identity holding the given permissions may create or change enrollments
+// and authenticators of the target identity. Those credentials can be used to log in as the target, so only admins
+// may manage them for admins and for identities holding management API roles.
+func CanManageCredentialsOf(identityPerms []string, targetIsAdmin bool, targetPermissions []string) bool {
+	if IsAdmin().IsAllowed(identityPerms...) {
+		return true
+	}
+	return !targetIsAdmin && len(targetPermissions) == 0
+}
@@ -0,0 +1,77 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package permissions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireEntityAccess(t *testing.T) {
+	req := require.New(t)
+
+	req.True(CanWrite("identities").IsAllowed(AuthenticatedPermission, AdminPermission))
+	req.False(CanRead("identities").IsAllowed(AuthenticatedPermission))
+
+	req.True(CanRead("identities").IsAllowed(AuthenticatedPermission, AuditorPermission))
+	req.False(CanWrite("identities").IsAllowed(AuthenticatedPermission, AuditorPermission))
+	req.False(CanRead("enrollments").IsAllowed(AuthenticatedPermission, AuditorPermission))
+
+	req.True(CanWrite("services").IsAllowed(AuthenticatedPermission, ServiceOperatorPermission))
+	req.True(CanWrite("configs").IsAllowed(AuthenticatedPermission, ServiceOperatorPermission+":web"))
+	req.True(CanRead("config-types").IsAllowed(AuthenticatedPermission, ServiceOperatorPermission))
+	req.False(CanWrite("config-types").IsAllowed(AuthenticatedPermission, ServiceOperatorPermission))
+	req.False(CanRead("identities").IsAllowed(AuthenticatedPermission, ServiceOperatorPermission))
+
+	req.True(CanWrite("enrollments").IsAllowed(AuthenticatedPermission, EnrollmentOperatorPermission))
+	req.True(CanRead("identities").IsAllowed(AuthenticatedPermission, EnrollmentOperatorPermission))
+	req.False(CanWrite("identities").IsAllowed(AuthenticatedPermission, EnrollmentOperatorPermission))
+	req.False(CanWrite("services").IsAllowed(AuthenticatedPermission, EnrollmentOperatorPermission))
+}
+
+func TestGetServiceOperatorScope(t *testing.T) {
+	req := require.New(t)
+
+	attributes, unrestricted := GetServiceOperatorScope(AuthenticatedPermission, ServiceOperatorPermission+":web", ServiceOperatorPermission+":db")
+	req.False(unrestricted)
+	req.Equal([]string{"web", "db"}, attributes)
+
+	_, unrestricted = GetServiceOperatorScope(AuthenticatedPermission, ServiceOperatorPermission+":web", ServiceOperatorPermission)
+	req.True(unrestricted)
+
+	_, unrestricted = GetServiceOperatorScope(AuthenticatedPermission, AdminPermission)
+	req.True(unrestricted)
+
+	attributes, unrestricted = GetServiceOperatorScope(AuthenticatedPermission, AuditorPermission)
+	req.False(unrestricted)
+	req.Empty(attributes)
+}
+
+func TestCanManageCredentialsOf(t *testing.T) {
+	req := require.New(t)
+
+	operator := []string{AuthenticatedPermission, EnrollmentOperatorPermission}
+	req.True(CanManageCredentialsOf(operator, false, nil))
+	req.False(CanManageCredentialsOf(operator, true, nil))
+	req.False(CanManageCredentialsOf(operator, false, []string{AuditorPermission}))
+	req.False(CanManageCredentialsOf(operator, false, []string{EnrollmentOperatorPermission}))
+
+	admin := []string{AuthenticatedPermission, AdminPermission}
+	req.True(CanManageCredentialsOf(admin, true, nil))
+	req.True(CanManageCredentialsOf(admin, false, []string{ServiceOperatorPermission}))
+}
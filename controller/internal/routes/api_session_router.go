@@ -42,15 +42,15 @@ func NewApiSessionRouter() *ApiSessionHandler {
 
 func (ir *ApiSessionHandler) Register(ae *env.AppEnv) {
 	ae.ManagementApi.APISessionDeleteAPISessionsHandler = api_session.DeleteAPISessionsHandlerFunc(func(params api_session.DeleteAPISessionsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(ir.Delete, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(ir.Delete, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameApiSession))
 	})
 
 	ae.ManagementApi.APISessionDetailAPISessionsHandler = api_session.DetailAPISessionsHandlerFunc(func(params api_session.DetailAPISessionsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(ir.Detail, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(ir.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameApiSession))
 	})
 
 	ae.ManagementApi.APISessionListAPISessionsHandler = api_session.ListAPISessionsHandlerFunc(func(params api_session.ListAPISessionsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(ir.List, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(ir.List, params.HTTPRequest, "", "", permissions.CanRead(EntityNameApiSession))
 	})
 }
 
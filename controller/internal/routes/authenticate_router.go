@@ -147,10 +147,7 @@ func (ro *AuthRouter) authHandler(ae *env.AppEnv, rc *response.RequestContext, h
 	if auth != nil {
 		configTypes = ae.Managers.ConfigType.MapConfigTypeNamesToIds(auth.ConfigTypes, identity.Id)
 	}
-	remoteIpStr := ""
-	if remoteIp, _, err := net.SplitHostPort(rc.Request.RemoteAddr); err == nil {
-		remoteIpStr = remoteIp
-	}
+	remoteIpStr := getRemoteIp(rc.Request)
 
 	logger.Debugf("client %v requesting configTypes: %v", identity.Name, configTypes)
 	newApiSession := &model.ApiSession{
@@ -217,6 +214,7 @@ func (ro *AuthRouter) authHandler(ae *env.AppEnv, rc *response.RequestContext, h
 	}
 
 	ae.GetManagers().PostureResponse.SetSdkInfo(identity.Id, sessionId, identity.SdkInfo)
+	ae.GetManagers().PostureResponse.SetSourceIp(identity.Id, sessionId, remoteIpStr)
 
 	rc.ApiSession = filledApiSession
 
@@ -270,3 +268,12 @@ func (ro *AuthRouter) authMfa(ae *env.AppEnv, rc *response.RequestContext, mfaCo
 
 	rc.RespondWithEmptyOk()
 }
+
+// getRemoteIp returns the address of the client which sent the request, without the port. Session creation and
+// authentication both use it, so SOURCE_IP posture checks see the same address on either path.
+func getRemoteIp(request *http.Request) string {
+	if remoteIp, _, err := net.SplitHostPort(request.RemoteAddr); err == nil {
+		return remoteIp
+	}
+	return ""
+}
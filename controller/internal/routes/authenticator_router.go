@@ -46,33 +46,33 @@ func NewAuthenticatorRouter() *AuthenticatorRouter {
 
 func (r *AuthenticatorRouter) Register(ae *env.AppEnv) {
 	ae.ManagementApi.AuthenticatorDeleteAuthenticatorHandler = authenticator.DeleteAuthenticatorHandlerFunc(func(params authenticator.DeleteAuthenticatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameAuthenticator))
 	})
 
 	ae.ManagementApi.AuthenticatorDetailAuthenticatorHandler = authenticator.DetailAuthenticatorHandlerFunc(func(params authenticator.DetailAuthenticatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameAuthenticator))
 	})
 
 	ae.ManagementApi.AuthenticatorListAuthenticatorsHandler = authenticator.ListAuthenticatorsHandlerFunc(func(params authenticator.ListAuthenticatorsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.CanRead(EntityNameAuthenticator))
 	})
 
 	ae.ManagementApi.AuthenticatorUpdateAuthenticatorHandler = authenticator.UpdateAuthenticatorHandlerFunc(func(params authenticator.UpdateAuthenticatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameAuthenticator))
 	})
 
 	ae.ManagementApi.AuthenticatorCreateAuthenticatorHandler = authenticator.CreateAuthenticatorHandlerFunc(func(params authenticator.CreateAuthenticatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.CanWrite(EntityNameAuthenticator))
 	})
 
 	ae.ManagementApi.AuthenticatorPatchAuthenticatorHandler = authenticator.PatchAuthenticatorHandlerFunc(func(params authenticator.PatchAuthenticatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameAuthenticator))
 	})
 
 	ae.ManagementApi.AuthenticatorReEnrollAuthenticatorHandler = authenticator.ReEnrollAuthenticatorHandlerFunc(func(params authenticator.ReEnrollAuthenticatorParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) {
 			r.ReEnroll(ae, rc, params)
-		}, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		}, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameAuthenticator))
 	})
 }
 
@@ -92,22 +92,38 @@ func (r *AuthenticatorRouter) Create(ae *env.AppEnv, rc *response.RequestContext
 			return "", err
 		}
 
+		if err = checkCredentialTarget(ae, rc, authenticator.IdentityId); err != nil {
+			return "", err
+		}
+
 		return MapCreate(ae.Managers.Authenticator.Create, authenticator, rc)
 	})
 }
 
 func (r *AuthenticatorRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
-	DeleteWithHandler(rc, ae.Managers.Authenticator)
+	Delete(rc, func(rc *response.RequestContext, id string) error {
+		if err := checkAuthenticatorTarget(ae, rc, id); err != nil {
+			return err
+		}
+		return ae.Managers.Authenticator.Delete(id, rc.NewChangeContext())
+	})
 }
 
 func (r *AuthenticatorRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params authenticator.UpdateAuthenticatorParams) {
 	Update(rc, func(id string) error {
+		if err := checkAuthenticatorTarget(ae, rc, id); err != nil {
+			return err
+		}
 		return ae.Managers.Authenticator.Update(MapUpdateAuthenticatorToModel(params.ID, params.Authenticator), false, nil, rc.NewChangeContext())
 	})
 }
 
 func (r *AuthenticatorRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params authenticator.PatchAuthenticatorParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
+		if err := checkAuthenticatorTarget(ae, rc, id); err != nil {
+			return err
+		}
+
 		model := MapPatchAuthenticatorToModel(params.ID, params.Authenticator)
 
 		if fields.IsUpdated("password") {
@@ -131,6 +147,11 @@ func (r *AuthenticatorRouter) ReEnroll(ae *env.AppEnv, rc *response.RequestConte
 		return
 	}
 
+	if err = checkAuthenticatorTarget(ae, rc, id); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
 	if id, err := ae.Managers.Authenticator.ReEnroll(id, time.Time(*params.ReEnroll.ExpiresAt), rc.NewChangeContext()); err == nil {
 		rc.RespondWithCreatedId(id, EnrollmentLinkFactory.SelfLinkFromId(id))
 	} else {
@@ -142,3 +163,16 @@ func (r *AuthenticatorRouter) ReEnroll(ae *env.AppEnv, rc *response.RequestConte
 		return
 	}
 }
+
+// checkAuthenticatorTarget returns an unauthorized error if the caller may not manage the credentials of the identity
+// owning the given authenticator
+func checkAuthenticatorTarget(ae *env.AppEnv, rc *response.RequestContext, authenticatorId string) error {
+	if permissions.IsAdmin().IsAllowed(rc.ActivePermissions...) {
+		return nil
+	}
+	authenticator, err := ae.Managers.Authenticator.Read(authenticatorId)
+	if err != nil {
+		return err
+	}
+	return checkCredentialTarget(ae, rc, authenticator.IdentityId)
+}
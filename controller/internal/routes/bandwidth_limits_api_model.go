@@ -0,0 +1,86 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/common/bandwidth"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+)
+
+// BandwidthLimits holds the bandwidth limits of a service or identity. They aren't part of the published edge
+// management API model yet, so they're read from and written to the raw request and response bodies.
+type BandwidthLimits struct {
+	IngressRate  int64 `json:"ingressRate,omitempty"`
+	IngressBurst int64 `json:"ingressBurst,omitempty"`
+	EgressRate   int64 `json:"egressRate,omitempty"`
+	EgressBurst  int64 `json:"egressBurst,omitempty"`
+}
+
+type bandwidthLimitsHolder struct {
+	BandwidthLimits *BandwidthLimits `json:"bandwidthLimits"`
+}
+
+// parseBandwidthLimits returns the bandwidth limits from a request body, or nil if they're missing or null
+func parseBandwidthLimits(body []byte) (*bandwidth.Limits, error) {
+	if len(body) == 0 {
+		return nil, nil
+	}
+	holder := &bandwidthLimitsHolder{}
+	if err := json.Unmarshal(body, holder); err != nil {
+		return nil, errorz.NewFieldError(err.Error(), db.FieldBandwidthLimits, nil)
+	}
+	if holder.BandwidthLimits == nil {
+		return nil, nil
+	}
+	return &bandwidth.Limits{
+		IngressRate:  holder.BandwidthLimits.IngressRate,
+		IngressBurst: holder.BandwidthLimits.IngressBurst,
+		EgressRate:   holder.BandwidthLimits.EgressRate,
+		EgressBurst:  holder.BandwidthLimits.EgressBurst,
+	}, nil
+}
+
+// addBandwidthLimitsField marks the bandwidth limits as updated if they're present in a patch body. They can be
+// cleared by patching them to null
+func addBandwidthLimitsField(body []byte, updatedFields fields.UpdatedFields) fields.UpdatedFields {
+	jsonMap := map[string]interface{}{}
+	if err := json.Unmarshal(body, &jsonMap); err != nil {
+		return updatedFields
+	}
+	if _, found := jsonMap[db.FieldBandwidthLimits]; found {
+		updatedFields = updatedFields.AddField(db.FieldBandwidthLimits)
+	}
+	return updatedFields
+}
+
+func newBandwidthLimitsHolder(limits *bandwidth.Limits) *bandwidthLimitsHolder {
+	if limits == nil {
+		return nil
+	}
+	return &bandwidthLimitsHolder{
+		BandwidthLimits: &BandwidthLimits{
+			IngressRate:  limits.IngressRate,
+			IngressBurst: limits.IngressBurst,
+			EgressRate:   limits.EgressRate,
+			EgressBurst:  limits.EgressBurst,
+		},
+	}
+}
@@ -44,27 +44,27 @@ func NewConfigRouter() *ConfigRouter {
 
 func (r *ConfigRouter) Register(ae *env.AppEnv) {
 	ae.ManagementApi.ConfigDeleteConfigHandler = config.DeleteConfigHandlerFunc(func(params config.DeleteConfigParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameConfig))
 	})
 
 	ae.ManagementApi.ConfigDetailConfigHandler = config.DetailConfigHandlerFunc(func(params config.DetailConfigParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameConfig))
 	})
 
 	ae.ManagementApi.ConfigListConfigsHandler = config.ListConfigsHandlerFunc(func(params config.ListConfigsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.CanRead(EntityNameConfig))
 	})
 
 	ae.ManagementApi.ConfigUpdateConfigHandler = config.UpdateConfigHandlerFunc(func(params config.UpdateConfigParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameConfig))
 	})
 
 	ae.ManagementApi.ConfigCreateConfigHandler = config.CreateConfigHandlerFunc(func(params config.CreateConfigParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.CanWrite(EntityNameConfig))
 	})
 
 	ae.ManagementApi.ConfigPatchConfigHandler = config.PatchConfigHandlerFunc(func(params config.PatchConfigParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameConfig))
 	})
 }
 
@@ -92,7 +92,12 @@ func (r *ConfigRouter) Create(ae *env.AppEnv, rc *response.RequestContext, param
 }
 
 func (r *ConfigRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
-	DeleteWithHandler(rc, ae.Managers.Config)
+	Delete(rc, func(rc *response.RequestContext, id string) error {
+		if err := checkConfigOperatorScope(ae, rc, id); err != nil {
+			return err
+		}
+		return ae.Managers.Config.Delete(id, rc.NewChangeContext())
+	})
 }
 
 func (r *ConfigRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params config.UpdateConfigParams) {
@@ -102,6 +107,10 @@ func (r *ConfigRouter) Update(ae *env.AppEnv, rc *response.RequestContext, param
 	}
 
 	Update(rc, func(id string) error {
+		if err := checkConfigOperatorScope(ae, rc, id); err != nil {
+			return err
+		}
+
 		model, err := MapUpdateConfigToModel(params.ID, params.Config)
 
 		if err != nil {
@@ -114,6 +123,10 @@ func (r *ConfigRouter) Update(ae *env.AppEnv, rc *response.RequestContext, param
 
 func (r *ConfigRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params config.PatchConfigParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
+		if err := checkConfigOperatorScope(ae, rc, id); err != nil {
+			return err
+		}
+
 		model, err := MapPatchConfigToModel(params.ID, params.Config)
 
 		if err != nil {
@@ -122,3 +135,16 @@ func (r *ConfigRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params
 		return ae.Managers.Config.Update(model, fields.FilterMaps("tags", "data"), rc.NewChangeContext())
 	})
 }
+
+// checkConfigOperatorScope ensures that service operators limited to services with given role attributes only change
+// configs which are used solely by those services. Configs not used by any service may be changed by any operator.
+func checkConfigOperatorScope(ae *env.AppEnv, rc *response.RequestContext, id string) error {
+	if _, unrestricted := permissions.GetServiceOperatorScope(rc.ActivePermissions...); unrestricted {
+		return nil
+	}
+	serviceIds, err := ae.Managers.Config.GetServiceIds(id)
+	if err != nil {
+		return err
+	}
+	return checkServicesOperatorScope(ae, rc, serviceIds...)
+}
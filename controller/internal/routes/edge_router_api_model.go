@@ -106,8 +106,16 @@ func MapPatchEdgeRouterToModel(id string, router *rest_model.EdgeRouterPatch) *m
 	return ret
 }
 
-func MapEdgeRouterToRestEntity(ae *env.AppEnv, _ *response.RequestContext, router *model.EdgeRouter) (interface{}, error) {
-	return MapEdgeRouterToRestModel(ae, router)
+func MapEdgeRouterToRestEntity(ae *env.AppEnv, rc *response.RequestContext, router *model.EdgeRouter) (interface{}, error) {
+	result, err := MapEdgeRouterToRestModel(ae, router)
+	if err != nil {
+		return nil, err
+	}
+	if !canViewEnrollmentSecrets(rc, nil) {
+		result.EnrollmentJWT = nil
+		result.EnrollmentToken = nil
+	}
+	return result, nil
 }
 
 func MapVersionInfoToRestModel(versionInfo versions.VersionInfo) *rest_model.VersionInfo {
@@ -19,7 +19,9 @@ package routes
 import (
 	"github.com/go-openapi/strfmt"
 	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/ziti/controller/env"
+	"github.com/openziti/ziti/controller/internal/permissions"
 	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/response"
 	"github.com/openziti/foundation/v2/stringz"
@@ -29,8 +31,27 @@ const EntityNameEnrollment = "enrollments"
 
 var EnrollmentLinkFactory = NewBasicLinkFactory(EntityNameEnrollment)
 
-func MapEnrollmentToRestEntity(ae *env.AppEnv, _ *response.RequestContext, enrollment *model.Enrollment) (interface{}, error) {
-	return MapEnrollmentToRestModel(ae, enrollment)
+func MapEnrollmentToRestEntity(ae *env.AppEnv, rc *response.RequestContext, enrollment *model.Enrollment) (interface{}, error) {
+	result, err := MapEnrollmentToRestModel(ae, enrollment)
+	if err != nil {
+		return nil, err
+	}
+
+	if !permissions.IsAdmin().IsAllowed(rc.ActivePermissions...) {
+		var identity *model.Identity
+		if enrollment.IdentityId != nil {
+			if identity, err = ae.Managers.Identity.Read(*enrollment.IdentityId); err != nil {
+				return nil, err
+			}
+		}
+		if !canViewEnrollmentSecrets(rc, identity) {
+			empty := ""
+			result.Token = &empty
+			result.JWT = ""
+		}
+	}
+
+	return result, nil
 }
 
 func MapEnrollmentToRestModel(ae *env.AppEnv, enrollment *model.Enrollment) (*rest_model.EnrollmentDetail, error) {
@@ -74,3 +95,54 @@ func MapEnrollmentToRestModel(ae *env.AppEnv, enrollment *model.Enrollment) (*re
 
 	return ret, nil
 }
+
+// canManageCredentialsOf returns true if the caller may create or change enrollments and authenticators of the
+// given identity
+func canManageCredentialsOf(rc *response.RequestContext, identity *model.Identity) bool {
+	return permissions.CanManageCredentialsOf(rc.ActivePermissions, identity.IsAdmin || identity.IsDefaultAdmin, identity.Permissions)
+}
+
+// checkCredentialTarget returns an unauthorized error if the caller may not manage the enrollments and
+// authenticators of the given identity
+func checkCredentialTarget(ae *env.AppEnv, rc *response.RequestContext, identityId string) error {
+	if permissions.IsAdmin().IsAllowed(rc.ActivePermissions...) {
+		return nil
+	}
+	identity, err := ae.Managers.Identity.Read(identityId)
+	if err != nil {
+		return err
+	}
+	if !canManageCredentialsOf(rc, identity) {
+		return errorz.NewUnauthorized()
+	}
+	return nil
+}
+
+// checkEnrollmentTarget returns an unauthorized error if the caller may not manage the given enrollment. Router
+// enrollments may only be managed by admins.
+func checkEnrollmentTarget(ae *env.AppEnv, rc *response.RequestContext, enrollmentId string) error {
+	if permissions.IsAdmin().IsAllowed(rc.ActivePermissions...) {
+		return nil
+	}
+	enrollment, err := ae.Managers.Enrollment.Read(enrollmentId)
+	if err != nil {
+		return err
+	}
+	if enrollment.IdentityId == nil {
+		return errorz.NewUnauthorized()
+	}
+	return checkCredentialTarget(ae, rc, *enrollment.IdentityId)
+}
+
+// canViewEnrollmentSecrets returns true if the caller may see the jwt and token of an enrollment for the given
+// identity, or for a router if the identity is nil. Anyone holding them can enroll in its place, so they're only
+// shown to callers who could create the enrollment themselves.
+func canViewEnrollmentSecrets(rc *response.RequestContext, identity *model.Identity) bool {
+	if permissions.IsAdmin().IsAllowed(rc.ActivePermissions...) {
+		return true
+	}
+	if identity == nil || !permissions.CanWrite(EntityNameEnrollment).IsAllowed(rc.ActivePermissions...) {
+		return false
+	}
+	return canManageCredentialsOf(rc, identity)
+}
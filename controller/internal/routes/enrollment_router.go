@@ -25,6 +25,7 @@ import (
 	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/response"
 	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
 	"time"
 )
 
@@ -45,27 +46,27 @@ func NewEnrollmentRouter() *EnrollmentRouter {
 
 func (r *EnrollmentRouter) Register(ae *env.AppEnv) {
 	ae.ManagementApi.EnrollmentDeleteEnrollmentHandler = enrollment.DeleteEnrollmentHandlerFunc(func(params enrollment.DeleteEnrollmentParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameEnrollment))
 	})
 
 	ae.ManagementApi.EnrollmentDetailEnrollmentHandler = enrollment.DetailEnrollmentHandlerFunc(func(params enrollment.DetailEnrollmentParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameEnrollment))
 	})
 
 	ae.ManagementApi.EnrollmentListEnrollmentsHandler = enrollment.ListEnrollmentsHandlerFunc(func(params enrollment.ListEnrollmentsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.CanRead(EntityNameEnrollment))
 	})
 
 	ae.ManagementApi.EnrollmentRefreshEnrollmentHandler = enrollment.RefreshEnrollmentHandlerFunc(func(params enrollment.RefreshEnrollmentParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) {
 			r.Refresh(ae, rc, params)
-		}, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		}, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameEnrollment))
 	})
 
 	ae.ManagementApi.EnrollmentCreateEnrollmentHandler = enrollment.CreateEnrollmentHandlerFunc(func(params enrollment.CreateEnrollmentParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) {
 			r.Create(ae, rc, params)
-		}, params.HTTPRequest, "", "", permissions.IsAdmin())
+		}, params.HTTPRequest, "", "", permissions.CanWrite(EntityNameEnrollment))
 	})
 }
 
@@ -78,7 +79,12 @@ func (r *EnrollmentRouter) Detail(ae *env.AppEnv, rc *response.RequestContext) {
 }
 
 func (r *EnrollmentRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
-	DeleteWithHandler(rc, ae.Managers.Enrollment)
+	Delete(rc, func(rc *response.RequestContext, id string) error {
+		if err := checkEnrollmentTarget(ae, rc, id); err != nil {
+			return err
+		}
+		return ae.Managers.Enrollment.Delete(id, rc.NewChangeContext())
+	})
 }
 
 func (r *EnrollmentRouter) Refresh(ae *env.AppEnv, rc *response.RequestContext, params enrollment.RefreshEnrollmentParams) {
@@ -94,6 +100,11 @@ func (r *EnrollmentRouter) Refresh(ae *env.AppEnv, rc *response.RequestContext,
 		return
 	}
 
+	if err := checkEnrollmentTarget(ae, rc, id); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
 	if err := ae.Managers.Enrollment.RefreshJwt(id, time.Time(*params.Refresh.ExpiresAt), rc.NewChangeContext()); err != nil {
 		if fe, ok := err.(*errorz.FieldError); ok {
 			rc.RespondWithFieldError(fe)
@@ -108,6 +119,9 @@ func (r *EnrollmentRouter) Refresh(ae *env.AppEnv, rc *response.RequestContext,
 
 func (r *EnrollmentRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params enrollment.CreateEnrollmentParams) {
 	Create(rc, rc, EnrollmentLinkFactory, func() (string, error) {
+		if err := checkCredentialTarget(ae, rc, stringz.OrEmpty(params.Enrollment.IdentityID)); err != nil {
+			return "", err
+		}
 		return MapCreate(ae.Managers.Enrollment.Create, MapCreateEnrollmentToModel(params.Enrollment), rc)
 	})
 
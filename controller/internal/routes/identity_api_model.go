@@ -17,15 +17,19 @@
 package routes
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/foundation/v2/stringz"
 	"github.com/openziti/sdk-golang/ziti"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/env"
+	"github.com/openziti/ziti/controller/fields"
 	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/models"
 	"github.com/openziti/ziti/controller/response"
@@ -204,8 +208,103 @@ func MapPatchIdentityToModel(id string, identity *rest_model.IdentityPatch, iden
 	return ret
 }
 
-func MapIdentityToRestEntity(ae *env.AppEnv, _ *response.RequestContext, entity *model.Identity) (interface{}, error) {
-	return MapIdentityToRestModel(ae, entity)
+// IdentityPermissions holds the management API roles granted to an identity. They aren't part of the published edge
+// management API model yet, so they're read from and written to the raw request and response bodies.
+type IdentityPermissions struct {
+	Permissions []string `json:"permissions"`
+}
+
+func applyIdentityPermissions(body []byte, identity *model.Identity) error {
+	if len(body) == 0 {
+		return nil
+	}
+	restPermissions := &IdentityPermissions{}
+	if err := json.Unmarshal(body, restPermissions); err != nil {
+		return errorz.NewFieldError(err.Error(), db.FieldIdentityPermissions, nil)
+	}
+	identity.Permissions = restPermissions.Permissions
+	return nil
+}
+
+// addIdentityPermissionsField marks the permissions as updated if they're present in a patch body
+func addIdentityPermissionsField(body []byte, updatedFields fields.UpdatedFields) fields.UpdatedFields {
+	jsonMap := map[string]interface{}{}
+	if err := json.Unmarshal(body, &jsonMap); err != nil {
+		return updatedFields
+	}
+	if _, found := jsonMap[db.FieldIdentityPermissions]; found {
+		updatedFields = updatedFields.AddField(db.FieldIdentityPermissions)
+	}
+	return updatedFields
+}
+
+// IdentityDetail adds the permissions, when any are granted, and the bandwidth limits, when set, to the published
+// identity detail
+type IdentityDetail struct {
+	*rest_model.IdentityDetail
+	Permissions     *IdentityPermissions
+	BandwidthLimits *bandwidthLimitsHolder
+}
+
+func (self *IdentityDetail) MarshalJSON() ([]byte, error) {
+	detail, err := self.IdentityDetail.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	parts := [][]byte{detail}
+
+	if self.Permissions != nil {
+		permissions, err := json.Marshal(self.Permissions)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, permissions)
+	}
+
+	if self.BandwidthLimits != nil {
+		limits, err := json.Marshal(self.BandwidthLimits)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, limits)
+	}
+
+	return swag.ConcatJSON(parts...), nil
+}
+
+func MapIdentityToRestEntity(ae *env.AppEnv, rc *response.RequestContext, entity *model.Identity) (interface{}, error) {
+	detail, err := MapIdentityToRestModel(ae, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	if detail.Enrollment != nil && !canViewEnrollmentSecrets(rc, entity) {
+		if detail.Enrollment.Updb != nil {
+			detail.Enrollment.Updb.JWT = ""
+			detail.Enrollment.Updb.Token = ""
+		}
+		if detail.Enrollment.Ott != nil {
+			detail.Enrollment.Ott.JWT = ""
+			detail.Enrollment.Ott.Token = ""
+		}
+		if detail.Enrollment.Ottca != nil {
+			detail.Enrollment.Ottca.JWT = ""
+			detail.Enrollment.Ottca.Token = ""
+		}
+	}
+
+	result := &IdentityDetail{
+		IdentityDetail: detail,
+	}
+
+	if len(entity.Permissions) > 0 {
+		result.Permissions = &IdentityPermissions{Permissions: entity.Permissions}
+	}
+
+	result.BandwidthLimits = newBandwidthLimitsHolder(entity.BandwidthLimits)
+
+	return result, nil
 }
 
 func MapIdentityToRestModel(ae *env.AppEnv, identity *model.Identity) (*rest_model.IdentityDetail, error) {
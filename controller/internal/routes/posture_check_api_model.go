@@ -17,6 +17,8 @@
 package routes
 
 import (
+	"encoding/json"
+
 	"github.com/go-openapi/strfmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge-api/rest_model"
@@ -371,11 +373,64 @@ func MapPostureCheckToRestModel(ae *env.AppEnv, rc *response.RequestContext, i *
 
 		ret = detail
 		setBaseEntityDetailsOnPostureCheck(ret, i)
+	case *model.PostureCheckSourceIp:
+		ret = &PostureCheckSourceIpDetail{
+			PostureCheckDomainDetail: &rest_model.PostureCheckDomainDetail{},
+			AllowedCidrs:             subType.AllowedCidrs,
+			DeniedCidrs:              subType.DeniedCidrs,
+			AllowedCountries:         subType.AllowedCountries,
+			DeniedCountries:          subType.DeniedCountries,
+		}
+		setBaseEntityDetailsOnPostureCheck(ret, i)
 	}
 
 	return ret, nil
 }
 
+// PostureCheckSourceIpDetail publishes SOURCE_IP posture checks, which aren't part of the edge-api posture check
+// schema yet. It reuses the domain detail for the common posture check fields.
+type PostureCheckSourceIpDetail struct {
+	*rest_model.PostureCheckDomainDetail
+	AllowedCidrs     []string
+	DeniedCidrs      []string
+	AllowedCountries []string
+	DeniedCountries  []string
+}
+
+func (self *PostureCheckSourceIpDetail) TypeID() string {
+	return model.PostureCheckTypeSourceIp
+}
+
+func (self *PostureCheckSourceIpDetail) SetTypeID(string) {}
+
+func (self *PostureCheckSourceIpDetail) MarshalJSON() ([]byte, error) {
+	base, err := self.PostureCheckDomainDetail.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{}
+	if err = json.Unmarshal(base, &fields); err != nil {
+		return nil, err
+	}
+
+	delete(fields, "domains")
+	fields["typeId"] = self.TypeID()
+	fields["allowedCidrs"] = nonNilStrings(self.AllowedCidrs)
+	fields["deniedCidrs"] = nonNilStrings(self.DeniedCidrs)
+	fields["allowedCountries"] = nonNilStrings(self.AllowedCountries)
+	fields["deniedCountries"] = nonNilStrings(self.DeniedCountries)
+
+	return json.Marshal(fields)
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
 func setBaseEntityDetailsOnPostureCheck(check rest_model.PostureCheckDetail, i *model.PostureCheck) {
 	if i.RoleAttributes == nil {
 		i.RoleAttributes = []string{}
@@ -0,0 +1,172 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/env"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/internal/permissions"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/response"
+)
+
+func init() {
+	env.AddManagementApiMiddleware(handleSourceIpPostureCheckWrites)
+}
+
+// PostureCheckSourceIpWrite is the create, update and patch body of SOURCE_IP posture checks
+type PostureCheckSourceIpWrite struct {
+	Name             *string                `json:"name"`
+	TypeId           string                 `json:"typeId"`
+	RoleAttributes   *rest_model.Attributes `json:"roleAttributes"`
+	Tags             *rest_model.Tags       `json:"tags"`
+	AllowedCidrs     []string               `json:"allowedCidrs"`
+	DeniedCidrs      []string               `json:"deniedCidrs"`
+	AllowedCountries []string               `json:"allowedCountries"`
+	DeniedCountries  []string               `json:"deniedCountries"`
+}
+
+func parseSourceIpPostureCheckWrite(body []byte) (*PostureCheckSourceIpWrite, error) {
+	result := &PostureCheckSourceIpWrite{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, err
+	}
+	if result.TypeId != "" && result.TypeId != model.PostureCheckTypeSourceIp {
+		return nil, errorz.NewFieldError("the type of a posture check can't be changed", "typeId", result.TypeId)
+	}
+	return result, nil
+}
+
+func MapSourceIpPostureCheckToModel(id string, write *PostureCheckSourceIpWrite) *model.PostureCheck {
+	return &model.PostureCheck{
+		BaseEntity: models.BaseEntity{
+			Id:   id,
+			Tags: TagsOrDefault(write.Tags),
+		},
+		Name:           stringz.OrEmpty(write.Name),
+		TypeId:         model.PostureCheckTypeSourceIp,
+		Version:        1,
+		RoleAttributes: AttributesOrDefault(write.RoleAttributes),
+		SubType: &model.PostureCheckSourceIp{
+			AllowedCidrs:     write.AllowedCidrs,
+			DeniedCidrs:      write.DeniedCidrs,
+			AllowedCountries: write.AllowedCountries,
+			DeniedCountries:  write.DeniedCountries,
+		},
+	}
+}
+
+// handleSourceIpPostureCheckWrites serves create, update and patch requests for SOURCE_IP posture checks. The
+// published posture check model rejects types it doesn't know while binding the body, so these requests are handled
+// before the generated operations see them.
+func handleSourceIpPostureCheckWrites(ae *env.AppEnv, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		route := middleware.MatchedRouteFrom(r)
+		if route == nil || route.Operation == nil {
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		rc, err := env.GetRequestContextFromHttpContext(r)
+		if err != nil || rc == nil {
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		id := route.Params.Get("id")
+		var handler func(ae *env.AppEnv, rc *response.RequestContext)
+
+		switch route.Operation.ID {
+		case "createPostureCheck":
+			if isSourceIpPostureCheckBody(rc.Body) {
+				handler = createSourceIpPostureCheck
+			}
+		case "updatePostureCheck":
+			if isSourceIpPostureCheck(ae, id) {
+				handler = updateSourceIpPostureCheck
+			}
+		case "patchPostureCheck":
+			if isSourceIpPostureCheck(ae, id) {
+				handler = patchSourceIpPostureCheck
+			}
+		}
+
+		if handler == nil {
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		ae.IsAllowed(handler, r, id, "", permissions.CanWrite(EntityNamePostureCheck)).WriteResponse(rw, runtime.JSONProducer())
+	})
+}
+
+func isSourceIpPostureCheckBody(body []byte) bool {
+	typeId := struct {
+		TypeId string `json:"typeId"`
+	}{}
+	return json.Unmarshal(body, &typeId) == nil && typeId.TypeId == model.PostureCheckTypeSourceIp
+}
+
+func isSourceIpPostureCheck(ae *env.AppEnv, id string) bool {
+	if id == "" {
+		return false
+	}
+	check, err := ae.Managers.PostureCheck.Read(id)
+	return err == nil && check.TypeId == model.PostureCheckTypeSourceIp
+}
+
+func createSourceIpPostureCheck(ae *env.AppEnv, rc *response.RequestContext) {
+	Create(rc, rc, PostureCheckLinkFactory, func() (string, error) {
+		write, err := parseSourceIpPostureCheckWrite(rc.Body)
+		if err != nil {
+			return "", err
+		}
+		if stringz.OrEmpty(write.Name) == "" {
+			return "", errorz.NewFieldError("name is required", "name", "")
+		}
+		return MapCreate(ae.Managers.PostureCheck.Create, MapSourceIpPostureCheckToModel("", write), rc)
+	})
+}
+
+func updateSourceIpPostureCheck(ae *env.AppEnv, rc *response.RequestContext) {
+	Update(rc, func(id string) error {
+		write, err := parseSourceIpPostureCheckWrite(rc.Body)
+		if err != nil {
+			return err
+		}
+		return ae.Managers.PostureCheck.Update(MapSourceIpPostureCheckToModel(id, write), nil, rc.NewChangeContext())
+	})
+}
+
+func patchSourceIpPostureCheck(ae *env.AppEnv, rc *response.RequestContext) {
+	Patch(rc, func(id string, fields fields.UpdatedFields) error {
+		write, err := parseSourceIpPostureCheckWrite(rc.Body)
+		if err != nil {
+			return err
+		}
+		return ae.Managers.PostureCheck.Update(MapSourceIpPostureCheckToModel(id, write), fields.FilterMaps("tags"), rc.NewChangeContext())
+	})
+}
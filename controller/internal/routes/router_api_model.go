@@ -57,8 +57,16 @@ func MapPatchTransitRouterToModel(id string, router *rest_model.RouterPatch) *mo
 	return ret
 }
 
-func MapTransitRouterToRestEntity(ae *env.AppEnv, _ *response.RequestContext, router *model.TransitRouter) (interface{}, error) {
-	return MapTransitRouterToRestModel(ae, router)
+func MapTransitRouterToRestEntity(ae *env.AppEnv, rc *response.RequestContext, router *model.TransitRouter) (interface{}, error) {
+	result, err := MapTransitRouterToRestModel(ae, router)
+	if err != nil {
+		return nil, err
+	}
+	if !canViewEnrollmentSecrets(rc, nil) {
+		result.EnrollmentJWT = nil
+		result.EnrollmentToken = nil
+	}
+	return result, nil
 }
 
 func MapTransitRouterToRestModel(ae *env.AppEnv, router *model.TransitRouter) (*rest_model.RouterDetail, error) {
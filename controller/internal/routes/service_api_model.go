@@ -17,12 +17,16 @@
 package routes
 
 import (
+	"encoding/json"
 	"fmt"
+	"github.com/go-openapi/swag"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/foundation/v2/stringz"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/env"
+	"github.com/openziti/ziti/controller/fields"
 	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/models"
 	"github.com/openziti/ziti/controller/response"
@@ -54,6 +58,44 @@ func (factory *ServiceLinkFactoryIml) Links(entity models.Entity) rest_model.Lin
 	return links
 }
 
+// ServiceCircuitOptions holds the service multipath mode, priority class and compression codec, which apply to the
+// service's circuits. They aren't part of the published edge management API model yet, so they're read from and
+// written to the raw request and response bodies.
+type ServiceCircuitOptions struct {
+	Multipath   *string `json:"multipath,omitempty"`
+	Priority    *string `json:"priority,omitempty"`
+	Compression *string `json:"compression,omitempty"`
+}
+
+func applyServiceCircuitOptions(body []byte, service *model.EdgeService) error {
+	if len(body) == 0 {
+		return nil
+	}
+	restOptions := &ServiceCircuitOptions{}
+	if err := json.Unmarshal(body, restOptions); err != nil {
+		return errorz.NewFieldError(err.Error(), db.FieldServiceMultipath, nil)
+	}
+	service.Multipath = stringz.OrEmpty(restOptions.Multipath)
+	service.Priority = stringz.OrEmpty(restOptions.Priority)
+	service.Compression = stringz.OrEmpty(restOptions.Compression)
+	return nil
+}
+
+// addServiceCircuitOptionFields marks the multipath mode, priority class and compression codec as updated if they're
+// present in a patch body
+func addServiceCircuitOptionFields(body []byte, updatedFields fields.UpdatedFields) fields.UpdatedFields {
+	jsonMap := map[string]interface{}{}
+	if err := json.Unmarshal(body, &jsonMap); err != nil {
+		return updatedFields
+	}
+	for _, field := range []string{db.FieldServiceMultipath, db.FieldServicePriority, db.FieldServiceCompression} {
+		if _, found := jsonMap[field]; found {
+			updatedFields = updatedFields.AddField(field)
+		}
+	}
+	return updatedFields
+}
+
 func MapCreateServiceToModel(service *rest_model.ServiceCreate) *model.EdgeService {
 	ret := &model.EdgeService{
 		BaseEntity: models.BaseEntity{
@@ -104,8 +146,66 @@ func MapPatchServiceToModel(id string, service *rest_model.ServicePatch) *model.
 	return ret
 }
 
+// ServiceDetail adds the circuit options and bandwidth limits, when set, to the published service detail
+type ServiceDetail struct {
+	*rest_model.ServiceDetail
+	CircuitOptions  *ServiceCircuitOptions
+	BandwidthLimits *bandwidthLimitsHolder
+}
+
+func (self *ServiceDetail) MarshalJSON() ([]byte, error) {
+	detail, err := self.ServiceDetail.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	parts := [][]byte{detail}
+
+	if self.CircuitOptions != nil {
+		circuitOptions, err := json.Marshal(self.CircuitOptions)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, circuitOptions)
+	}
+
+	if self.BandwidthLimits != nil {
+		limits, err := json.Marshal(self.BandwidthLimits)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, limits)
+	}
+
+	return swag.ConcatJSON(parts...), nil
+}
+
 func MapServiceToRestEntity(ae *env.AppEnv, rc *response.RequestContext, service *model.ServiceDetail) (interface{}, error) {
-	return MapServiceToRestModel(ae, rc, service)
+	detail, err := MapServiceToRestModel(ae, rc, service)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ServiceDetail{
+		ServiceDetail: detail,
+	}
+
+	if service.Multipath != "" || service.Priority != "" || service.Compression != "" {
+		result.CircuitOptions = &ServiceCircuitOptions{}
+		if service.Multipath != "" {
+			result.CircuitOptions.Multipath = &service.Multipath
+		}
+		if service.Priority != "" {
+			result.CircuitOptions.Priority = &service.Priority
+		}
+		if service.Compression != "" {
+			result.CircuitOptions.Compression = &service.Compression
+		}
+	}
+
+	result.BandwidthLimits = newBandwidthLimitsHolder(service.BandwidthLimits)
+
+	return result, nil
 }
 
 func MapServicesToRestEntity(ae *env.AppEnv, rc *response.RequestContext, es []*model.ServiceDetail) ([]interface{}, error) {
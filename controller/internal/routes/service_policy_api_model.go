@@ -17,12 +17,20 @@
 package routes
 
 import (
+	"encoding/json"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/common/schedule"
+	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/env"
+	"github.com/openziti/ziti/controller/fields"
 	"github.com/openziti/ziti/controller/model"
-	"github.com/openziti/ziti/controller/response"
 	"github.com/openziti/ziti/controller/models"
-	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/response"
+	"time"
 )
 
 const EntityNameServicePolicy = "service-policies"
@@ -48,6 +56,80 @@ func (factory *ServicePolicyLinkFactoryImpl) Links(entity models.Entity) rest_mo
 	return links
 }
 
+// ServicePolicySchedule holds the service policy validity period and access windows. These aren't part of the published
+// edge management API model yet, so they're read from and written to the raw request and response bodies.
+type ServicePolicySchedule struct {
+	ValidFrom  *strfmt.DateTime               `json:"validFrom,omitempty"`
+	ValidUntil *strfmt.DateTime               `json:"validUntil,omitempty"`
+	Schedules  []*ServicePolicyScheduleWindow `json:"schedules,omitempty"`
+}
+
+type ServicePolicyScheduleWindow struct {
+	Cron     string `json:"cron"`
+	Duration string `json:"duration"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+func parseServicePolicySchedule(body []byte) (*ServicePolicySchedule, error) {
+	result := &ServicePolicySchedule{}
+	if len(body) == 0 {
+		return result, nil
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, errorz.NewFieldError(err.Error(), db.FieldServicePolicySchedules, nil)
+	}
+	return result, nil
+}
+
+func applyServicePolicySchedule(body []byte, policy *model.ServicePolicy) error {
+	restSchedule, err := parseServicePolicySchedule(body)
+	if err != nil {
+		return err
+	}
+
+	if restSchedule.ValidFrom != nil {
+		t := time.Time(*restSchedule.ValidFrom)
+		policy.ValidFrom = &t
+	}
+
+	if restSchedule.ValidUntil != nil {
+		t := time.Time(*restSchedule.ValidUntil)
+		policy.ValidUntil = &t
+	}
+
+	for _, window := range restSchedule.Schedules {
+		if window == nil {
+			return errorz.NewFieldError("schedules may not contain empty entries", db.FieldServicePolicySchedules, nil)
+		}
+		duration, err := time.ParseDuration(window.Duration)
+		if err != nil {
+			return errorz.NewFieldError("invalid schedule duration", db.FieldServicePolicySchedules, window.Duration)
+		}
+		policy.Schedules = append(policy.Schedules, &schedule.Window{
+			Cron:     window.Cron,
+			Duration: duration,
+			Timezone: window.Timezone,
+		})
+	}
+
+	return nil
+}
+
+// addServicePolicyScheduleFields marks the schedule fields as updated if they're present in a patch body. Unlike other
+// fields, they can be cleared by patching them to null.
+func addServicePolicyScheduleFields(body []byte, updatedFields fields.UpdatedFields) fields.UpdatedFields {
+	jsonMap := map[string]interface{}{}
+	if err := json.Unmarshal(body, &jsonMap); err != nil {
+		return updatedFields
+	}
+	for _, field := range []string{db.FieldServicePolicyValidFrom, db.FieldServicePolicyValidUntil, db.FieldServicePolicySchedules} {
+		if _, found := jsonMap[field]; found {
+			updatedFields = updatedFields.AddField(field)
+		}
+	}
+	return updatedFields
+}
+
 func MapCreateServicePolicyToModel(policy *rest_model.ServicePolicyCreate) *model.ServicePolicy {
 	semantic := ""
 	if policy.Semantic != nil {
@@ -108,8 +190,55 @@ func MapPatchServicePolicyToModel(id string, policy *rest_model.ServicePolicyPat
 	return ret
 }
 
+// ServicePolicyDetail adds the policy schedule, when set, to the published service policy detail
+type ServicePolicyDetail struct {
+	*rest_model.ServicePolicyDetail
+	Schedule *ServicePolicySchedule
+}
+
+func (self *ServicePolicyDetail) MarshalJSON() ([]byte, error) {
+	detail, err := self.ServicePolicyDetail.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	if self.Schedule == nil {
+		return detail, nil
+	}
+
+	schedule, err := json.Marshal(self.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return swag.ConcatJSON(detail, schedule), nil
+}
+
 func MapServicePolicyToRestEntity(ae *env.AppEnv, _ *response.RequestContext, policy *model.ServicePolicy) (interface{}, error) {
-	return MapServicePolicyToRestModel(ae, policy), nil
+	result := &ServicePolicyDetail{
+		ServicePolicyDetail: MapServicePolicyToRestModel(ae, policy),
+	}
+
+	if policy.GetSchedule() != nil {
+		result.Schedule = &ServicePolicySchedule{}
+		if policy.ValidFrom != nil {
+			validFrom := strfmt.DateTime(*policy.ValidFrom)
+			result.Schedule.ValidFrom = &validFrom
+		}
+		if policy.ValidUntil != nil {
+			validUntil := strfmt.DateTime(*policy.ValidUntil)
+			result.Schedule.ValidUntil = &validUntil
+		}
+		for _, window := range policy.Schedules {
+			result.Schedule.Schedules = append(result.Schedule.Schedules, &ServicePolicyScheduleWindow{
+				Cron:     window.Cron,
+				Duration: window.Duration.String(),
+				Timezone: window.Timezone,
+			})
+		}
+	}
+
+	return result, nil
 }
 
 func MapServicePolicyToRestModel(ae *env.AppEnv, policy *model.ServicePolicy) *rest_model.ServicePolicyDetail {
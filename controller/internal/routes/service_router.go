@@ -24,6 +24,7 @@ import (
 	managementService "github.com/openziti/edge-api/rest_management_api_server/operations/service"
 	"github.com/openziti/edge-api/rest_model"
 	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
 	"github.com/openziti/metrics"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/controller/db"
@@ -81,53 +82,53 @@ func (r *ServiceRouter) Register(ae *env.AppEnv) {
 
 	//Management
 	ae.ManagementApi.ServiceDeleteServiceHandler = managementService.DeleteServiceHandlerFunc(func(params managementService.DeleteServiceParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameService))
 	})
 
 	ae.ManagementApi.ServiceDetailServiceHandler = managementService.DetailServiceHandlerFunc(func(params managementService.DetailServiceParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameService))
 	})
 
 	ae.ManagementApi.ServiceListServicesHandler = managementService.ListServicesHandlerFunc(func(params managementService.ListServicesParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.ListManagementServices, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(r.ListManagementServices, params.HTTPRequest, "", "", permissions.CanRead(EntityNameService))
 	})
 
 	ae.ManagementApi.ServiceUpdateServiceHandler = managementService.UpdateServiceHandlerFunc(func(params managementService.UpdateServiceParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameService))
 	})
 
 	ae.ManagementApi.ServiceCreateServiceHandler = managementService.CreateServiceHandlerFunc(func(params managementService.CreateServiceParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.CanWrite(EntityNameService))
 	})
 
 	ae.ManagementApi.ServicePatchServiceHandler = managementService.PatchServiceHandlerFunc(func(params managementService.PatchServiceParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameService))
 	})
 
 	ae.ManagementApi.ServiceListServiceServiceEdgeRouterPoliciesHandler = managementService.ListServiceServiceEdgeRouterPoliciesHandlerFunc(func(params managementService.ListServiceServiceEdgeRouterPoliciesParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.listServiceEdgeRouterPolicies, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.listServiceEdgeRouterPolicies, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameService), permissions.CanRead(EntityNameServiceEdgeRouterPolicy))
 	})
 
 	ae.ManagementApi.ServiceListServiceEdgeRoutersHandler = managementService.ListServiceEdgeRoutersHandlerFunc(func(params managementService.ListServiceEdgeRoutersParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.listEdgeRouters, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.listEdgeRouters, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameService), permissions.CanRead(EntityNameEdgeRouter))
 	})
 
 	ae.ManagementApi.ServiceListServiceServicePoliciesHandler = managementService.ListServiceServicePoliciesHandlerFunc(func(params managementService.ListServiceServicePoliciesParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.listServicePolicies, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.listServicePolicies, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameService), permissions.CanRead(EntityNameServicePolicy))
 	})
 
 	ae.ManagementApi.ServiceListServiceIdentitiesHandler = managementService.ListServiceIdentitiesHandlerFunc(func(params managementService.ListServiceIdentitiesParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) {
 			r.listIdentities(ae, rc, params)
-		}, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		}, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameService), permissions.CanRead(EntityNameIdentity))
 	})
 
 	ae.ManagementApi.ServiceListServiceConfigHandler = managementService.ListServiceConfigHandlerFunc(func(params managementService.ListServiceConfigParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.listConfigs, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.listConfigs, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameService), permissions.CanRead(EntityNameConfig))
 	})
 
 	ae.ManagementApi.ServiceListServiceTerminatorsHandler = managementService.ListServiceTerminatorsHandlerFunc(func(params managementService.ListServiceTerminatorsParams, i interface{}) middleware.Responder {
-		return ae.IsAllowed(r.listManagementTerminators, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.listManagementTerminators, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameService), permissions.CanRead(EntityNameTerminator))
 	})
 }
 
@@ -243,23 +244,71 @@ func (r *ServiceRouter) Detail(ae *env.AppEnv, rc *response.RequestContext) {
 
 func (r *ServiceRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params managementService.CreateServiceParams) {
 	Create(rc, rc, ServiceLinkFactory, func() (string, error) {
-		return MapCreate(ae.Managers.EdgeService.Create, MapCreateServiceToModel(params.Service), rc)
+		svc := MapCreateServiceToModel(params.Service)
+		if err := applyServiceCircuitOptions(rc.Body, svc); err != nil {
+			return "", err
+		}
+		var err error
+		if svc.BandwidthLimits, err = parseBandwidthLimits(rc.Body); err != nil {
+			return "", err
+		}
+		if err := checkServiceOperatorScope(rc, svc.RoleAttributes); err != nil {
+			return "", err
+		}
+		return MapCreate(ae.Managers.EdgeService.Create, svc, rc)
 	})
 }
 
 func (r *ServiceRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
-	DeleteWithHandler(rc, ae.Managers.EdgeService)
+	Delete(rc, func(rc *response.RequestContext, id string) error {
+		if err := checkCurrentServiceOperatorScope(ae, rc, id); err != nil {
+			return err
+		}
+		return ae.Managers.EdgeService.Delete(id, rc.NewChangeContext())
+	})
 }
 
 func (r *ServiceRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params managementService.UpdateServiceParams) {
 	Update(rc, func(id string) error {
-		return ae.Managers.EdgeService.Update(MapUpdateServiceToModel(params.ID, params.Service), nil, rc.NewChangeContext())
+		svc := MapUpdateServiceToModel(params.ID, params.Service)
+		if err := applyServiceCircuitOptions(rc.Body, svc); err != nil {
+			return err
+		}
+		var err error
+		if svc.BandwidthLimits, err = parseBandwidthLimits(rc.Body); err != nil {
+			return err
+		}
+		if err := checkCurrentServiceOperatorScope(ae, rc, id); err != nil {
+			return err
+		}
+		if err := checkServiceOperatorScope(rc, svc.RoleAttributes); err != nil {
+			return err
+		}
+		return ae.Managers.EdgeService.Update(svc, nil, rc.NewChangeContext())
 	})
 }
 
 func (r *ServiceRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params managementService.PatchServiceParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
-		return ae.Managers.EdgeService.Update(MapPatchServiceToModel(params.ID, params.Service), fields.FilterMaps("tags").MapField("maxIdleTimeMillis", "maxIdleTime"), rc.NewChangeContext())
+		svc := MapPatchServiceToModel(params.ID, params.Service)
+		if err := applyServiceCircuitOptions(rc.Body, svc); err != nil {
+			return err
+		}
+		var err error
+		if svc.BandwidthLimits, err = parseBandwidthLimits(rc.Body); err != nil {
+			return err
+		}
+		fields = addServiceCircuitOptionFields(rc.Body, fields)
+		fields = addBandwidthLimitsField(rc.Body, fields)
+		if err := checkCurrentServiceOperatorScope(ae, rc, id); err != nil {
+			return err
+		}
+		if fields.IsUpdated(db.FieldRoleAttributes) {
+			if err := checkServiceOperatorScope(rc, svc.RoleAttributes); err != nil {
+				return err
+			}
+		}
+		return ae.Managers.EdgeService.Update(svc, fields.FilterMaps("tags").MapField("maxIdleTimeMillis", "maxIdleTime"), rc.NewChangeContext())
 	})
 }
 
@@ -394,3 +443,48 @@ func getServiceEdgeRouters(ae *env.AppEnv, rc *response.RequestContext, serviceI
 
 	return edgeRouters, nil
 }
+
+// checkServiceOperatorScope ensures that service operators who are limited to services with given role attributes
+// don't create or update services without any of those attributes
+func checkServiceOperatorScope(rc *response.RequestContext, roleAttributes []string) error {
+	attributes, unrestricted := permissions.GetServiceOperatorScope(rc.ActivePermissions...)
+	if unrestricted {
+		return nil
+	}
+	for _, attr := range attributes {
+		if stringz.Contains(roleAttributes, attr) {
+			return nil
+		}
+	}
+	return errorz.NewUnauthorized()
+}
+
+// checkCurrentServiceOperatorScope ensures that service operators who are limited to services with given role
+// attributes only modify or delete services which currently carry one of those attributes
+func checkCurrentServiceOperatorScope(ae *env.AppEnv, rc *response.RequestContext, id string) error {
+	if _, unrestricted := permissions.GetServiceOperatorScope(rc.ActivePermissions...); unrestricted {
+		return nil
+	}
+	svc, err := ae.Managers.EdgeService.Read(id)
+	if err != nil {
+		return err
+	}
+	return checkServiceOperatorScope(rc, svc.RoleAttributes)
+}
+
+// checkServicesOperatorScope ensures that service operators who are limited to services with given role attributes
+// only change configs and terminators of services which currently carry one of those attributes
+func checkServicesOperatorScope(ae *env.AppEnv, rc *response.RequestContext, serviceIds ...string) error {
+	if _, unrestricted := permissions.GetServiceOperatorScope(rc.ActivePermissions...); unrestricted {
+		return nil
+	}
+	for _, serviceId := range serviceIds {
+		if err := checkCurrentServiceOperatorScope(ae, rc, serviceId); err != nil {
+			if boltz.IsErrNotFoundErr(err) {
+				return errorz.NewUnauthorized()
+			}
+			return err
+		}
+	}
+	return nil
+}
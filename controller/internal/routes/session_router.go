@@ -127,6 +127,7 @@ func (r *SessionRouter) Create(ae *env.AppEnv, rc *response.RequestContext, para
 	start := time.Now()
 	if rc.Claims != nil {
 		request := MapCreateSessionToModel(rc.Claims.Subject, rc.Claims.ApiSessionId, params.Session)
+		ae.Managers.PostureResponse.SetSourceIp(request.IdentityId, request.ApiSessionId, getRemoteIp(rc.Request))
 		token, err := ae.Managers.Session.CreateJwt(request, rc.NewChangeContext())
 
 		if err != nil {
@@ -159,6 +160,7 @@ func (r *SessionRouter) Create(ae *env.AppEnv, rc *response.RequestContext, para
 		rc.Respond(newSessionEnvelope, http.StatusCreated)
 	} else {
 		responder := &SessionRequestResponder{ae: ae, Responder: rc}
+		ae.Managers.PostureResponse.SetSourceIp(rc.Identity.Id, rc.ApiSession.Id, getRemoteIp(rc.Request))
 		CreateWithResponder(rc, responder, SessionLinkFactory, func() (string, error) {
 			return ae.Managers.Session.Create(MapCreateSessionToModel(rc.Identity.Id, rc.ApiSession.Id, params.Session), rc.NewChangeContext())
 		})
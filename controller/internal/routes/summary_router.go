@@ -25,6 +25,8 @@ import (
 	"github.com/openziti/ziti/controller/response"
 )
 
+const EntityNameSummary = "summary"
+
 func init() {
 	r := NewSummaryRouter()
 	env.AddRouter(r)
@@ -42,7 +44,7 @@ func NewSummaryRouter() *SummaryRouter {
 
 func (r *SummaryRouter) Register(ae *env.AppEnv) {
 	ae.ManagementApi.InformationalListSummaryHandler = informational.ListSummaryHandlerFunc(func(params informational.ListSummaryParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.CanRead(EntityNameSummary))
 	})
 
 }
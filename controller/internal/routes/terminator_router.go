@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openziti/edge-api/rest_management_api_server/operations/terminator"
 	"github.com/openziti/ziti/controller/api_impl"
+	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/env"
 	"github.com/openziti/ziti/controller/fields"
 	"github.com/openziti/ziti/controller/internal/permissions"
@@ -44,27 +45,27 @@ func NewTerminatorRouter() *TerminatorRouter {
 
 func (r *TerminatorRouter) Register(ae *env.AppEnv) {
 	ae.ManagementApi.TerminatorDeleteTerminatorHandler = terminator.DeleteTerminatorHandlerFunc(func(params terminator.DeleteTerminatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameTerminator))
 	})
 
 	ae.ManagementApi.TerminatorDetailTerminatorHandler = terminator.DetailTerminatorHandlerFunc(func(params terminator.DetailTerminatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameTerminator))
 	})
 
 	ae.ManagementApi.TerminatorListTerminatorsHandler = terminator.ListTerminatorsHandlerFunc(func(params terminator.ListTerminatorsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.CanRead(EntityNameTerminator))
 	})
 
 	ae.ManagementApi.TerminatorUpdateTerminatorHandler = terminator.UpdateTerminatorHandlerFunc(func(params terminator.UpdateTerminatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameTerminator))
 	})
 
 	ae.ManagementApi.TerminatorCreateTerminatorHandler = terminator.CreateTerminatorHandlerFunc(func(params terminator.CreateTerminatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.CanWrite(EntityNameTerminator))
 	})
 
 	ae.ManagementApi.TerminatorPatchTerminatorHandler = terminator.PatchTerminatorHandlerFunc(func(params terminator.PatchTerminatorParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanWrite(EntityNameTerminator))
 	})
 }
 
@@ -79,6 +80,9 @@ func (r *TerminatorRouter) Detail(ae *env.AppEnv, rc *response.RequestContext) {
 func (r *TerminatorRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params terminator.CreateTerminatorParams) {
 	Create(rc, rc, TerminatorLinkFactory, func() (string, error) {
 		entity := MapCreateTerminatorToModel(params.Terminator)
+		if err := checkServicesOperatorScope(ae, rc, entity.Service); err != nil {
+			return "", err
+		}
 		err := ae.Managers.Terminator.Create(entity, rc.NewChangeContext())
 		if err != nil {
 			return "", err
@@ -88,17 +92,47 @@ func (r *TerminatorRouter) Create(ae *env.AppEnv, rc *response.RequestContext, p
 }
 
 func (r *TerminatorRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
-	DeleteWithHandler(rc, ae.Managers.Terminator)
+	Delete(rc, func(rc *response.RequestContext, id string) error {
+		if err := checkTerminatorOperatorScope(ae, rc, id); err != nil {
+			return err
+		}
+		return ae.Managers.Terminator.Delete(id, rc.NewChangeContext())
+	})
 }
 
 func (r *TerminatorRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params terminator.UpdateTerminatorParams) {
 	Update(rc, func(id string) error {
-		return ae.Managers.Terminator.Update(MapUpdateTerminatorToModel(params.ID, params.Terminator), nil, rc.NewChangeContext())
+		entity := MapUpdateTerminatorToModel(params.ID, params.Terminator)
+		if err := checkTerminatorOperatorScope(ae, rc, id, entity.Service); err != nil {
+			return err
+		}
+		return ae.Managers.Terminator.Update(entity, nil, rc.NewChangeContext())
 	})
 }
 
 func (r *TerminatorRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params terminator.PatchTerminatorParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
-		return ae.Managers.Terminator.Update(MapPatchTerminatorToModel(params.ID, params.Terminator), fields.FilterMaps("tags"), rc.NewChangeContext())
+		entity := MapPatchTerminatorToModel(params.ID, params.Terminator)
+		var serviceIds []string
+		if fields.IsUpdated(db.FieldTerminatorService) {
+			serviceIds = append(serviceIds, entity.Service)
+		}
+		if err := checkTerminatorOperatorScope(ae, rc, id, serviceIds...); err != nil {
+			return err
+		}
+		return ae.Managers.Terminator.Update(entity, fields.FilterMaps("tags"), rc.NewChangeContext())
 	})
 }
+
+// checkTerminatorOperatorScope ensures that service operators limited to services with given role attributes only
+// change terminators of those services, and only move terminators to those services
+func checkTerminatorOperatorScope(ae *env.AppEnv, rc *response.RequestContext, id string, newServiceIds ...string) error {
+	if _, unrestricted := permissions.GetServiceOperatorScope(rc.ActivePermissions...); unrestricted {
+		return nil
+	}
+	entity, err := ae.Managers.Terminator.Read(id)
+	if err != nil {
+		return err
+	}
+	return checkServicesOperatorScope(ae, rc, append(newServiceIds, entity.Service)...)
+}
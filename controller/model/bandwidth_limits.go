@@ -0,0 +1,82 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/common/bandwidth"
+	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/controller/db"
+)
+
+func validateBandwidthLimits(limits *bandwidth.Limits) error {
+	if limits == nil {
+		return nil
+	}
+	if err := limits.Validate(); err != nil {
+		return errorz.NewFieldError(err.Error(), db.FieldBandwidthLimits, limits.String())
+	}
+	return nil
+}
+
+func bandwidthLimitsToBolt(limits *bandwidth.Limits) *db.BandwidthLimits {
+	if limits == nil {
+		return nil
+	}
+	return &db.BandwidthLimits{
+		IngressRate:  limits.IngressRate,
+		IngressBurst: limits.IngressBurst,
+		EgressRate:   limits.EgressRate,
+		EgressBurst:  limits.EgressBurst,
+	}
+}
+
+func bandwidthLimitsFromBolt(limits *db.BandwidthLimits) *bandwidth.Limits {
+	if limits == nil {
+		return nil
+	}
+	return &bandwidth.Limits{
+		IngressRate:  limits.IngressRate,
+		IngressBurst: limits.IngressBurst,
+		EgressRate:   limits.EgressRate,
+		EgressBurst:  limits.EgressBurst,
+	}
+}
+
+func bandwidthLimitsToProto(limits *bandwidth.Limits) *edge_cmd_pb.BandwidthLimits {
+	if limits == nil {
+		return nil
+	}
+	return &edge_cmd_pb.BandwidthLimits{
+		IngressRate:  limits.IngressRate,
+		IngressBurst: limits.IngressBurst,
+		EgressRate:   limits.EgressRate,
+		EgressBurst:  limits.EgressBurst,
+	}
+}
+
+func bandwidthLimitsFromProto(limits *edge_cmd_pb.BandwidthLimits) *bandwidth.Limits {
+	if limits == nil {
+		return nil
+	}
+	return &bandwidth.Limits{
+		IngressRate:  limits.IngressRate,
+		IngressBurst: limits.IngressBurst,
+		EgressRate:   limits.EgressRate,
+		EgressBurst:  limits.EgressBurst,
+	}
+}
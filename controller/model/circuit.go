@@ -72,7 +72,7 @@ func (self *Circuit) HasRouter(routerId string) bool {
 	if self == nil || self.Path == nil {
 		return false
 	}
-	for _, node := range self.Path.Nodes {
+	for _, node := range self.Path.RouteNodes() {
 		if node.Id == routerId {
 			return true
 		}
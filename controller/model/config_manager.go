@@ -78,6 +78,17 @@ func (self *ConfigManager) Read(id string) (*Config, error) {
 	return modelEntity, nil
 }
 
+// GetServiceIds returns the ids of services which use the config, either directly or as an identity override
+func (self *ConfigManager) GetServiceIds(id string) ([]string, error) {
+	var result []string
+	err := self.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		result, err = self.env.GetStores().Config.GetServiceIds(tx, id)
+		return err
+	})
+	return result, err
+}
+
 func (self *ConfigManager) readInTx(tx *bbolt.Tx, id string) (*Config, error) {
 	modelEntity := &Config{}
 	if err := self.readEntityInTx(tx, id, modelEntity); err != nil {
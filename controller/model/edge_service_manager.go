@@ -21,6 +21,7 @@ import (
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/common/schedule"
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/command"
 	"github.com/openziti/ziti/controller/db"
@@ -126,7 +127,7 @@ func (self *EdgeServiceManager) ReadForIdentityInTx(tx *bbolt.Tx, id string, ide
 	isBindable := edgeServiceStore.IsBindableByIdentity(tx, id, identityId)
 	isDialable := edgeServiceStore.IsDialableByIdentity(tx, id, identityId)
 
-	if !isBindable && !isDialable && !identity.IsAdmin { // admin can view services even if policies don't permit bind/dial {
+	if !isBindable && !isDialable && !identity.CanReadAllServices() { // admin can view services even if policies don't permit bind/dial {
 		return nil, boltz.NewNotFoundError(self.GetStore().GetSingularEntityType(), "id", id)
 	}
 
@@ -157,7 +158,7 @@ func (self *EdgeServiceManager) ReadForIdentityInTx(tx *bbolt.Tx, id string, ide
 }
 
 func (self *EdgeServiceManager) PublicQueryForIdentity(sessionIdentity *Identity, configTypes map[string]struct{}, query ast.Query) (*ServiceListResult, error) {
-	if sessionIdentity.IsAdmin {
+	if sessionIdentity.CanReadAllServices() {
 		return self.queryServices(query, sessionIdentity.Id, configTypes, true)
 	}
 	return self.QueryForIdentity(sessionIdentity.Id, configTypes, query)
@@ -207,6 +208,10 @@ func (self *EdgeServiceManager) Marshall(entity *EdgeService) ([]byte, error) {
 		RoleAttributes:     entity.RoleAttributes,
 		Configs:            entity.Configs,
 		EncryptionRequired: entity.EncryptionRequired,
+		Multipath:          entity.Multipath,
+		Priority:           entity.Priority,
+		Compression:        entity.Compression,
+		BandwidthLimits:    bandwidthLimitsToProto(entity.BandwidthLimits),
 	}
 
 	return proto.Marshal(msg)
@@ -229,6 +234,10 @@ func (self *EdgeServiceManager) Unmarshall(bytes []byte) (*EdgeService, error) {
 		RoleAttributes:     msg.RoleAttributes,
 		Configs:            msg.Configs,
 		EncryptionRequired: msg.EncryptionRequired,
+		Multipath:          msg.Multipath,
+		Priority:           msg.Priority,
+		Compression:        msg.Compression,
+		BandwidthLimits:    bandwidthLimitsFromProto(msg.BandwidthLimits),
 	}, nil
 }
 
@@ -309,6 +318,12 @@ type PolicyPostureChecks struct {
 	PostureChecks []*PostureCheck
 	PolicyType    db.PolicyType
 	PolicyName    string
+	Schedule      *schedule.Schedule
+}
+
+// IsActiveAt returns false if the policy has a schedule which doesn't grant access at the given time
+func (self *PolicyPostureChecks) IsActiveAt(t time.Time) bool {
+	return self.Schedule.IsActiveAt(t)
 }
 
 func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId string) map[string]*PolicyPostureChecks {
@@ -322,9 +337,10 @@ func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId str
 
 	policyNameSymbol := self.env.GetStores().ServicePolicy.GetSymbol(db.FieldName)
 	policyTypeSymbol := self.env.GetStores().ServicePolicy.GetSymbol(db.FieldServicePolicyType)
+	policyScheduledSymbol := self.env.GetStores().ServicePolicy.GetSymbol(db.FieldServicePolicyScheduled)
 
 	_ = self.GetDb().View(func(tx *bbolt.Tx) error {
-		if !self.env.GetStores().PostureCheck.IterateIds(tx, ast.BoolNodeTrue).IsValid() {
+		if !self.env.GetStores().PostureCheck.IterateIds(tx, ast.BoolNodeTrue).IsValid() && !self.hasScheduledPolicies(tx) {
 			return nil
 		}
 
@@ -351,6 +367,14 @@ func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId str
 				PolicyName:    *policyName,
 			}
 
+			if scheduled := boltz.FieldToBool(policyScheduledSymbol.Eval(tx, policyIdBytes)); scheduled != nil && *scheduled {
+				if policy, err := self.env.GetManagers().ServicePolicy.readInTx(tx, policyIdStr); err == nil {
+					policyIdToChecks[policyIdStr].Schedule = policy.GetSchedule()
+				} else {
+					pfxlog.Logger().WithError(err).WithField("policyId", policyIdStr).Error("unable to load service policy schedule")
+				}
+			}
+
 			cursor := postureCheckLinks.IterateLinks(tx, policyIdBytes)
 			for cursor.IsValid() {
 				checkId := string(cursor.Current())
@@ -370,6 +394,15 @@ func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId str
 	return policyIdToChecks
 }
 
+func (self *EdgeServiceManager) hasScheduledPolicies(tx *bbolt.Tx) bool {
+	query, err := ast.Parse(self.env.GetStores().ServicePolicy, db.FieldServicePolicyScheduled+" = true")
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to parse scheduled service policy query")
+		return true
+	}
+	return self.env.GetStores().ServicePolicy.IterateIds(tx, query).IsValid()
+}
+
 type ServiceDetailLister struct {
 	manager *EdgeServiceManager
 }
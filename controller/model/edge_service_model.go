@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/bandwidth"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/models"
 	"go.etcd.io/bbolt"
@@ -28,12 +29,16 @@ import (
 
 type EdgeService struct {
 	models.BaseEntity
-	Name               string        `json:"name"`
-	MaxIdleTime        time.Duration `json:"maxIdleTime"`
-	TerminatorStrategy string        `json:"terminatorStrategy"`
-	RoleAttributes     []string      `json:"roleAttributes"`
-	Configs            []string      `json:"configs"`
-	EncryptionRequired bool          `json:"encryptionRequired"`
+	Name               string            `json:"name"`
+	MaxIdleTime        time.Duration     `json:"maxIdleTime"`
+	TerminatorStrategy string            `json:"terminatorStrategy"`
+	RoleAttributes     []string          `json:"roleAttributes"`
+	Configs            []string          `json:"configs"`
+	EncryptionRequired bool              `json:"encryptionRequired"`
+	Multipath          string            `json:"multipath"`
+	Priority           string            `json:"priority"`
+	Compression        string            `json:"compression"`
+	BandwidthLimits    *bandwidth.Limits `json:"bandwidthLimits"`
 }
 
 func (entity *EdgeService) toBoltEntity(tx *bbolt.Tx, env Env) (*db.EdgeService, error) {
@@ -41,16 +46,24 @@ func (entity *EdgeService) toBoltEntity(tx *bbolt.Tx, env Env) (*db.EdgeService,
 		return nil, err
 	}
 
+	if err := validateBandwidthLimits(entity.BandwidthLimits); err != nil {
+		return nil, err
+	}
+
 	edgeService := &db.EdgeService{
 		Service: db.Service{
 			BaseExtEntity:      *boltz.NewExtEntity(entity.Id, entity.Tags),
 			Name:               entity.Name,
 			MaxIdleTime:        entity.MaxIdleTime,
 			TerminatorStrategy: entity.TerminatorStrategy,
+			Multipath:          entity.Multipath,
+			Priority:           entity.Priority,
+			Compression:        entity.Compression,
 		},
 		RoleAttributes:     entity.RoleAttributes,
 		Configs:            entity.Configs,
 		EncryptionRequired: entity.EncryptionRequired,
+		BandwidthLimits:    bandwidthLimitsToBolt(entity.BandwidthLimits),
 	}
 	return edgeService, nil
 }
@@ -93,6 +106,10 @@ func (entity *EdgeService) fillFrom(_ Env, _ *bbolt.Tx, boltService *db.EdgeServ
 	entity.RoleAttributes = boltService.RoleAttributes
 	entity.Configs = boltService.Configs
 	entity.EncryptionRequired = boltService.EncryptionRequired
+	entity.Multipath = boltService.Multipath
+	entity.Priority = boltService.Priority
+	entity.Compression = boltService.Compression
+	entity.BandwidthLimits = bandwidthLimitsFromBolt(boltService.BandwidthLimits)
 	return nil
 }
 
@@ -106,6 +123,10 @@ type ServiceDetail struct {
 	Configs            []string                          `json:"configs"`
 	Config             map[string]map[string]interface{} `json:"config"`
 	EncryptionRequired bool                              `json:"encryptionRequired"`
+	Multipath          string                            `json:"multipath"`
+	Priority           string                            `json:"priority"`
+	Compression        string                            `json:"compression"`
+	BandwidthLimits    *bandwidth.Limits                 `json:"bandwidthLimits"`
 }
 
 func (entity *ServiceDetail) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.EdgeService, error) {
@@ -124,6 +145,10 @@ func (entity *ServiceDetail) fillFrom(_ Env, _ *bbolt.Tx, boltService *db.EdgeSe
 	entity.RoleAttributes = boltService.RoleAttributes
 	entity.Configs = boltService.Configs
 	entity.EncryptionRequired = boltService.EncryptionRequired
+	entity.Multipath = boltService.Multipath
+	entity.Priority = boltService.Priority
+	entity.Compression = boltService.Compression
+	entity.BandwidthLimits = bandwidthLimitsFromBolt(boltService.BandwidthLimits)
 
 	return nil
 }
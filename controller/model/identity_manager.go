@@ -617,6 +617,7 @@ func (self *IdentityManager) IdentityToProtobuf(entity *Identity) (*edge_cmd_pb.
 		IsDefaultAdmin:            entity.IsDefaultAdmin,
 		IsAdmin:                   entity.IsAdmin,
 		RoleAttributes:            entity.RoleAttributes,
+		Permissions:               entity.Permissions,
 		EnvInfo:                   envInfo,
 		SdkInfo:                   sdkInfo,
 		DefaultHostingPrecedence:  uint32(entity.DefaultHostingPrecedence),
@@ -629,6 +630,7 @@ func (self *IdentityManager) IdentityToProtobuf(entity *Identity) (*edge_cmd_pb.
 		Disabled:                  entity.Disabled,
 		DisabledAt:                timePtrToPb(entity.DisabledAt),
 		DisabledUntil:             timePtrToPb(entity.DisabledUntil),
+		BandwidthLimits:           bandwidthLimitsToProto(entity.BandwidthLimits),
 	}
 
 	for serviceId, configInfo := range entity.ServiceConfigs {
@@ -717,6 +719,7 @@ func (self *IdentityManager) ProtobufToIdentity(msg *edge_cmd_pb.Identity) (*Ide
 		IsDefaultAdmin:            msg.IsDefaultAdmin,
 		IsAdmin:                   msg.IsAdmin,
 		RoleAttributes:            msg.RoleAttributes,
+		Permissions:               msg.Permissions,
 		EnvInfo:                   envInfo,
 		SdkInfo:                   sdkInfo,
 		DefaultHostingPrecedence:  ziti.Precedence(msg.DefaultHostingPrecedence),
@@ -730,6 +733,7 @@ func (self *IdentityManager) ProtobufToIdentity(msg *edge_cmd_pb.Identity) (*Ide
 		DisabledAt:                pbTimeToTimePtr(msg.DisabledAt),
 		DisabledUntil:             pbTimeToTimePtr(msg.DisabledUntil),
 		ServiceConfigs:            serviceConfigs,
+		BandwidthLimits:           bandwidthLimitsFromProto(msg.BandwidthLimits),
 	}, nil
 }
 
@@ -20,9 +20,11 @@ import (
 	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/sdk-golang/ziti"
 	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/bandwidth"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/models"
 	"go.etcd.io/bbolt"
+	"strings"
 	"time"
 )
 
@@ -95,6 +97,23 @@ type Identity struct {
 	DisabledAt                *time.Time
 	DisabledUntil             *time.Time
 	ServiceConfigs            map[string]map[string]string
+	Permissions               []string
+	BandwidthLimits           *bandwidth.Limits
+}
+
+// CanReadAllServices returns true if the identity can see every service, whether or not service policies grant it
+// access. Admins, auditors and service operators all need to see services they can't dial or bind.
+func (entity *Identity) CanReadAllServices() bool {
+	if entity.IsAdmin || entity.IsDefaultAdmin {
+		return true
+	}
+	for _, permission := range entity.Permissions {
+		if permission == db.IdentityPermissionAuditor || permission == db.IdentityPermissionServiceOperator ||
+			strings.HasPrefix(permission, db.IdentityPermissionServiceOperator+":") {
+			return true
+		}
+	}
+	return false
 }
 
 func (entity *Identity) toBoltEntityForCreate(_ *bbolt.Tx, env Env) (*db.Identity, error) {
@@ -118,6 +137,10 @@ func (entity *Identity) toBoltEntityForCreate(_ *bbolt.Tx, env Env) (*db.Identit
 
 	entity.IdentityTypeId = identityType.Id
 
+	if err = validateBandwidthLimits(entity.BandwidthLimits); err != nil {
+		return nil, err
+	}
+
 	boltEntity := &db.Identity{
 		BaseExtEntity:             *boltz.NewExtEntity(entity.Id, entity.Tags),
 		Name:                      entity.Name,
@@ -126,6 +149,7 @@ func (entity *Identity) toBoltEntityForCreate(_ *bbolt.Tx, env Env) (*db.Identit
 		IsDefaultAdmin:            entity.IsDefaultAdmin,
 		IsAdmin:                   entity.IsAdmin,
 		RoleAttributes:            entity.RoleAttributes,
+		Permissions:               entity.Permissions,
 		DefaultHostingPrecedence:  entity.DefaultHostingPrecedence,
 		DefaultHostingCost:        entity.DefaultHostingCost,
 		ServiceHostingPrecedences: entity.ServiceHostingPrecedences,
@@ -135,6 +159,7 @@ func (entity *Identity) toBoltEntityForCreate(_ *bbolt.Tx, env Env) (*db.Identit
 		DisabledAt:                entity.DisabledAt,
 		DisabledUntil:             entity.DisabledUntil,
 		ServiceConfigs:            entity.ServiceConfigs,
+		BandwidthLimits:           bandwidthLimitsToBolt(entity.BandwidthLimits),
 	}
 
 	if entity.EnvInfo != nil {
@@ -229,12 +254,19 @@ func (entity *Identity) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker bol
 		entity.IdentityTypeId = identityType.Id
 	}
 
+	if checker == nil || checker.IsUpdated(db.FieldBandwidthLimits) {
+		if err := validateBandwidthLimits(entity.BandwidthLimits); err != nil {
+			return nil, err
+		}
+	}
+
 	boltEntity := &db.Identity{
 		Name:                      entity.Name,
 		IdentityTypeId:            entity.IdentityTypeId,
 		AuthPolicyId:              entity.AuthPolicyId,
 		BaseExtEntity:             *boltz.NewExtEntity(entity.Id, entity.Tags),
 		RoleAttributes:            entity.RoleAttributes,
+		Permissions:               entity.Permissions,
 		DefaultHostingPrecedence:  entity.DefaultHostingPrecedence,
 		DefaultHostingCost:        entity.DefaultHostingCost,
 		ServiceHostingPrecedences: entity.ServiceHostingPrecedences,
@@ -245,6 +277,7 @@ func (entity *Identity) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker bol
 		DisabledUntil:             entity.DisabledUntil,
 		IsAdmin:                   entity.IsAdmin,
 		ServiceConfigs:            entity.ServiceConfigs,
+		BandwidthLimits:           bandwidthLimitsToBolt(entity.BandwidthLimits),
 	}
 
 	identityStore := env.GetManagers().Identity.GetStore()
@@ -278,6 +311,7 @@ func (entity *Identity) fillFrom(env Env, _ *bbolt.Tx, boltIdentity *db.Identity
 	entity.IsDefaultAdmin = boltIdentity.IsDefaultAdmin
 	entity.IsAdmin = boltIdentity.IsAdmin
 	entity.RoleAttributes = boltIdentity.RoleAttributes
+	entity.Permissions = boltIdentity.Permissions
 	entity.HasErConnection = env.GetManagers().Identity.HasErConnection(entity.Id)
 	entity.DefaultHostingPrecedence = boltIdentity.DefaultHostingPrecedence
 	entity.DefaultHostingCost = boltIdentity.DefaultHostingCost
@@ -289,6 +323,7 @@ func (entity *Identity) fillFrom(env Env, _ *bbolt.Tx, boltIdentity *db.Identity
 	entity.DisabledAt = boltIdentity.DisabledAt
 	entity.Disabled = boltIdentity.Disabled
 	entity.ServiceConfigs = boltIdentity.ServiceConfigs
+	entity.BandwidthLimits = bandwidthLimitsFromBolt(boltIdentity.BandwidthLimits)
 	fillModelInfo(entity, boltIdentity.EnvInfo, boltIdentity.SdkInfo)
 
 	return nil
@@ -29,24 +29,36 @@ import (
 	"time"
 )
 
+// TopologyChangeListener is notified when a link or connected router changes in a way which may affect the paths
+// between routers. Notifications may be made while holding link locks, so implementations must not block.
+type TopologyChangeListener interface {
+	LinkChanged(link *Link)
+	RouterChanged(router *Router)
+}
+
 type LinkManager struct {
 	linkTable      *linkTable
 	idGenerator    idgen.Generator
 	lock           sync.Mutex
 	initialLatency time.Duration
+	costConfig     *config.LinkCostConfig
 	store          *objectz.ObjectStore[*Link]
+	listener       TopologyChangeListener
 }
 
 func NewLinkManager(env Env) *LinkManager {
 	initialLatency := config.DefaultOptionsInitialLinkLatency
+	var costConfig *config.LinkCostConfig
 	if env != nil {
 		initialLatency = env.GetConfig().Network.InitialLinkLatency
+		costConfig = &env.GetConfig().Network.LinkCost
 	}
 
 	result := &LinkManager{
 		linkTable:      newLinkTable(),
 		idGenerator:    idgen.NewGenerator(),
 		initialLatency: initialLatency,
+		costConfig:     costConfig,
 	}
 
 	result.store = objectz.NewObjectStore[*Link](func() objectz.ObjectIterator[*Link] {
@@ -109,12 +121,19 @@ func (self *LinkManager) BuildRouterLinks(router *Router) {
 	})
 }
 
+// SetTopologyChangeListener sets the listener for links added after the call. It should be set before any links are added.
+func (self *LinkManager) SetTopologyChangeListener(listener TopologyChangeListener) {
+	self.listener = listener
+}
+
 func (self *LinkManager) Add(link *Link) {
+	link.listener = self.listener
 	self.linkTable.add(link)
 	link.Src.routerLinks.Add(link, link.DstId)
 	if dest := link.GetDest(); dest != nil {
 		dest.routerLinks.Add(link, link.Src.Id)
 	}
+	link.notifyChanged()
 }
 
 func (self *LinkManager) has(link *Link) bool {
@@ -155,7 +174,7 @@ func (self *LinkManager) RouterReportedLink(linkId string, iteration uint32, lin
 		log.Infof("replaced link with newer iteration %v => %v", link.Iteration, iteration)
 	}
 
-	link = newLink(linkId, linkProtocol, dialAddress, self.initialLatency)
+	link = newLink(linkId, linkProtocol, dialAddress, self.initialLatency, self.costConfig)
 	link.Iteration = iteration
 	link.Src = src
 	link.Dst.Store(dst)
@@ -187,6 +206,7 @@ func (self *LinkManager) Remove(link *Link) {
 		if dest := link.GetDest(); dest != nil {
 			dest.routerLinks.Remove(link, link.Src.Id)
 		}
+		link.notifyChanged()
 	}
 }
 
@@ -214,12 +234,20 @@ func (self *LinkManager) ConnectedNeighborsOfRouter(router *Router) []*Router {
 }
 
 func (self *LinkManager) LeastExpensiveLink(a, b *Router) (*Link, bool) {
+	return self.LeastExpensiveLinkExcluding(a, b, nil)
+}
+
+// LeastExpensiveLinkExcluding returns the least expensive usable link between the routers which isn't in the excluded set
+func (self *LinkManager) LeastExpensiveLinkExcluding(a, b *Router, excluded map[string]struct{}) (*Link, bool) {
 	var selected *Link
 	var cost int64 = math.MaxInt64
 
 	linksByRouter := a.routerLinks.GetLinksByRouter()
 	links := linksByRouter[b.Id]
 	for _, link := range links {
+		if _, skip := excluded[link.Id]; skip {
+			continue
+		}
 		if link.IsUsable() {
 			linkCost := link.GetCost()
 			if link.DstId == b.Id {
@@ -261,7 +289,7 @@ func (self *LinkManager) MissingLinks(routers []*Router, pendingTimeout time.Dur
 				for _, listener := range dstR.Listeners {
 					if !self.hasLink(srcR, dstR, listener.GetProtocol(), pendingLimit) {
 						id := idgen.NewUUIDString()
-						link := newLink(id, listener.GetProtocol(), listener.GetAddress(), self.initialLatency)
+						link := newLink(id, listener.GetProtocol(), listener.GetAddress(), self.initialLatency, self.costConfig)
 						link.Src = srcR
 						link.Dst.Store(dstR)
 						link.DstId = dstR.Id
@@ -18,6 +18,8 @@ package model
 
 import (
 	"github.com/openziti/foundation/v2/concurrenz"
+	"github.com/openziti/ziti/controller/config"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -40,9 +42,23 @@ type Link struct {
 	StaticCost  int32
 	usable      atomic.Bool
 	lock        sync.Mutex
+	costConfig  *config.LinkCostConfig
+	srcQuality  concurrenz.AtomicValue[*LinkQuality]
+	dstQuality  concurrenz.AtomicValue[*LinkQuality]
+	listener    TopologyChangeListener
 }
 
-func newLink(id string, linkProtocol string, dialAddress string, initialLatency time.Duration) *Link {
+// LinkQuality holds the link metrics, other than latency, reported by one side of a link
+type LinkQuality struct {
+	// Jitter is the standard deviation of the link latency, in nanoseconds
+	Jitter int64
+	// LossRatio is the fraction of messages which were dropped instead of sent, from 0 to 1
+	LossRatio float64
+	// TxRate is the rate at which data is being sent, in bytes per second
+	TxRate float64
+}
+
+func newLink(id string, linkProtocol string, dialAddress string, initialLatency time.Duration, costConfig *config.LinkCostConfig) *Link {
 	l := &Link{
 		Id:          id,
 		Protocol:    linkProtocol,
@@ -55,6 +71,7 @@ func newLink(id string, linkProtocol string, dialAddress string, initialLatency
 		StaticCost: 1,
 		SrcLatency: initialLatency.Nanoseconds(),
 		DstLatency: initialLatency.Nanoseconds(),
+		costConfig: costConfig,
 	}
 	l.RecalculateCost()
 	l.recalculateUsable()
@@ -98,12 +115,15 @@ func (link *Link) IsDown() bool {
 }
 
 func (link *Link) recalculateUsable() {
-	if link.down {
-		link.usable.Store(false)
-	} else if link.state.Mode != Connected {
-		link.usable.Store(false)
-	} else {
-		link.usable.Store(true)
+	usable := !link.down && link.state.Mode == Connected
+	if link.usable.Swap(usable) != usable {
+		link.notifyChanged()
+	}
+}
+
+func (link *Link) notifyChanged() {
+	if listener := link.listener; listener != nil {
+		listener.LinkChanged(link)
 	}
 }
 
@@ -138,9 +158,74 @@ func (link *Link) SetDstLatency(latency int64) {
 	link.RecalculateCost()
 }
 
+func (link *Link) GetSrcQuality() *LinkQuality {
+	return link.srcQuality.Load()
+}
+
+func (link *Link) SetSrcQuality(quality *LinkQuality) {
+	link.srcQuality.Store(quality)
+	link.RecalculateCost()
+}
+
+func (link *Link) GetDstQuality() *LinkQuality {
+	return link.dstQuality.Load()
+}
+
+func (link *Link) SetDstQuality(quality *LinkQuality) {
+	link.dstQuality.Store(quality)
+	link.RecalculateCost()
+}
+
 func (link *Link) RecalculateCost() {
 	cost := int64(link.GetStaticCost()) + link.GetSrcLatency()/1_000_000 + link.GetDstLatency()/1_000_000
-	atomic.StoreInt64(&link.Cost, cost)
+	cost += link.getQualityCost()
+	if atomic.SwapInt64(&link.Cost, cost) != cost {
+		link.notifyChanged()
+	}
+}
+
+// getQualityCost returns the cost added for jitter, loss and utilization, as weighted by the link cost config
+func (link *Link) getQualityCost() int64 {
+	costConfig := link.costConfig
+	if costConfig.IsLatencyOnly() {
+		return 0
+	}
+
+	src := link.GetSrcQuality()
+	dst := link.GetDstQuality()
+	if src == nil && dst == nil {
+		return 0
+	}
+	if src == nil {
+		src = &LinkQuality{}
+	}
+	if dst == nil {
+		dst = &LinkQuality{}
+	}
+
+	jitterMillis := float64(src.Jitter+dst.Jitter) / 1_000_000
+	cost := costConfig.JitterWeight * jitterMillis
+
+	// a message has to survive being sent from both sides of the link
+	delivered := (1 - clampRatio(src.LossRatio)) * (1 - clampRatio(dst.LossRatio))
+	cost += costConfig.LossWeight * (1 - delivered) * 100
+
+	if costConfig.Capacity > 0 {
+		utilization := clampRatio(math.Max(src.TxRate, dst.TxRate) / costConfig.Capacity)
+		cost += costConfig.UtilizationWeight * utilization * 100
+	}
+
+	return int64(math.Round(cost))
+}
+
+func clampRatio(val float64) float64 {
+	if val < 0 || math.IsNaN(val) {
+		return 0
+	}
+	if val > 1 {
+		return 1
+	}
+	return val
 }
 
 func (link *Link) GetCost() int64 {
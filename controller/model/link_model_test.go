@@ -0,0 +1,66 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkQualityCost(t *testing.T) {
+	req := require.New(t)
+
+	latencyOnly := newLink("l0", "tls", "tcp:localhost:1234", 10*time.Millisecond, nil)
+	latencyOnly.SetSrcQuality(&LinkQuality{Jitter: int64(5 * time.Millisecond), LossRatio: 0.5})
+	req.Equal(int64(21), latencyOnly.GetCost())
+
+	costConfig := &config.LinkCostConfig{
+		JitterWeight:      2,
+		LossWeight:        10,
+		UtilizationWeight: 1,
+		Capacity:          1_000_000,
+	}
+
+	link := newLink("l1", "tls", "tcp:localhost:1234", 10*time.Millisecond, costConfig)
+	req.Equal(int64(21), link.GetCost())
+
+	// 5ms of jitter at 2 per ms
+	link.SetSrcQuality(&LinkQuality{Jitter: int64(5 * time.Millisecond)})
+	req.Equal(int64(31), link.GetCost())
+
+	// 10% loss on one side at 10 per percent
+	link.SetDstQuality(&LinkQuality{LossRatio: 0.1})
+	req.Equal(int64(131), link.GetCost())
+
+	// half of capacity in use at 1 per percent
+	link.SetDstQuality(&LinkQuality{TxRate: 500_000})
+	req.Equal(int64(81), link.GetCost())
+
+	// utilization is capped at capacity
+	link.SetDstQuality(&LinkQuality{TxRate: 5_000_000})
+	req.Equal(int64(131), link.GetCost())
+
+	// a fast but lossy link should cost more than a slower clean one
+	lossy := newLink("l2", "tls", "tcp:localhost:1234", 5*time.Millisecond, costConfig)
+	lossy.SetSrcQuality(&LinkQuality{LossRatio: 0.05})
+	clean := newLink("l3", "tls", "tcp:localhost:1234", 20*time.Millisecond, costConfig)
+	clean.SetSrcQuality(&LinkQuality{})
+	req.Greater(lossy.GetCost(), clean.GetCost())
+}
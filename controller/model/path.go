@@ -29,6 +29,39 @@ type Path struct {
 	InitiatorRemoteAddr  string
 	TerminatorLocalAddr  string
 	TerminatorRemoteAddr string
+
+	// Multipath is the multipath mode of the service the path was created for. If it's set and a link-disjoint path
+	// between the same routers exists, Alternate holds that path. It shares the ingress and egress ids of this path.
+	Multipath string
+	Alternate *Path
+
+	// Priority is the priority class of the service the path was created for
+	Priority string
+}
+
+// RouteNodes returns every router which needs a route for the path, including routers which are only on the alternate
+// path. Each router is returned once and the egress router is always last.
+func (self *Path) RouteNodes() []*Router {
+	if self.Alternate == nil || len(self.Nodes) < 2 {
+		return self.Nodes
+	}
+
+	seen := map[string]struct{}{}
+	var result []*Router
+	for _, r := range self.Nodes[:len(self.Nodes)-1] {
+		seen[r.Id] = struct{}{}
+		result = append(result, r)
+	}
+
+	egress := self.EgressRouter()
+	for _, r := range self.Alternate.Nodes {
+		if _, found := seen[r.Id]; !found && r != egress {
+			seen[r.Id] = struct{}{}
+			result = append(result, r)
+		}
+	}
+
+	return append(result, egress)
 }
 
 func (self *Path) Cost(minRouterCost uint16) int64 {
@@ -54,6 +87,9 @@ func (self *Path) String() string {
 		out += fmt.Sprintf("->[l/%s]", self.Links[i].Id)
 		out += fmt.Sprintf("->[r/%s]", self.Nodes[i+1].Id)
 	}
+	if self.Alternate != nil {
+		out += fmt.Sprintf(" %s alternate %s", self.Multipath, self.Alternate.String())
+	}
 	return out
 }
 
@@ -74,7 +110,10 @@ func (self *Path) EqualPath(other *Path) bool {
 			return false
 		}
 	}
-	return true
+	if self.Alternate == nil || other.Alternate == nil {
+		return self.Alternate == other.Alternate
+	}
+	return self.Alternate.EqualPath(other.Alternate)
 }
 
 func (self *Path) EgressRouter() *Router {
@@ -92,5 +131,5 @@ func (self *Path) UsesLink(l *Link) bool {
 			}
 		}
 	}
-	return false
+	return self.Alternate != nil && self.Alternate.UsesLink(l)
 }
@@ -0,0 +1,649 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+// PolicySimulation describes proposed policy and role attribute changes. Policies are matched to existing policies by
+// id or, if no id is given, by name. Matched policies are replaced in full, unmatched policies are treated as new.
+// Entity and policy references, including @ roles, may use either ids or names. Role attribute changes are keyed by
+// entity id or name and replace the entity's role attributes
+type PolicySimulation struct {
+	ServicePolicies                  []*ServicePolicy
+	EdgeRouterPolicies               []*EdgeRouterPolicy
+	ServiceEdgeRouterPolicies        []*ServiceEdgeRouterPolicy
+	DeletedServicePolicies           []string
+	DeletedEdgeRouterPolicies        []string
+	DeletedServiceEdgeRouterPolicies []string
+	IdentityRoleAttributes           map[string][]string
+	ServiceRoleAttributes            map[string][]string
+	EdgeRouterRoleAttributes         map[string][]string
+}
+
+type AdvisorReachabilityChange struct {
+	IdentityId   string
+	IdentityName string
+	ServiceId    string
+	ServiceName  string
+	PolicyType   string
+}
+
+type AdvisorPolicySimulationResult struct {
+	Gained   []*AdvisorReachabilityChange
+	Lost     []*AdvisorReachabilityChange
+	Warnings []string
+}
+
+// SimulatePolicyChanges reports which identities would gain or lose the ability to dial or bind services if the given
+// changes were made. An identity can reach a service when a service policy grants it access and the identity and
+// service share at least one edge router, the same rules AnalyzeServiceReachability applies. Posture checks and
+// service policy schedules are evaluated when sessions are created, so they aren't considered. Nothing is written to
+// the database
+func (advisor *PolicyAdvisor) SimulatePolicyChanges(simulation *PolicySimulation) (*AdvisorPolicySimulationResult, error) {
+	var current *simulatedNetwork
+	err := advisor.env.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		current, err = advisor.loadSimulatedNetwork(tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proposed := current.clone()
+	if err = proposed.apply(simulation); err != nil {
+		return nil, err
+	}
+
+	result := &AdvisorPolicySimulationResult{}
+	result.Warnings = append(result.Warnings, proposed.servicePolicies.unmatchedRoleWarnings(current.servicePolicies)...)
+	result.Warnings = append(result.Warnings, proposed.edgeRouterPolicies.unmatchedRoleWarnings(current.edgeRouterPolicies)...)
+	result.Warnings = append(result.Warnings, proposed.serviceEdgeRouterPolicies.unmatchedRoleWarnings(current.serviceEdgeRouterPolicies)...)
+
+	before, err := current.evaluate()
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := proposed.evaluate()
+	if err != nil {
+		return nil, err
+	}
+
+	result.Gained = proposed.diff(after, before)
+	result.Lost = current.diff(before, after)
+	return result, nil
+}
+
+type simulatedEntity struct {
+	id             string
+	name           string
+	roleAttributes []string
+}
+
+type simulatedEntities struct {
+	typeName string
+	byId     map[string]*simulatedEntity
+}
+
+func newSimulatedEntities(typeName string) *simulatedEntities {
+	return &simulatedEntities{
+		typeName: typeName,
+		byId:     map[string]*simulatedEntity{},
+	}
+}
+
+func (self *simulatedEntities) add(entity *simulatedEntity) {
+	self.byId[entity.id] = entity
+}
+
+// find returns the entity with the given id or, failing that, the given name
+func (self *simulatedEntities) find(idOrName string) (*simulatedEntity, error) {
+	if entity, found := self.byId[idOrName]; found {
+		return entity, nil
+	}
+
+	var result *simulatedEntity
+	for _, entity := range self.byId {
+		if entity.name == idOrName {
+			if result != nil {
+				return nil, errors.Errorf("multiple %v named %v, use the id instead", self.typeName, idOrName)
+			}
+			result = entity
+		}
+	}
+
+	if result == nil {
+		return nil, errors.Errorf("no %v found with id or name %v", self.typeName, idOrName)
+	}
+	return result, nil
+}
+
+func (self *simulatedEntities) clone() *simulatedEntities {
+	result := newSimulatedEntities(self.typeName)
+	for id, entity := range self.byId {
+		copied := *entity
+		result.byId[id] = &copied
+	}
+	return result
+}
+
+// hasRoleAttribute returns true if any entity has the given role attribute
+func (self *simulatedEntities) hasRoleAttribute(attr string) bool {
+	for _, entity := range self.byId {
+		for _, entityAttr := range entity.roleAttributes {
+			if entityAttr == attr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// simulatedPolicy is a policy relating source entities to target entities. Service policies relate identities to
+// services, edge router policies relate identities to edge routers and service edge router policies relate services
+// to edge routers
+type simulatedPolicy struct {
+	id          string
+	name        string
+	policyType  string
+	semantic    string
+	sourceRoles []string
+	targetRoles []string
+	proposed    bool
+}
+
+type simulatedPolicies struct {
+	typeName string
+	sources  *simulatedEntities
+	targets  *simulatedEntities
+	byId     map[string]*simulatedPolicy
+}
+
+func (self *simulatedPolicies) find(id, name string) *simulatedPolicy {
+	if id != "" {
+		return self.byId[id]
+	}
+	for _, policy := range self.byId {
+		if policy.name == name {
+			return policy
+		}
+	}
+	return nil
+}
+
+func (self *simulatedPolicies) findByIdOrName(idOrName string) (*simulatedPolicy, error) {
+	if policy := self.find(idOrName, ""); policy != nil {
+		return policy, nil
+	}
+	if policy := self.find("", idOrName); policy != nil {
+		return policy, nil
+	}
+	return nil, errors.Errorf("no %v found with id or name %v", self.typeName, idOrName)
+}
+
+// put adds or replaces a policy, resolving @ references to ids
+func (self *simulatedPolicies) put(policy *simulatedPolicy) error {
+	if existing := self.find(policy.id, policy.name); existing != nil {
+		policy.id = existing.id
+		if policy.name == "" {
+			policy.name = existing.name
+		}
+	} else if policy.id == "" {
+		policy.id = "simulated:" + policy.name
+	}
+	policy.proposed = true
+
+	var err error
+	if policy.sourceRoles, err = self.resolveRoles(policy, policy.sourceRoles, self.sources); err != nil {
+		return err
+	}
+	if policy.targetRoles, err = self.resolveRoles(policy, policy.targetRoles, self.targets); err != nil {
+		return err
+	}
+	if _, err = db.NewRoleMatcher(policy.semantic, policy.sourceRoles); err != nil {
+		return errors.Wrapf(err, "invalid %v %v", self.typeName, policy.name)
+	}
+	if _, err = db.NewRoleMatcher(policy.semantic, policy.targetRoles); err != nil {
+		return errors.Wrapf(err, "invalid %v %v", self.typeName, policy.name)
+	}
+
+	self.byId[policy.id] = policy
+	return nil
+}
+
+func (self *simulatedPolicies) resolveRoles(policy *simulatedPolicy, roles []string, entities *simulatedEntities) ([]string, error) {
+	var resolved []string
+	for _, role := range roles {
+		if strings.HasPrefix(role, db.EntityPrefix) {
+			entity, err := entities.find(strings.TrimPrefix(role, db.EntityPrefix))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid %v %v", self.typeName, policy.name)
+			}
+			role = db.EntityPrefix + entity.id
+		}
+		resolved = append(resolved, role)
+	}
+	return resolved, nil
+}
+
+// unmatchedRoleWarnings reports role attributes used by policies which no entity has. This is checked for proposed
+// policies, and for other policies whose roles only stop matching because of proposed role attribute changes
+func (self *simulatedPolicies) unmatchedRoleWarnings(current *simulatedPolicies) []string {
+	var result []string
+	check := func(policy *simulatedPolicy, roles []string, entities, currentEntities *simulatedEntities) {
+		for _, role := range roles {
+			if !strings.HasPrefix(role, db.RolePrefix) {
+				continue
+			}
+			attr := strings.TrimPrefix(role, db.RolePrefix)
+			if attr == "all" || entities.hasRoleAttribute(attr) {
+				continue
+			}
+			if policy.proposed || currentEntities.hasRoleAttribute(attr) {
+				result = append(result, fmt.Sprintf("%v %v: role %v matches no %v", self.typeName, policy.name, role, entities.typeName))
+			}
+		}
+	}
+
+	for _, policy := range self.byId {
+		check(policy, policy.sourceRoles, self.sources, current.sources)
+		check(policy, policy.targetRoles, self.targets, current.targets)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+func (self *simulatedPolicies) clone(sources, targets *simulatedEntities) *simulatedPolicies {
+	result := &simulatedPolicies{
+		typeName: self.typeName,
+		sources:  sources,
+		targets:  targets,
+		byId:     map[string]*simulatedPolicy{},
+	}
+	for id, policy := range self.byId {
+		copied := *policy
+		result.byId[id] = &copied
+	}
+	return result
+}
+
+// links returns the target ids related to each source id by the policies
+func (self *simulatedPolicies) links(filter func(policy *simulatedPolicy) bool) (map[string]map[string]struct{}, error) {
+	result := map[string]map[string]struct{}{}
+	for _, policy := range self.byId {
+		if filter != nil && !filter(policy) {
+			continue
+		}
+
+		sourceMatcher, err := db.NewRoleMatcher(policy.semantic, policy.sourceRoles)
+		if err != nil {
+			return nil, err
+		}
+		targetMatcher, err := db.NewRoleMatcher(policy.semantic, policy.targetRoles)
+		if err != nil {
+			return nil, err
+		}
+
+		var targetIds []string
+		for _, target := range self.targets.byId {
+			if targetMatcher.IsMatch(target.id, target.roleAttributes) {
+				targetIds = append(targetIds, target.id)
+			}
+		}
+
+		if len(targetIds) == 0 {
+			continue
+		}
+
+		for _, source := range self.sources.byId {
+			if !sourceMatcher.IsMatch(source.id, source.roleAttributes) {
+				continue
+			}
+			related := result[source.id]
+			if related == nil {
+				related = map[string]struct{}{}
+				result[source.id] = related
+			}
+			for _, targetId := range targetIds {
+				related[targetId] = struct{}{}
+			}
+		}
+	}
+	return result, nil
+}
+
+type simulatedNetwork struct {
+	identities                *simulatedEntities
+	services                  *simulatedEntities
+	edgeRouters               *simulatedEntities
+	servicePolicies           *simulatedPolicies
+	edgeRouterPolicies        *simulatedPolicies
+	serviceEdgeRouterPolicies *simulatedPolicies
+}
+
+func newSimulatedNetwork(identities, services, edgeRouters *simulatedEntities) *simulatedNetwork {
+	return &simulatedNetwork{
+		identities:  identities,
+		services:    services,
+		edgeRouters: edgeRouters,
+		servicePolicies: &simulatedPolicies{
+			typeName: "service policy",
+			sources:  identities,
+			targets:  services,
+			byId:     map[string]*simulatedPolicy{},
+		},
+		edgeRouterPolicies: &simulatedPolicies{
+			typeName: "edge router policy",
+			sources:  identities,
+			targets:  edgeRouters,
+			byId:     map[string]*simulatedPolicy{},
+		},
+		serviceEdgeRouterPolicies: &simulatedPolicies{
+			typeName: "service edge router policy",
+			sources:  services,
+			targets:  edgeRouters,
+			byId:     map[string]*simulatedPolicy{},
+		},
+	}
+}
+
+func (advisor *PolicyAdvisor) loadSimulatedNetwork(tx *bbolt.Tx) (*simulatedNetwork, error) {
+	stores := advisor.env.GetStores()
+
+	identities := newSimulatedEntities("identities")
+	for cursor := stores.Identity.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		entity, err := stores.Identity.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return nil, err
+		}
+		identities.add(&simulatedEntity{id: entity.Id, name: entity.Name, roleAttributes: entity.RoleAttributes})
+	}
+
+	services := newSimulatedEntities("services")
+	for cursor := stores.EdgeService.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		entity, err := stores.EdgeService.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return nil, err
+		}
+		services.add(&simulatedEntity{id: entity.Id, name: entity.Name, roleAttributes: entity.RoleAttributes})
+	}
+
+	edgeRouters := newSimulatedEntities("edge routers")
+	for cursor := stores.EdgeRouter.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		entity, err := stores.EdgeRouter.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return nil, err
+		}
+		edgeRouters.add(&simulatedEntity{id: entity.Id, name: entity.Name, roleAttributes: entity.RoleAttributes})
+	}
+
+	network := newSimulatedNetwork(identities, services, edgeRouters)
+
+	for cursor := stores.ServicePolicy.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		policy, err := stores.ServicePolicy.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return nil, err
+		}
+		network.servicePolicies.byId[policy.Id] = &simulatedPolicy{
+			id:          policy.Id,
+			name:        policy.Name,
+			policyType:  policy.PolicyType.String(),
+			semantic:    policy.Semantic,
+			sourceRoles: policy.IdentityRoles,
+			targetRoles: policy.ServiceRoles,
+		}
+	}
+
+	for cursor := stores.EdgeRouterPolicy.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		policy, err := stores.EdgeRouterPolicy.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return nil, err
+		}
+		network.edgeRouterPolicies.byId[policy.Id] = &simulatedPolicy{
+			id:          policy.Id,
+			name:        policy.Name,
+			semantic:    policy.Semantic,
+			sourceRoles: policy.IdentityRoles,
+			targetRoles: policy.EdgeRouterRoles,
+		}
+	}
+
+	for cursor := stores.ServiceEdgeRouterPolicy.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		policy, err := stores.ServiceEdgeRouterPolicy.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return nil, err
+		}
+		network.serviceEdgeRouterPolicies.byId[policy.Id] = &simulatedPolicy{
+			id:          policy.Id,
+			name:        policy.Name,
+			semantic:    policy.Semantic,
+			sourceRoles: policy.ServiceRoles,
+			targetRoles: policy.EdgeRouterRoles,
+		}
+	}
+
+	return network, nil
+}
+
+func (self *simulatedNetwork) clone() *simulatedNetwork {
+	identities := self.identities.clone()
+	services := self.services.clone()
+	edgeRouters := self.edgeRouters.clone()
+
+	return &simulatedNetwork{
+		identities:                identities,
+		services:                  services,
+		edgeRouters:               edgeRouters,
+		servicePolicies:           self.servicePolicies.clone(identities, services),
+		edgeRouterPolicies:        self.edgeRouterPolicies.clone(identities, edgeRouters),
+		serviceEdgeRouterPolicies: self.serviceEdgeRouterPolicies.clone(services, edgeRouters),
+	}
+}
+
+// apply makes the simulated changes. Role attributes are updated first, so new and updated policies are checked
+// against the proposed attributes
+func (self *simulatedNetwork) apply(simulation *PolicySimulation) error {
+	attributeChanges := []struct {
+		entities *simulatedEntities
+		changes  map[string][]string
+	}{
+		{self.identities, simulation.IdentityRoleAttributes},
+		{self.services, simulation.ServiceRoleAttributes},
+		{self.edgeRouters, simulation.EdgeRouterRoleAttributes},
+	}
+
+	for _, attributeChange := range attributeChanges {
+		for idOrName, roleAttributes := range attributeChange.changes {
+			entity, err := attributeChange.entities.find(idOrName)
+			if err != nil {
+				return err
+			}
+			entity.roleAttributes = roleAttributes
+		}
+	}
+
+	deletes := []struct {
+		policies *simulatedPolicies
+		ids      []string
+	}{
+		{self.servicePolicies, simulation.DeletedServicePolicies},
+		{self.edgeRouterPolicies, simulation.DeletedEdgeRouterPolicies},
+		{self.serviceEdgeRouterPolicies, simulation.DeletedServiceEdgeRouterPolicies},
+	}
+
+	for _, policyDeletes := range deletes {
+		for _, idOrName := range policyDeletes.ids {
+			policy, err := policyDeletes.policies.findByIdOrName(idOrName)
+			if err != nil {
+				return err
+			}
+			delete(policyDeletes.policies.byId, policy.id)
+		}
+	}
+
+	for _, policy := range simulation.ServicePolicies {
+		var policyType string
+		if strings.EqualFold(policy.PolicyType, db.PolicyTypeDialName) {
+			policyType = db.PolicyTypeDialName
+		} else if strings.EqualFold(policy.PolicyType, db.PolicyTypeBindName) {
+			policyType = db.PolicyTypeBindName
+		} else {
+			return errors.Errorf("invalid policy type '%v' for service policy %v", policy.PolicyType, policy.Name)
+		}
+
+		err := self.servicePolicies.put(&simulatedPolicy{
+			id:          policy.Id,
+			name:        policy.Name,
+			policyType:  policyType,
+			semantic:    policy.Semantic,
+			sourceRoles: policy.IdentityRoles,
+			targetRoles: policy.ServiceRoles,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, policy := range simulation.EdgeRouterPolicies {
+		err := self.edgeRouterPolicies.put(&simulatedPolicy{
+			id:          policy.Id,
+			name:        policy.Name,
+			semantic:    policy.Semantic,
+			sourceRoles: policy.IdentityRoles,
+			targetRoles: policy.EdgeRouterRoles,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, policy := range simulation.ServiceEdgeRouterPolicies {
+		err := self.serviceEdgeRouterPolicies.put(&simulatedPolicy{
+			id:          policy.Id,
+			name:        policy.Name,
+			semantic:    policy.Semantic,
+			sourceRoles: policy.ServiceRoles,
+			targetRoles: policy.EdgeRouterRoles,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// simulatedReachability maps identity id to service id to the policy types the identity can use with the service
+type simulatedReachability map[string]map[string][]string
+
+func (self *simulatedNetwork) evaluate() (simulatedReachability, error) {
+	result := simulatedReachability{}
+
+	identityEdgeRouters, err := self.edgeRouterPolicies.links(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceEdgeRouters, err := self.serviceEdgeRouterPolicies.links(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policyType := range []string{db.PolicyTypeDialName, db.PolicyTypeBindName} {
+		permitted, err := self.servicePolicies.links(func(policy *simulatedPolicy) bool {
+			return policy.policyType == policyType
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for identityId, serviceIds := range permitted {
+			for serviceId := range serviceIds {
+				if !hasCommonEdgeRouter(identityEdgeRouters[identityId], serviceEdgeRouters[serviceId]) {
+					continue
+				}
+				services := result[identityId]
+				if services == nil {
+					services = map[string][]string{}
+					result[identityId] = services
+				}
+				services[serviceId] = append(services[serviceId], policyType)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func hasCommonEdgeRouter(a, b map[string]struct{}) bool {
+	if len(b) < len(a) {
+		a, b = b, a
+	}
+	for id := range a {
+		if _, found := b[id]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// diff returns the identity/service/policy type combinations in reachability which aren't in other, described using
+// this network's entity names
+func (self *simulatedNetwork) diff(reachability, other simulatedReachability) []*AdvisorReachabilityChange {
+	var result []*AdvisorReachabilityChange
+	for identityId, services := range reachability {
+		for serviceId, policyTypes := range services {
+			for _, policyType := range policyTypes {
+				if stringz.Contains(other[identityId][serviceId], policyType) {
+					continue
+				}
+				result = append(result, &AdvisorReachabilityChange{
+					IdentityId:   identityId,
+					IdentityName: self.identities.byId[identityId].name,
+					ServiceId:    serviceId,
+					ServiceName:  self.services.byId[serviceId].name,
+					PolicyType:   policyType,
+				})
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.IdentityName != b.IdentityName {
+			return a.IdentityName < b.IdentityName
+		}
+		if a.ServiceName != b.ServiceName {
+			return a.ServiceName < b.ServiceName
+		}
+		return a.PolicyType < b.PolicyType
+	})
+
+	return result
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+)
+
+func TestPolicyAdvisor(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test simulate policy changes", ctx.testSimulatePolicyChanges)
+}
+
+func (ctx *TestContext) testSimulatePolicyChanges(*testing.T) {
+	identityRole := eid.New()
+	serviceRole := eid.New()
+
+	identity := ctx.requireNewIdentity(false)
+	identity.RoleAttributes = []string{identityRole}
+	ctx.NoError(ctx.managers.Identity.Update(identity, nil, change.New()))
+
+	identity2 := ctx.requireNewIdentity(false)
+	identity2.RoleAttributes = []string{identityRole}
+	ctx.NoError(ctx.managers.Identity.Update(identity2, nil, change.New()))
+
+	service := ctx.requireNewService()
+	service.RoleAttributes = []string{serviceRole}
+	ctx.NoError(ctx.managers.EdgeService.Update(service, nil, change.New()))
+
+	ctx.requireNewEdgeRouter()
+	ctx.requireNewEdgeRouterPolicy(ss("#all"), ss("#all"))
+	serp := ctx.requireNewServiceNewEdgeRouterPolicy(ss("#all"), ss("#all"))
+	policy := ctx.requireNewServicePolicy(db.PolicyTypeDialName, ss("#"+identityRole), ss("#"+serviceRole))
+
+	// a typo in a policy role cuts off every identity using it
+	result, err := ctx.managers.PolicyAdvisor.SimulatePolicyChanges(&PolicySimulation{
+		ServicePolicies: []*ServicePolicy{{
+			Name:          policy.Name,
+			PolicyType:    db.PolicyTypeDialName,
+			IdentityRoles: ss("#" + identityRole + "x"),
+			ServiceRoles:  ss("#" + serviceRole),
+		}},
+	})
+	ctx.NoError(err)
+	ctx.Empty(result.Gained)
+	ctx.Len(result.Lost, 2)
+	for _, lost := range result.Lost {
+		ctx.Equal(service.Id, lost.ServiceId)
+		ctx.Equal(db.PolicyTypeDialName, lost.PolicyType)
+	}
+	ctx.Equal([]string{"service policy " + policy.Name + ": role #" + identityRole + "x matches no identities"}, result.Warnings)
+
+	// nothing is written
+	policy, err = ctx.managers.ServicePolicy.Read(policy.Id)
+	ctx.NoError(err)
+	ctx.Equal(ss("#"+identityRole), policy.IdentityRoles)
+
+	// new policies may reference entities by name
+	result, err = ctx.managers.PolicyAdvisor.SimulatePolicyChanges(&PolicySimulation{
+		ServicePolicies: []*ServicePolicy{{
+			Name:          eid.New(),
+			PolicyType:    db.PolicyTypeBindName,
+			IdentityRoles: ss("@" + identity.Name),
+			ServiceRoles:  ss("@" + service.Name),
+		}},
+	})
+	ctx.NoError(err)
+	ctx.Empty(result.Lost)
+	ctx.Empty(result.Warnings)
+	ctx.Len(result.Gained, 1)
+	ctx.Equal(identity.Id, result.Gained[0].IdentityId)
+	ctx.Equal(identity.Name, result.Gained[0].IdentityName)
+	ctx.Equal(db.PolicyTypeBindName, result.Gained[0].PolicyType)
+
+	// removing a role attribute only affects that identity
+	result, err = ctx.managers.PolicyAdvisor.SimulatePolicyChanges(&PolicySimulation{
+		IdentityRoleAttributes: map[string][]string{identity2.Name: nil},
+	})
+	ctx.NoError(err)
+	ctx.Empty(result.Gained)
+	ctx.Empty(result.Warnings)
+	ctx.Len(result.Lost, 1)
+	ctx.Equal(identity2.Id, result.Lost[0].IdentityId)
+
+	// removing the last role attribute holder is reported for existing policies
+	result, err = ctx.managers.PolicyAdvisor.SimulatePolicyChanges(&PolicySimulation{
+		ServiceRoleAttributes: map[string][]string{service.Id: ss(eid.New())},
+	})
+	ctx.NoError(err)
+	ctx.Len(result.Lost, 2)
+	ctx.Equal([]string{"service policy " + policy.Name + ": role #" + serviceRole + " matches no services"}, result.Warnings)
+
+	// without a shared edge router nothing is reachable
+	result, err = ctx.managers.PolicyAdvisor.SimulatePolicyChanges(&PolicySimulation{
+		DeletedServiceEdgeRouterPolicies: ss(serp.Name),
+	})
+	ctx.NoError(err)
+	ctx.Len(result.Lost, 2)
+
+	_, err = ctx.managers.PolicyAdvisor.SimulatePolicyChanges(&PolicySimulation{
+		DeletedEdgeRouterPolicies: ss(eid.New()),
+	})
+	ctx.Error(err)
+
+	_, err = ctx.managers.PolicyAdvisor.SimulatePolicyChanges(&PolicySimulation{
+		ServicePolicies: []*ServicePolicy{{Name: eid.New(), PolicyType: db.PolicyTypeDialName, IdentityRoles: ss("@" + eid.New())}},
+	})
+	ctx.Error(err)
+}
@@ -133,6 +133,10 @@ func (self *PostureCheckManager) IsUpdated(field string) bool {
 		strings.EqualFold(field, db.FieldPostureCheckProcessMultiPath) ||
 		strings.EqualFold(field, db.FieldPostureCheckProcessMultiSignerFingerprints) ||
 		strings.EqualFold(field, db.FieldPostureCheckProcessMultiProcesses) ||
+		strings.EqualFold(field, db.FieldPostureCheckSourceIpAllowedCidrs) ||
+		strings.EqualFold(field, db.FieldPostureCheckSourceIpDeniedCidrs) ||
+		strings.EqualFold(field, db.FieldPostureCheckSourceIpAllowedCountries) ||
+		strings.EqualFold(field, db.FieldPostureCheckSourceIpDeniedCountries) ||
 		strings.EqualFold(field, db.FieldSemantic)
 }
 
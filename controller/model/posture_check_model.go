@@ -65,6 +65,7 @@ const (
 	PostureCheckTypeProcessMulti = "PROCESS_MULTI"
 	PostureCheckTypeMAC          = "MAC"
 	PostureCheckTypeMFA          = "MFA"
+	PostureCheckTypeSourceIp     = "SOURCE_IP"
 )
 
 var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
@@ -74,6 +75,7 @@ var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
 	PostureCheckTypeProcessMulti: newPostureCheckProcessMulti,
 	PostureCheckTypeMAC:          newPostureCheckMacAddresses,
 	PostureCheckTypeMFA:          newPostureCheckMfa,
+	PostureCheckTypeSourceIp:     newPostureCheckSourceIp,
 }
 
 func newSubType(typeId string) PostureCheckSubType {
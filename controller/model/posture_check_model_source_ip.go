@@ -0,0 +1,162 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/openziti/ziti/common/geoip"
+	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+var _ PostureCheckSubType = &PostureCheckSourceIp{}
+
+type PostureCheckSourceIp struct {
+	AllowedCidrs     []string
+	DeniedCidrs      []string
+	AllowedCountries []string
+	DeniedCountries  []string
+}
+
+func newPostureCheckSourceIp() PostureCheckSubType {
+	return &PostureCheckSourceIp{}
+}
+
+func (p *PostureCheckSourceIp) TypeId() string {
+	return db.PostureCheckTypeSourceIp
+}
+
+func (p *PostureCheckSourceIp) getRules() *geoip.SourceIpRules {
+	return &geoip.SourceIpRules{
+		AllowedCidrs:     p.AllowedCidrs,
+		DeniedCidrs:      p.DeniedCidrs,
+		AllowedCountries: p.AllowedCountries,
+		DeniedCountries:  p.DeniedCountries,
+	}
+}
+
+func (p *PostureCheckSourceIp) fillProtobuf(msg *edge_cmd_pb.PostureCheck) {
+	msg.Subtype = &edge_cmd_pb.PostureCheck_SourceIp_{
+		SourceIp: &edge_cmd_pb.PostureCheck_SourceIp{
+			AllowedCidrs:     p.AllowedCidrs,
+			DeniedCidrs:      p.DeniedCidrs,
+			AllowedCountries: p.AllowedCountries,
+			DeniedCountries:  p.DeniedCountries,
+		},
+	}
+}
+
+func (p *PostureCheckSourceIp) fillFromProtobuf(msg *edge_cmd_pb.PostureCheck) error {
+	if sourceIp_, ok := msg.Subtype.(*edge_cmd_pb.PostureCheck_SourceIp_); ok {
+		if sourceIp := sourceIp_.SourceIp; sourceIp != nil {
+			p.AllowedCidrs = sourceIp.AllowedCidrs
+			p.DeniedCidrs = sourceIp.DeniedCidrs
+			p.AllowedCountries = sourceIp.AllowedCountries
+			p.DeniedCountries = sourceIp.DeniedCountries
+		}
+	} else {
+		return errors.Errorf("expected posture check sub type data of source ip, but got %T", msg.Subtype)
+	}
+	return nil
+}
+
+func (p *PostureCheckSourceIp) LastUpdatedAt(string, *PostureData) *time.Time {
+	return nil
+}
+
+func (p *PostureCheckSourceIp) GetTimeoutSeconds() int64 {
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckSourceIp) GetTimeoutRemainingSeconds(_ string, _ *PostureData) int64 {
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckSourceIp) getSourceIp(apiSessionId string, pd *PostureData) string {
+	if pd == nil {
+		return ""
+	}
+	if apiSessionData := pd.ApiSessions[apiSessionId]; apiSessionData != nil {
+		return apiSessionData.SourceIp
+	}
+	return ""
+}
+
+func (p *PostureCheckSourceIp) FailureValues(apiSessionId string, pd *PostureData) PostureCheckFailureValues {
+	return &PostureCheckFailureValuesSourceIp{
+		ActualValue: p.getSourceIp(apiSessionId, pd),
+		ExpectedValue: PostureCheckSourceIpExpected{
+			AllowedCidrs:     p.AllowedCidrs,
+			DeniedCidrs:      p.DeniedCidrs,
+			AllowedCountries: p.AllowedCountries,
+			DeniedCountries:  p.DeniedCountries,
+		},
+	}
+}
+
+func (p *PostureCheckSourceIp) Evaluate(apiSessionId string, pd *PostureData) bool {
+	ip := net.ParseIP(p.getSourceIp(apiSessionId, pd))
+	return p.getRules().Evaluate(ip) == nil
+}
+
+func (p *PostureCheckSourceIp) fillFrom(_ Env, _ *bbolt.Tx, _ *db.PostureCheck, subType db.PostureCheckSubType) error {
+	subCheck := subType.(*db.PostureCheckSourceIp)
+
+	if subCheck == nil {
+		return fmt.Errorf("could not convert source ip check to bolt type")
+	}
+
+	p.AllowedCidrs = subCheck.AllowedCidrs
+	p.DeniedCidrs = subCheck.DeniedCidrs
+	p.AllowedCountries = subCheck.AllowedCountries
+	p.DeniedCountries = subCheck.DeniedCountries
+	return nil
+}
+
+func (p *PostureCheckSourceIp) toBoltEntityForCreate(*bbolt.Tx, Env) (db.PostureCheckSubType, error) {
+	return &db.PostureCheckSourceIp{
+		AllowedCidrs:     p.AllowedCidrs,
+		DeniedCidrs:      p.DeniedCidrs,
+		AllowedCountries: p.AllowedCountries,
+		DeniedCountries:  p.DeniedCountries,
+	}, nil
+}
+
+type PostureCheckSourceIpExpected struct {
+	AllowedCidrs     []string `json:"allowedCidrs,omitempty"`
+	DeniedCidrs      []string `json:"deniedCidrs,omitempty"`
+	AllowedCountries []string `json:"allowedCountries,omitempty"`
+	DeniedCountries  []string `json:"deniedCountries,omitempty"`
+}
+
+type PostureCheckFailureValuesSourceIp struct {
+	ActualValue   string
+	ExpectedValue PostureCheckSourceIpExpected
+}
+
+func (p PostureCheckFailureValuesSourceIp) Expected() interface{} {
+	return p.ExpectedValue
+}
+
+func (p PostureCheckFailureValuesSourceIp) Actual() interface{} {
+	return p.ActualValue
+}
@@ -0,0 +1,65 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostureCheckModelSourceIp_Evaluate(t *testing.T) {
+	sourceIpCheck := &PostureCheckSourceIp{
+		AllowedCidrs: []string{"192.0.2.0/24"},
+		DeniedCidrs:  []string{"192.0.2.128/25"},
+	}
+
+	newPostureData := func(sourceIp string) *PostureData {
+		return &PostureData{
+			ApiSessions: map[string]*ApiSessionPostureData{
+				"apiSession1": {SourceIp: sourceIp},
+			},
+		}
+	}
+
+	t.Run("returns true for an address in an allowed range", func(t *testing.T) {
+		req := require.New(t)
+		req.True(sourceIpCheck.Evaluate("apiSession1", newPostureData("192.0.2.10")))
+	})
+
+	t.Run("returns false for an address in a denied range", func(t *testing.T) {
+		req := require.New(t)
+		req.False(sourceIpCheck.Evaluate("apiSession1", newPostureData("192.0.2.200")))
+	})
+
+	t.Run("returns false for an address outside the allowed ranges", func(t *testing.T) {
+		req := require.New(t)
+		req.False(sourceIpCheck.Evaluate("apiSession1", newPostureData("198.51.100.1")))
+	})
+
+	t.Run("returns false if the source address is unknown", func(t *testing.T) {
+		req := require.New(t)
+		req.False(sourceIpCheck.Evaluate("apiSession1", newPostureData("")))
+		req.False(sourceIpCheck.Evaluate("apiSession2", newPostureData("192.0.2.10")))
+	})
+
+	t.Run("failure values report the observed address", func(t *testing.T) {
+		req := require.New(t)
+		failureValues := sourceIpCheck.FailureValues("apiSession1", newPostureData("198.51.100.1"))
+		req.Equal("198.51.100.1", failureValues.Actual())
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/db"
 	"go.etcd.io/bbolt"
+	"net"
 	"runtime/debug"
 	"time"
 )
@@ -173,7 +174,10 @@ func (self *PostureResponseManager) postureDataUpdated(env Env, identityId strin
 							if !isEvaluatedPolicy { //not checked yet
 								validPolicies[policyId] = false
 								isValidPolicy = false
-								if ok, _ := self.postureCache.Evaluate(identityId, apiSessionId, checks.PostureChecks); ok {
+								// policies outside their schedule don't grant access, regardless of posture
+								if !checks.IsActiveAt(time.Now()) {
+									validPolicies[policyId] = false
+								} else if ok, _ := self.postureCache.Evaluate(identityId, apiSessionId, checks.PostureChecks); ok {
 									isValidService = true
 									isValidPolicy = true
 								}
@@ -254,6 +258,36 @@ func (self *PostureResponseManager) SetSdkInfo(identityId, apiSessionId string,
 	})
 }
 
+// SetSourceIp records the address the client was observed connecting from, which is used by SOURCE_IP posture checks.
+// The address may include a port, which is discarded.
+func (self *PostureResponseManager) SetSourceIp(identityId, apiSessionId string, addr string) {
+	if identityId == "" || apiSessionId == "" || addr == "" {
+		return
+	}
+
+	sourceIp := addr
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		sourceIp = host
+	}
+
+	self.postureCache.Upsert(identityId, false, func(exist bool, valueInMap *PostureData, newValue *PostureData) *PostureData {
+		var postureData *PostureData
+		if exist {
+			postureData = valueInMap
+		} else {
+			postureData = newValue
+		}
+
+		if _, ok := postureData.ApiSessions[apiSessionId]; !ok {
+			postureData.ApiSessions[apiSessionId] = &ApiSessionPostureData{}
+		}
+
+		postureData.ApiSessions[apiSessionId].SourceIp = sourceIp
+
+		return postureData
+	})
+}
+
 type ServiceWithTimeout struct {
 	Service *EdgeService
 	Timeout int64
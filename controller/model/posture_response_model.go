@@ -349,6 +349,7 @@ type ApiSessionPostureData struct {
 	Mfa           *PostureResponseMfa           `json:"mfa"`
 	EndpointState *PostureResponseEndpointState `json:"endpointState"`
 	SdkInfo       *SdkInfo
+	SourceIp      string
 }
 
 func (self *ApiSessionPostureData) GetPassedMfaAt() *time.Time {
@@ -379,9 +380,10 @@ func (self PostureCheckFailure) ToClientErrorData() interface{} {
 }
 
 type PosturePolicyFailure struct {
-	PolicyId   string
-	PolicyName string
-	Checks     []*PostureCheckFailure
+	PolicyId        string
+	PolicyName      string
+	Checks          []*PostureCheckFailure
+	OutsideSchedule bool
 }
 
 type PostureSessionRequestFailure struct {
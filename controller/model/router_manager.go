@@ -69,6 +69,7 @@ type RouterManager struct {
 	baseEntityManager[*Router, *db.Router]
 	cache     cmap.ConcurrentMap[string, *Router]
 	connected cmap.ConcurrentMap[string, *Router]
+	listener  TopologyChangeListener
 }
 
 func newRouterManager(env Env) *RouterManager {
@@ -114,6 +115,19 @@ func (self *RouterManager) MarkConnected(r *Router) {
 
 	r.Connected.Store(true)
 	self.connected.Set(r.Id, r)
+	self.notifyChanged(r)
+}
+
+// SetTopologyChangeListener sets the listener which is notified when routers connect, disconnect or have their
+// cost or traversal settings changed
+func (self *RouterManager) SetTopologyChangeListener(listener TopologyChangeListener) {
+	self.listener = listener
+}
+
+func (self *RouterManager) notifyChanged(r *Router) {
+	if self.listener != nil {
+		self.listener.RouterChanged(r)
+	}
 }
 
 func (self *RouterManager) MarkDisconnected(r *Router) {
@@ -126,6 +140,7 @@ func (self *RouterManager) MarkDisconnected(r *Router) {
 		return exists
 	})
 	r.routerLinks.Clear()
+	self.notifyChanged(r)
 }
 
 func (self *RouterManager) IsConnected(id string) bool {
@@ -346,6 +361,10 @@ func (self *RouterManager) UpdateCachedRouter(id string) {
 
 		self.cache.RemoveCb(id, updateCb)
 		self.connected.RemoveCb(id, updateCb)
+
+		if connected := self.GetConnected(id); connected != nil {
+			self.notifyChanged(connected)
+		}
 	}
 }
 
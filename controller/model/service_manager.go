@@ -160,6 +160,9 @@ func (self *ServiceManager) Marshall(entity *Service) ([]byte, error) {
 		MaxIdleTime:        int64(entity.MaxIdleTime),
 		TerminatorStrategy: entity.TerminatorStrategy,
 		Tags:               tags,
+		Multipath:          entity.Multipath,
+		Priority:           entity.Priority,
+		Compression:        entity.Compression,
 	}
 
 	return proto.Marshal(msg)
@@ -179,5 +182,8 @@ func (self *ServiceManager) Unmarshall(bytes []byte) (*Service, error) {
 		Name:               msg.Name,
 		MaxIdleTime:        time.Duration(msg.MaxIdleTime),
 		TerminatorStrategy: msg.TerminatorStrategy,
+		Multipath:          msg.Multipath,
+		Priority:           msg.Priority,
+		Compression:        msg.Compression,
 	}, nil
 }
@@ -30,6 +30,9 @@ type Service struct {
 	TerminatorStrategy string
 	Terminators        []*Terminator
 	MaxIdleTime        time.Duration
+	Multipath          string
+	Priority           string
+	Compression        string
 }
 
 func (entity *Service) GetName() string {
@@ -46,6 +49,9 @@ func (entity *Service) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.Service, error
 		Name:               entity.Name,
 		MaxIdleTime:        entity.MaxIdleTime,
 		TerminatorStrategy: entity.TerminatorStrategy,
+		Multipath:          entity.Multipath,
+		Priority:           entity.Priority,
+		Compression:        entity.Compression,
 	}, nil
 }
 
@@ -53,6 +59,9 @@ func (entity *Service) fillFrom(env Env, tx *bbolt.Tx, boltService *db.Service)
 	entity.Name = boltService.Name
 	entity.MaxIdleTime = boltService.MaxIdleTime
 	entity.TerminatorStrategy = boltService.TerminatorStrategy
+	entity.Multipath = boltService.Multipath
+	entity.Priority = boltService.Priority
+	entity.Compression = boltService.Compression
 	entity.FillCommon(boltService)
 
 	terminatorIds := env.GetStores().Service.GetRelatedEntitiesIdList(tx, entity.Id, db.EntityTypeTerminators)
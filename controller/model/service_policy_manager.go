@@ -19,6 +19,7 @@ package model
 import (
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/common/schedule"
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/command"
 	"github.com/openziti/ziti/controller/db"
@@ -26,6 +27,7 @@ import (
 	"github.com/openziti/ziti/controller/models"
 	"go.etcd.io/bbolt"
 	"google.golang.org/protobuf/proto"
+	"time"
 )
 
 func NewServicePolicyManager(env Env) *ServicePolicyManager {
@@ -79,6 +81,16 @@ func (self *ServicePolicyManager) Marshall(entity *ServicePolicy) ([]byte, error
 		ServiceRoles:      entity.ServiceRoles,
 		PostureCheckRoles: entity.PostureCheckRoles,
 		PolicyType:        entity.PolicyType,
+		ValidFrom:         timePtrToPb(entity.ValidFrom),
+		ValidUntil:        timePtrToPb(entity.ValidUntil),
+	}
+
+	for _, window := range entity.Schedules {
+		msg.Schedules = append(msg.Schedules, &edge_cmd_pb.ServicePolicyScheduleWindow{
+			Cron:            window.Cron,
+			DurationSeconds: int64(window.Duration / time.Second),
+			Timezone:        window.Timezone,
+		})
 	}
 
 	return proto.Marshal(msg)
@@ -90,6 +102,15 @@ func (self *ServicePolicyManager) Unmarshall(bytes []byte) (*ServicePolicy, erro
 		return nil, err
 	}
 
+	var schedules []*schedule.Window
+	for _, window := range msg.Schedules {
+		schedules = append(schedules, &schedule.Window{
+			Cron:     window.Cron,
+			Duration: time.Duration(window.DurationSeconds) * time.Second,
+			Timezone: window.Timezone,
+		})
+	}
+
 	return &ServicePolicy{
 		BaseEntity: models.BaseEntity{
 			Id:   msg.Id,
@@ -101,6 +122,9 @@ func (self *ServicePolicyManager) Unmarshall(bytes []byte) (*ServicePolicy, erro
 		ServiceRoles:      msg.ServiceRoles,
 		PostureCheckRoles: msg.PostureCheckRoles,
 		PolicyType:        msg.PolicyType,
+		ValidFrom:         pbTimeToTimePtr(msg.ValidFrom),
+		ValidUntil:        pbTimeToTimePtr(msg.ValidUntil),
+		Schedules:         schedules,
 	}, nil
 }
 
@@ -20,10 +20,12 @@ import (
 	"fmt"
 	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/schedule"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/models"
 	"go.etcd.io/bbolt"
 	"strings"
+	"time"
 )
 
 type ServicePolicy struct {
@@ -34,6 +36,62 @@ type ServicePolicy struct {
 	IdentityRoles     []string
 	ServiceRoles      []string
 	PostureCheckRoles []string
+	ValidFrom         *time.Time
+	ValidUntil        *time.Time
+	Schedules         []*schedule.Window
+}
+
+// GetSchedule returns the time restrictions on the policy, or nil if the policy is always in effect
+func (entity *ServicePolicy) GetSchedule() *schedule.Schedule {
+	result := &schedule.Schedule{
+		ValidFrom:  entity.ValidFrom,
+		ValidUntil: entity.ValidUntil,
+		Windows:    entity.Schedules,
+	}
+	if result.IsEmpty() {
+		return nil
+	}
+	return result
+}
+
+// validateSchedule checks the validity window and schedules. On update, fields which aren't being changed are taken
+// from the stored policy, so a patch can't invert the validity window by changing only one end of it.
+func (entity *ServicePolicy) validateSchedule(tx *bbolt.Tx, env Env, checker boltz.FieldChecker) error {
+	validFromUpdated := checker == nil || checker.IsUpdated(db.FieldServicePolicyValidFrom)
+	validUntilUpdated := checker == nil || checker.IsUpdated(db.FieldServicePolicyValidUntil)
+
+	if validFromUpdated || validUntilUpdated {
+		validFrom, validUntil := entity.ValidFrom, entity.ValidUntil
+		if !validFromUpdated || !validUntilUpdated {
+			current, err := env.GetStores().ServicePolicy.LoadById(tx, entity.Id)
+			if err != nil {
+				return err
+			}
+			if !validFromUpdated {
+				validFrom = current.ValidFrom
+			}
+			if !validUntilUpdated {
+				validUntil = current.ValidUntil
+			}
+		}
+
+		if validFrom != nil && validUntil != nil && !validUntil.After(*validFrom) {
+			return errorz.NewFieldError("validUntil must be after validFrom", db.FieldServicePolicyValidUntil, validUntil.Format(time.RFC3339))
+		}
+	}
+
+	if checker == nil || checker.IsUpdated(db.FieldServicePolicySchedules) {
+		for _, window := range entity.Schedules {
+			if window == nil {
+				return errorz.NewFieldError("schedules may not contain empty entries", db.FieldServicePolicySchedules, nil)
+			}
+			if err := window.Validate(); err != nil {
+				return errorz.NewFieldError(err.Error(), db.FieldServicePolicySchedules, window.String())
+			}
+		}
+	}
+
+	return nil
 }
 
 func (entity *ServicePolicy) validatePolicyType() error {
@@ -44,13 +102,17 @@ func (entity *ServicePolicy) validatePolicyType() error {
 	return nil
 }
 
-func (entity *ServicePolicy) toBoltEntity(checker boltz.FieldChecker) (*db.ServicePolicy, error) {
+func (entity *ServicePolicy) toBoltEntity(tx *bbolt.Tx, env Env, checker boltz.FieldChecker) (*db.ServicePolicy, error) {
 	if checker == nil || checker.IsUpdated(db.FieldServicePolicyType) {
 		if err := entity.validatePolicyType(); err != nil {
 			return nil, err
 		}
 	}
 
+	if err := entity.validateSchedule(tx, env, checker); err != nil {
+		return nil, err
+	}
+
 	policyType := db.PolicyTypeInvalid
 	if strings.EqualFold(entity.PolicyType, db.PolicyTypeDialName) {
 		policyType = db.PolicyTypeDial
@@ -66,15 +128,30 @@ func (entity *ServicePolicy) toBoltEntity(checker boltz.FieldChecker) (*db.Servi
 		IdentityRoles:     entity.IdentityRoles,
 		ServiceRoles:      entity.ServiceRoles,
 		PostureCheckRoles: entity.PostureCheckRoles,
+		ValidFrom:         entity.ValidFrom,
+		ValidUntil:        entity.ValidUntil,
+		Schedules:         toBoltSchedules(entity.Schedules),
 	}, nil
 }
 
-func (entity *ServicePolicy) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.ServicePolicy, error) {
-	return entity.toBoltEntity(nil)
+func toBoltSchedules(windows []*schedule.Window) []*db.ServicePolicySchedule {
+	var result []*db.ServicePolicySchedule
+	for _, window := range windows {
+		result = append(result, &db.ServicePolicySchedule{
+			Cron:     window.Cron,
+			Duration: window.Duration,
+			Timezone: window.Timezone,
+		})
+	}
+	return result
 }
 
-func (entity *ServicePolicy) toBoltEntityForUpdate(_ *bbolt.Tx, _ Env, checker boltz.FieldChecker) (*db.ServicePolicy, error) {
-	return entity.toBoltEntity(checker)
+func (entity *ServicePolicy) toBoltEntityForCreate(tx *bbolt.Tx, env Env) (*db.ServicePolicy, error) {
+	return entity.toBoltEntity(tx, env, nil)
+}
+
+func (entity *ServicePolicy) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker boltz.FieldChecker) (*db.ServicePolicy, error) {
+	return entity.toBoltEntity(tx, env, checker)
 }
 
 func (entity *ServicePolicy) fillFrom(_ Env, _ *bbolt.Tx, boltServicePolicy *db.ServicePolicy) error {
@@ -85,5 +162,15 @@ func (entity *ServicePolicy) fillFrom(_ Env, _ *bbolt.Tx, boltServicePolicy *db.
 	entity.ServiceRoles = boltServicePolicy.ServiceRoles
 	entity.IdentityRoles = boltServicePolicy.IdentityRoles
 	entity.PostureCheckRoles = boltServicePolicy.PostureCheckRoles
+	entity.ValidFrom = boltServicePolicy.ValidFrom
+	entity.ValidUntil = boltServicePolicy.ValidUntil
+	entity.Schedules = nil
+	for _, boltSchedule := range boltServicePolicy.Schedules {
+		entity.Schedules = append(entity.Schedules, &schedule.Window{
+			Cron:     boltSchedule.Cron,
+			Duration: boltSchedule.Duration,
+			Timezone: boltSchedule.Timezone,
+		})
+	}
 	return nil
 }
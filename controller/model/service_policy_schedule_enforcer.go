@@ -0,0 +1,165 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"bytes"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"go.etcd.io/bbolt"
+)
+
+// how often sessions are checked against service policy schedules. Windows open and close on minute boundaries, so
+// this bounds how long a session can outlive the window which granted it
+const servicePolicyScheduleCheckInterval = 15 * time.Second
+
+// servicePolicyScheduleEnforcer periodically removes sessions which were granted by scheduled service policies that
+// are no longer in effect, because the validity period has ended or the access window has closed
+type servicePolicyScheduleEnforcer struct {
+	env       Env
+	isRunning atomic.Bool
+}
+
+func newServicePolicyScheduleEnforcer(env Env) *servicePolicyScheduleEnforcer {
+	result := &servicePolicyScheduleEnforcer{
+		env: env,
+	}
+	go result.run(env.GetCloseNotifyChannel())
+	return result
+}
+
+func (self *servicePolicyScheduleEnforcer) run(closeNotify <-chan struct{}) {
+	ticker := time.NewTicker(servicePolicyScheduleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.enforce(time.Now())
+		case <-closeNotify:
+			return
+		}
+	}
+}
+
+// enforce deletes sessions for which no service policy of the session's type grants access at the given time. It
+// returns the number of sessions deleted
+func (self *servicePolicyScheduleEnforcer) enforce(now time.Time) int {
+	if !self.isRunning.CompareAndSwap(false, true) {
+		return 0
+	}
+
+	log := pfxlog.Logger()
+
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("error during service policy schedule enforcement: %v", r)
+		}
+		self.isRunning.Store(false)
+	}()
+
+	hasScheduledPolicies := false
+	_ = self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		hasScheduledPolicies = self.env.GetManagers().EdgeService.hasScheduledPolicies(tx)
+		return nil
+	})
+
+	if !hasScheduledPolicies {
+		return 0
+	}
+
+	const maxScanPerTx = 1000
+
+	var lastId []byte
+	deleted := 0
+	notifiedIdentityIds := map[string]struct{}{}
+
+	for done := false; !done; {
+		var sessions []*db.Session
+		_ = self.env.GetDb().View(func(tx *bbolt.Tx) error {
+			cursor := self.env.GetStores().Session.IterateIds(tx, ast.BoolNodeTrue)
+
+			if len(lastId) != 0 {
+				cursor.Seek(lastId)
+				if cursor.IsValid() && bytes.Equal(cursor.Current(), lastId) {
+					cursor.Next()
+				}
+			}
+
+			for cursor.IsValid() && len(sessions) < maxScanPerTx {
+				if session, _ := self.env.GetStores().Session.LoadById(tx, string(cursor.Current())); session != nil {
+					sessions = append(sessions, session)
+				}
+				lastId = cursor.Current()
+				cursor.Next()
+			}
+
+			done = !cursor.IsValid()
+			return nil
+		})
+
+		for _, session := range sessions {
+			if self.isAllowed(session, now) {
+				continue
+			}
+
+			log.WithField("sessionId", session.Id).
+				WithField("identityId", session.IdentityId).
+				WithField("serviceId", session.ServiceId).
+				Debug("session no longer granted by any service policy schedule, removing")
+
+			ctx := change.New().SetSourceType("service.policy.schedule").SetChangeAuthorType(change.AuthorTypeController)
+			if err := self.env.GetManagers().Session.Delete(session.Id, ctx); err != nil {
+				log.WithError(err).WithField("sessionId", session.Id).Error("error removing session outside service policy schedule")
+				continue
+			}
+			deleted++
+
+			if _, notified := notifiedIdentityIds[session.IdentityId]; !notified {
+				notifiedIdentityIds[session.IdentityId] = struct{}{}
+				self.env.HandleServiceUpdatedEventForIdentityId(session.IdentityId)
+			}
+		}
+	}
+
+	return deleted
+}
+
+// isAllowed returns true if at least one service policy of the session's type which links the session's identity and
+// service is in effect at the given time. Posture checks aren't considered here, they're enforced by the posture cache
+func (self *servicePolicyScheduleEnforcer) isAllowed(session *db.Session, now time.Time) bool {
+	policies := self.env.GetManagers().EdgeService.GetPolicyPostureChecks(session.IdentityId, session.ServiceId)
+
+	hasMatchingPolicies := false
+	for _, policy := range policies {
+		if policy.PolicyType.String() != session.Type {
+			continue
+		}
+		hasMatchingPolicies = true
+		if policy.IsActiveAt(now) {
+			return true
+		}
+	}
+
+	// sessions which aren't granted by any policy are cleaned up when the policies change
+	return !hasMatchingPolicies
+}
@@ -0,0 +1,108 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePolicySchedules(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("sessions outside the policy schedule are removed", ctx.testScheduleEnforcerRemovesSessions)
+	t.Run("patching one end of the validity window is checked against the other", ctx.testPatchValidityWindow)
+}
+
+func (ctx *TestContext) testScheduleEnforcerRemovesSessions(t *testing.T) {
+	req := require.New(t)
+
+	ctx.requireNewEdgeRouter()
+	identity := ctx.requireNewIdentity(false)
+	service := ctx.requireNewService()
+
+	now := time.Now()
+	validFrom := now.Add(-time.Hour)
+	validUntil := now.Add(time.Hour)
+
+	policy := &ServicePolicy{
+		Name:          eid.New(),
+		Semantic:      db.SemanticAllOf,
+		IdentityRoles: ss("@" + identity.Id),
+		ServiceRoles:  ss("@" + service.Id),
+		PolicyType:    db.PolicyTypeDialName,
+		ValidFrom:     &validFrom,
+		ValidUntil:    &validUntil,
+	}
+	req.NoError(ctx.managers.ServicePolicy.Create(policy, change.New()))
+	ctx.requireNewEdgeRouterPolicy(ss("@"+identity.Id), ss("#all"))
+	ctx.requireNewServiceNewEdgeRouterPolicy(ss("@"+service.Id), ss("#all"))
+
+	apiSession := ctx.requireNewApiSession(identity)
+	session := ctx.requireNewSession(apiSession, service.Id, db.SessionTypeDial)
+
+	enforcer := ctx.managers.Session.scheduleEnforcer
+
+	req.Equal(0, enforcer.enforce(now))
+	_, err := ctx.managers.Session.Read(session.Id)
+	req.NoError(err)
+
+	req.Equal(1, enforcer.enforce(validUntil.Add(time.Minute)))
+	_, err = ctx.managers.Session.Read(session.Id)
+	req.True(boltz.IsErrNotFoundErr(err))
+}
+
+func (ctx *TestContext) testPatchValidityWindow(t *testing.T) {
+	req := require.New(t)
+
+	validFrom := time.Now().Add(time.Hour)
+	validUntil := validFrom.Add(time.Hour)
+
+	policy := &ServicePolicy{
+		Name:          eid.New(),
+		Semantic:      db.SemanticAllOf,
+		IdentityRoles: ss("#all"),
+		ServiceRoles:  ss("#all"),
+		PolicyType:    db.PolicyTypeDialName,
+		ValidFrom:     &validFrom,
+		ValidUntil:    &validUntil,
+	}
+	req.NoError(ctx.managers.ServicePolicy.Create(policy, change.New()))
+
+	invalidUntil := validFrom.Add(-time.Minute)
+	patch := &ServicePolicy{ValidUntil: &invalidUntil}
+	patch.Id = policy.Id
+	err := ctx.managers.ServicePolicy.Update(patch, fields.UpdatedFieldsMap{db.FieldServicePolicyValidUntil: struct{}{}}, change.New())
+	req.Error(err)
+	var fieldErr *errorz.FieldError
+	req.ErrorAs(err, &fieldErr)
+	req.Equal(db.FieldServicePolicyValidUntil, fieldErr.FieldName)
+
+	newUntil := validUntil.Add(time.Hour)
+	patch.ValidUntil = &newUntil
+	req.NoError(ctx.managers.ServicePolicy.Update(patch, fields.UpdatedFieldsMap{db.FieldServicePolicyValidUntil: struct{}{}}, change.New()))
+}
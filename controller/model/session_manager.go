@@ -40,11 +40,13 @@ func NewSessionManager(env Env) *SessionManager {
 		baseEntityManager: newBaseEntityManager[*Session, *db.Session](env, env.GetStores().Session),
 	}
 	manager.impl = manager
+	manager.scheduleEnforcer = newServicePolicyScheduleEnforcer(env)
 	return manager
 }
 
 type SessionManager struct {
 	baseEntityManager[*Session, *db.Session]
+	scheduleEnforcer *servicePolicyScheduleEnforcer
 }
 
 func (self *SessionManager) newModelEntity() *Session {
@@ -71,6 +73,9 @@ func (self *SessionManager) EvaluatePostureForService(identityId, apiSessionId,
 
 	var failedPolicyIds []string
 	var successPolicyIds []string
+	outsideSchedulePolicyIds := map[string]struct{}{}
+
+	now := time.Now()
 
 	for policyId, policyPostureCheck := range policyPostureCheckMap {
 
@@ -78,6 +83,15 @@ func (self *SessionManager) EvaluatePostureForService(identityId, apiSessionId,
 			continue
 		}
 		hasMatchingPolicies = true
+
+		if !policyPostureCheck.IsActiveAt(now) {
+			outsideSchedulePolicyIds[policyId] = struct{}{}
+			failedPolicies[policyId] = nil
+			failedPoliciesIdToName[policyId] = policyPostureCheck.PolicyName
+			failedPolicyIds = append(failedPolicyIds, policyId)
+			continue
+		}
+
 		var failedChecks []*PostureCheckFailure
 
 		for _, postureCheck := range policyPostureCheck.PostureChecks {
@@ -117,14 +131,23 @@ func (self *SessionManager) EvaluatePostureForService(identityId, apiSessionId,
 		}
 
 		for policyId, failures := range failedPolicies {
+			_, outsideSchedule := outsideSchedulePolicyIds[policyId]
 			policyFailure := &PosturePolicyFailure{
-				PolicyId:   policyId,
-				PolicyName: failedPoliciesIdToName[policyId],
-				Checks:     failures,
+				PolicyId:        policyId,
+				PolicyName:      failedPoliciesIdToName[policyId],
+				Checks:          failures,
+				OutsideSchedule: outsideSchedule,
 			}
 
 			var outFailures []interface{}
 
+			if outsideSchedule {
+				outFailures = append(outFailures, map[string]interface{}{
+					"outsideSchedule": true,
+					"isPassing":       false,
+				})
+			}
+
 			for _, failure := range failures {
 				outFailures = append(outFailures, failure.ToClientErrorData())
 			}
@@ -133,8 +156,13 @@ func (self *SessionManager) EvaluatePostureForService(identityId, apiSessionId,
 			sessionFailure.PolicyFailures = append(sessionFailure.PolicyFailures, policyFailure)
 		}
 
+		message := fmt.Sprintf("Failed to pass posture checks for service policies: %v", failedPolicyIds)
+		if len(outsideSchedulePolicyIds) == len(failedPolicyIds) {
+			message = fmt.Sprintf("Service policies are outside their scheduled access windows: %v", failedPolicyIds)
+		}
+
 		cause := &fabricApiError.GenericCauseError{
-			Message: fmt.Sprintf("Failed to pass posture checks for service policies: %v", failedPolicyIds),
+			Message: message,
 			DataMap: failureMap,
 		}
 
@@ -34,7 +34,6 @@ import (
 	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/raft"
 	"google.golang.org/protobuf/proto"
-	"math"
 	"os"
 	"path/filepath"
 	"runtime/debug"
@@ -55,6 +54,7 @@ import (
 	"github.com/openziti/metrics"
 	"github.com/openziti/metrics/metrics_pb"
 	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/compression"
 	"github.com/openziti/ziti/common/ctrl_msg"
 	"github.com/openziti/ziti/common/logcontext"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
@@ -103,6 +103,7 @@ type Network struct {
 	lastSnapshot           time.Time
 	metricsRegistry        metrics.Registry
 	VersionProvider        versions.VersionProvider
+	pathCache              *pathCache
 
 	serviceEventMetrics          metrics.UsageRegistry
 	serviceDialSuccessCounter    metrics.IntervalCounter
@@ -162,6 +163,10 @@ func NewNetwork(config Config, env model.Env) (*Network, error) {
 		config: config,
 	}
 
+	network.pathCache = newPathCache(network, config.GetMetricsRegistry())
+	network.Link.SetTopologyChangeListener(network.pathCache)
+	network.Router.SetTopologyChangeListener(network.pathCache)
+
 	env.GetManagers().Command.Decoders.RegisterF(int32(cmd_pb.CommandType_SyncSnapshot), network.decodeSyncSnapshotCommand)
 
 	routerCommPool, err := network.createRouterCommPool(config)
@@ -597,9 +602,17 @@ func (network *Network) CreateCircuit(params model.CreateCircuitParams) (*model.
 			network.ServiceDialOtherError(serviceId)
 			return nil, pathErr
 		}
+		network.setAlternatePath(path, svc.Multipath)
+		path.Priority = svc.Priority
 
 		// get circuit tags
 		tags := params.GetCircuitTags(terminator)
+		if svc.Compression != "" {
+			if tags == nil {
+				tags = map[string]string{}
+			}
+			tags[compression.TagCodec] = svc.Compression
+		}
 
 		// 4a: Create Route Messages
 		rms := network.CreateRouteMessages(path, attempt, circuitId, terminator, deadline)
@@ -647,7 +660,7 @@ func (network *Network) CreateCircuit(params model.CreateCircuitParams) (*model.
 
 		// 5.a: Unroute Abandoned Routers (from Previous Attempts)
 		usedRouters := make(map[string]struct{})
-		for _, r := range path.Nodes {
+		for _, r := range path.RouteNodes() {
 			usedRouters[r.Id] = struct{}{}
 		}
 		cleanupCount := 0
@@ -753,7 +766,7 @@ func (network *Network) selectPath(params model.CreateCircuitParams, svc *model.
 				continue
 			}
 
-			path, cost, err := network.shortestPath(params.GetSourceRouter(), dstR)
+			path, cost, err := network.pathCache.shortestPath(params.GetSourceRouter(), dstR)
 			if err != nil {
 				log.Debugf("error while calculating path for service %v: %v", svc.Id, err)
 				errList = append(errList, err)
@@ -838,7 +851,7 @@ func (network *Network) RemoveCircuit(circuitId string, now bool) error {
 	log := pfxlog.Logger().WithField("circuitId", circuitId)
 
 	if circuit, found := network.Circuit.Get(circuitId); found {
-		for _, r := range circuit.Path.Nodes {
+		for _, r := range circuit.Path.RouteNodes() {
 			err := sendUnroute(r, circuit.Id, now)
 			if err != nil {
 				log.Errorf("error sending unroute to [r/%s] (%s)", r.Id, err)
@@ -889,9 +902,13 @@ func (network *Network) CreatePath(srcR, dstR *model.Router) (*model.Path, error
 }
 
 func (network *Network) setLinks(path *model.Path) error {
+	return network.setLinksExcluding(path, nil)
+}
+
+func (network *Network) setLinksExcluding(path *model.Path, excluded map[string]struct{}) error {
 	if len(path.Nodes) > 1 {
 		for i := 0; i < len(path.Nodes)-1; i++ {
-			if link, found := network.Link.LeastExpensiveLink(path.Nodes[i], path.Nodes[i+1]); found {
+			if link, found := network.Link.LeastExpensiveLinkExcluding(path.Nodes[i], path.Nodes[i+1], excluded); found {
 				path.Links = append(path.Links, link)
 			} else {
 				return errors.Errorf("no link from r/%v to r/%v", path.Nodes[i].Id, path.Nodes[i+1].Id)
@@ -1091,9 +1108,9 @@ func (network *Network) rerouteCircuit(circuit *model.Circuit, deadline time.Tim
 
 			rms := network.CreateRouteMessages(cq, SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
 
-			for i := 0; i < len(cq.Nodes); i++ {
-				if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
-					log.WithError(err).Errorf("error sending route to [r/%s]", cq.Nodes[i].Id)
+			for i, r := range cq.RouteNodes() {
+				if _, err := sendRoute(r, rms[i], network.options.RouteTimeout); err != nil {
+					log.WithError(err).Errorf("error sending route to [r/%s]", r.Id)
 				}
 			}
 
@@ -1121,10 +1138,10 @@ func (network *Network) smartReroute(circuit *model.Circuit, cq *model.Path, dea
 
 		rms := network.CreateRouteMessages(cq, SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
 
-		for i := 0; i < len(cq.Nodes); i++ {
-			if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
+		for i, r := range cq.RouteNodes() {
+			if _, err := sendRoute(r, rms[i], network.options.RouteTimeout); err != nil {
 				retry = true
-				log.WithField("routerId", cq.Nodes[i].Id).WithError(err).Error("error sending smart route update to router")
+				log.WithField("routerId", r.Id).WithError(err).Error("error sending smart route update to router")
 				break
 			}
 		}
@@ -1154,8 +1171,10 @@ func (network *Network) AcceptMetricsMsg(metrics *metrics_pb.MetricsMessage) {
 		metricId := "link." + link.Id + ".latency"
 		var latencyCost int64
 		var found bool
+		quality := &model.LinkQuality{}
 		if latency, ok := metrics.Histograms[metricId]; ok {
 			latencyCost = int64(latency.Mean)
+			quality.Jitter = int64(latency.StdDev)
 			found = true
 
 			metricId = "link." + link.Id + ".queue_time"
@@ -1164,11 +1183,19 @@ func (network *Network) AcceptMetricsMsg(metrics *metrics_pb.MetricsMessage) {
 			}
 		}
 
-		if found {
+		qualityFound := getLinkThroughputMetrics(metrics, link.Id, quality)
+
+		if found || qualityFound {
 			if link.Src.Id == router.Id {
-				link.SetSrcLatency(latencyCost) // latency is in nanoseconds
+				if found {
+					link.SetSrcLatency(latencyCost) // latency is in nanoseconds
+				}
+				link.SetSrcQuality(quality)
 			} else if link.DstId == router.Id {
-				link.SetDstLatency(latencyCost) // latency is in nanoseconds
+				if found {
+					link.SetDstLatency(latencyCost) // latency is in nanoseconds
+				}
+				link.SetDstQuality(quality)
 			} else {
 				log.Warnf("link not for router")
 			}
@@ -1176,6 +1203,34 @@ func (network *Network) AcceptMetricsMsg(metrics *metrics_pb.MetricsMessage) {
 	}
 }
 
+// getLinkThroughputMetrics fills in the link loss ratio and send rate from the link message meters, returning true if
+// any of them were reported
+func getLinkThroughputMetrics(metrics *metrics_pb.MetricsMessage, linkId string, quality *model.LinkQuality) bool {
+	var sentRate, droppedRate float64
+	var found bool
+
+	if meter, ok := metrics.Meters["link."+linkId+".tx.bytesrate"]; ok {
+		quality.TxRate = meter.M1Rate
+		found = true
+	}
+
+	if meter, ok := metrics.Meters["link."+linkId+".tx.msgrate"]; ok {
+		sentRate = meter.M1Rate
+		found = true
+	}
+
+	if meter, ok := metrics.Meters["link.dropped_msgs:"+linkId]; ok {
+		droppedRate = meter.M1Rate
+		found = true
+	}
+
+	if sentRate+droppedRate > 0 {
+		quality.LossRatio = droppedRate / (sentRate + droppedRate)
+	}
+
+	return found
+}
+
 func sendRoute(r *model.Router, createMsg *ctrl_pb.Route, timeout time.Duration) (xt.PeerData, error) {
 	log := pfxlog.Logger().WithField("routerId", r.Id).
 		WithField("circuitId", createMsg.CircuitId)
@@ -1546,23 +1601,6 @@ func (network *Network) reportRouterLinksError(router *model.Router, err error,
 	cb(result)
 }
 
-func minCost(q map[*model.Router]bool, dist map[*model.Router]int64) *model.Router {
-	if dist == nil || len(dist) < 1 {
-		return nil
-	}
-
-	min := int64(math.MaxInt64)
-	var selected *model.Router
-	for r := range q {
-		d := dist[r]
-		if d <= min {
-			selected = r
-			min = d
-		}
-	}
-	return selected
-}
-
 type Cache interface {
 	RemoveFromCache(id string)
 }
@@ -1,16 +1,75 @@
 package network
 
 import (
-	"fmt"
+	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/xt"
 	"github.com/pkg/errors"
-	"math"
 	"time"
 )
 
+// CreateRouteMessages returns the route messages for the path, ordered to match path.RouteNodes(). If the path has an
+// alternate, routers on both paths get a single message with the forwards for both, primary forwards first.
 func (network *Network) CreateRouteMessages(path *model.Path, attempt uint32, circuitId string, terminator xt.Terminator, deadline time.Time) []*ctrl_pb.Route {
+	routeMessages := network.createPathRouteMessages(path, attempt, circuitId, terminator, deadline)
+	priority := getPriorityClass(path.Priority)
+	for _, routeMessage := range routeMessages {
+		routeMessage.Priority = priority
+	}
+	if path.Alternate == nil {
+		return routeMessages
+	}
+
+	mode := getMultipathMode(path.Multipath)
+	byRouter := map[string]*ctrl_pb.Route{}
+	for i, r := range path.Nodes {
+		byRouter[r.Id] = routeMessages[i]
+	}
+
+	alternateMessages := network.createPathRouteMessages(path.Alternate, attempt, circuitId, terminator, deadline)
+	for i, r := range path.Alternate.Nodes {
+		if routeMessage, found := byRouter[r.Id]; found {
+			routeMessage.Forwards = append(routeMessage.Forwards, alternateMessages[i].Forwards...)
+		} else {
+			byRouter[r.Id] = alternateMessages[i]
+		}
+	}
+
+	var result []*ctrl_pb.Route
+	for _, r := range path.RouteNodes() {
+		routeMessage := byRouter[r.Id]
+		routeMessage.Multipath = mode
+		routeMessage.Priority = priority
+		result = append(result, routeMessage)
+	}
+	return result
+}
+
+func getMultipathMode(multipath string) ctrl_pb.MultipathMode {
+	switch multipath {
+	case db.ServiceMultipathDuplicate:
+		return ctrl_pb.MultipathMode_MultipathDuplicate
+	case db.ServiceMultipathFailover:
+		return ctrl_pb.MultipathMode_MultipathFailover
+	default:
+		return ctrl_pb.MultipathMode_MultipathNone
+	}
+}
+
+func getPriorityClass(priority string) ctrl_pb.PriorityClass {
+	switch priority {
+	case db.ServicePriorityInteractive:
+		return ctrl_pb.PriorityClass_PriorityInteractive
+	case db.ServicePriorityBulk:
+		return ctrl_pb.PriorityClass_PriorityBulk
+	default:
+		return ctrl_pb.PriorityClass_PriorityDefault
+	}
+}
+
+func (network *Network) createPathRouteMessages(path *model.Path, attempt uint32, circuitId string, terminator xt.Terminator, deadline time.Time) []*ctrl_pb.Route {
 	var routeMessages []*ctrl_pb.Route
 	remainingTime := time.Until(deadline)
 	if len(path.Links) == 0 {
@@ -114,10 +173,48 @@ func (network *Network) CreatePathWithNodes(nodes []*model.Router) (*model.Path,
 	return path, nil
 }
 
+// setAlternatePath looks for a path between the same routers which doesn't share any links with the given path. If
+// there isn't one, the circuit continues with a single path.
+func (network *Network) setAlternatePath(path *model.Path, multipath string) {
+	path.Multipath = multipath
+	path.Alternate = nil
+
+	if multipath == "" || len(path.Links) == 0 {
+		return
+	}
+
+	excluded := map[string]struct{}{}
+	for _, link := range path.Links {
+		excluded[link.Id] = struct{}{}
+	}
+
+	log := pfxlog.Logger().WithField("path", path.String()).WithField("multipath", multipath)
+
+	srcR := path.Nodes[0]
+	dstR := path.EgressRouter()
+	nodes, _, err := network.shortestPathExcluding(srcR, dstR, excluded)
+	if err != nil {
+		log.WithError(err).Warn("no link-disjoint alternate path available, using single path")
+		return
+	}
+
+	alternate := &model.Path{
+		Nodes:     nodes,
+		IngressId: path.IngressId,
+		EgressId:  path.EgressId,
+	}
+	if err = network.setLinksExcluding(alternate, excluded); err != nil {
+		log.WithError(err).Warn("no link-disjoint alternate path available, using single path")
+		return
+	}
+
+	path.Alternate = alternate
+}
+
 func (network *Network) UpdatePath(path *model.Path) (*model.Path, error) {
 	srcR := path.Nodes[0]
 	dstR := path.Nodes[len(path.Nodes)-1]
-	nodes, _, err := network.shortestPath(srcR, dstR)
+	nodes, _, err := network.pathCache.shortestPath(srcR, dstR)
 	if err != nil {
 		return nil, err
 	}
@@ -134,77 +231,19 @@ func (network *Network) UpdatePath(path *model.Path) (*model.Path, error) {
 	if err := network.setLinks(path2); err != nil {
 		return nil, err
 	}
+	network.setAlternatePath(path2, path.Multipath)
+	path2.Priority = path.Priority
 	return path2, nil
 }
 
 func (network *Network) shortestPath(srcR *model.Router, dstR *model.Router) ([]*model.Router, int64, error) {
+	return network.shortestPathExcluding(srcR, dstR, nil)
+}
+
+// shortestPathExcluding finds the least expensive path between the routers which doesn't use any of the excluded links
+func (network *Network) shortestPathExcluding(srcR *model.Router, dstR *model.Router, excluded map[string]struct{}) ([]*model.Router, int64, error) {
 	if srcR == nil || dstR == nil {
 		return nil, 0, errors.New("not routable (!srcR||!dstR)")
 	}
-
-	if srcR == dstR {
-		return []*model.Router{srcR}, 0, nil
-	}
-
-	dist := make(map[*model.Router]int64)
-	prev := make(map[*model.Router]*model.Router)
-	unvisited := make(map[*model.Router]bool)
-
-	for _, r := range network.Router.AllConnected() {
-		dist[r] = math.MaxInt32
-		unvisited[r] = true
-	}
-	dist[srcR] = 0
-
-	minRouterCost := network.options.MinRouterCost
-
-	for len(unvisited) > 0 {
-		u := minCost(unvisited, dist)
-		if u == dstR { // if the dest router is the lowest cost next link, we can stop evaluating
-			break
-		}
-		delete(unvisited, u)
-
-		neighbors := network.Link.ConnectedNeighborsOfRouter(u)
-		for _, r := range neighbors {
-			if _, found := unvisited[r]; found {
-				var cost int64 = math.MaxInt32 + 1
-				if l, found := network.Link.LeastExpensiveLink(r, u); found {
-					if !r.NoTraversal || r == srcR || r == dstR {
-						cost = l.GetCost() + int64(max(r.Cost, minRouterCost))
-					}
-				}
-
-				alt := dist[u] + cost
-				if alt < dist[r] {
-					dist[r] = alt
-					prev[r] = u
-				}
-			}
-		}
-	}
-
-	/*
-	 * dist: (r2->r1->r0)
-	 *		r0 = 2 <- r1
-	 *		r1 = 1 <- r2
-	 *		r2 = 0 <- nil
-	 */
-
-	routerPath := make([]*model.Router, 0)
-	p := prev[dstR]
-	for p != nil {
-		routerPath = append([]*model.Router{p}, routerPath...)
-		p = prev[p]
-	}
-	routerPath = append(routerPath, dstR)
-
-	if routerPath[0] != srcR {
-		return nil, 0, fmt.Errorf("can't route from %v -> %v", srcR.Id, dstR.Id)
-	}
-	if routerPath[len(routerPath)-1] != dstR {
-		return nil, 0, fmt.Errorf("can't route from %v -> %v. destination unreachable", srcR.Id, dstR.Id)
-	}
-
-	return routerPath, dist[dstR], nil
+	return network.buildPathTree(srcR, dstR, excluded).getPath(dstR)
 }
@@ -0,0 +1,292 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/pkg/errors"
+)
+
+// unreachableCost is the path cost at or above which a router is treated as unreachable
+const unreachableCost = math.MaxInt32
+
+// pathTree holds the least expensive paths from a source router to every router reachable from it
+type pathTree struct {
+	src   *model.Router
+	dist  map[string]int64
+	prev  map[string]*model.Router
+	links map[string]*model.Link
+}
+
+// usesLink returns true if the link is used to reach either of its routers
+func (self *pathTree) usesLink(link *model.Link) bool {
+	return self.links[link.Src.Id] == link || self.links[link.DstId] == link
+}
+
+// improvedBy returns true if going from one router to the other over the link would be cheaper than the path the tree
+// currently has to the second router
+func (self *pathTree) improvedBy(from, to *model.Router, link *model.Link, minRouterCost uint16) bool {
+	if from == nil || to == nil || to == self.src {
+		return false
+	}
+	fromCost, found := self.dist[from.Id]
+	if !found || (from != self.src && from.NoTraversal) {
+		return false
+	}
+	cost := fromCost + link.GetCost() + int64(max(to.Cost, minRouterCost))
+	toCost, found := self.dist[to.Id]
+	return cost < unreachableCost && (!found || cost < toCost)
+}
+
+func (self *pathTree) getPath(dstR *model.Router) ([]*model.Router, int64, error) {
+	if self.src == dstR {
+		return []*model.Router{dstR}, 0, nil
+	}
+
+	cost, found := self.dist[dstR.Id]
+	if !found {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v. destination unreachable", self.src.Id, dstR.Id)
+	}
+
+	routerPath := []*model.Router{dstR}
+	for p := self.prev[dstR.Id]; p != nil; p = self.prev[p.Id] {
+		routerPath = append([]*model.Router{p}, routerPath...)
+	}
+
+	if routerPath[0] != self.src {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v", self.src.Id, dstR.Id)
+	}
+
+	return routerPath, cost, nil
+}
+
+type pathTreeEntry struct {
+	router *model.Router
+	cost   int64
+}
+
+type pathTreeQueue []*pathTreeEntry
+
+func (self pathTreeQueue) Len() int {
+	return len(self)
+}
+
+func (self pathTreeQueue) Less(i, j int) bool {
+	return self[i].cost < self[j].cost
+}
+
+func (self pathTreeQueue) Swap(i, j int) {
+	self[i], self[j] = self[j], self[i]
+}
+
+func (self *pathTreeQueue) Push(x any) {
+	*self = append(*self, x.(*pathTreeEntry))
+}
+
+func (self *pathTreeQueue) Pop() any {
+	old := *self
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*self = old[:n-1]
+	return entry
+}
+
+// buildPathTree runs Dijkstra from the source router. If a destination is given it stops once the destination is
+// reached, otherwise it finds paths to every reachable router. Routers which don't allow traversal can be the source or
+// destination of a path, but are never used in the middle of one.
+func (network *Network) buildPathTree(srcR, dstR *model.Router, excluded map[string]struct{}) *pathTree {
+	tree := &pathTree{
+		src:   srcR,
+		dist:  map[string]int64{srcR.Id: 0},
+		prev:  map[string]*model.Router{},
+		links: map[string]*model.Link{},
+	}
+
+	if !srcR.Connected.Load() {
+		return tree
+	}
+
+	minRouterCost := network.options.MinRouterCost
+	visited := map[string]struct{}{}
+	queue := &pathTreeQueue{{router: srcR}}
+
+	for queue.Len() > 0 {
+		entry := heap.Pop(queue).(*pathTreeEntry)
+		u := entry.router
+		if _, found := visited[u.Id]; found {
+			continue
+		}
+		visited[u.Id] = struct{}{}
+
+		if u == dstR {
+			break
+		}
+
+		if u != srcR && u.NoTraversal {
+			continue
+		}
+
+		for _, r := range network.Link.ConnectedNeighborsOfRouter(u) {
+			if _, found := visited[r.Id]; found || !r.Connected.Load() {
+				continue
+			}
+
+			l, found := network.Link.LeastExpensiveLinkExcluding(r, u, excluded)
+			if !found {
+				continue
+			}
+
+			cost := entry.cost + l.GetCost() + int64(max(r.Cost, minRouterCost))
+			if cost >= unreachableCost {
+				continue
+			}
+
+			if current, found := tree.dist[r.Id]; !found || cost < current {
+				tree.dist[r.Id] = cost
+				tree.prev[r.Id] = u
+				tree.links[r.Id] = l
+				heap.Push(queue, &pathTreeEntry{router: r, cost: cost})
+			}
+		}
+	}
+
+	return tree
+}
+
+// pathCache keeps the path tree for each source router used to create circuits, so dials don't need to run Dijkstra
+// for every terminator router. Trees are dropped when a change could affect them: links which a tree uses changing or
+// going away, links becoming cheap enough to shorten a tree's paths and routers connecting, disconnecting or changing
+// cost.
+type pathCache struct {
+	network *Network
+	lock    sync.Mutex
+	trees   map[string]*pathTree
+
+	// version is incremented on every change, so trees built concurrently with a change aren't cached
+	version uint64
+
+	hitCount      atomic.Int64
+	missCount     atomic.Int64
+	hits          metrics.Meter
+	misses        metrics.Meter
+	invalidations metrics.Meter
+}
+
+func newPathCache(network *Network, registry metrics.Registry) *pathCache {
+	result := &pathCache{
+		network:       network,
+		trees:         map[string]*pathTree{},
+		hits:          registry.Meter("path_cache.hits"),
+		misses:        registry.Meter("path_cache.misses"),
+		invalidations: registry.Meter("path_cache.invalidations"),
+	}
+
+	registry.FuncGauge("path_cache.size", func() int64 {
+		result.lock.Lock()
+		defer result.lock.Unlock()
+		return int64(len(result.trees))
+	})
+
+	registry.FuncGauge("path_cache.hit_rate", func() int64 {
+		return result.getHitRate()
+	})
+
+	return result
+}
+
+// getHitRate returns the percentage of path lookups which were answered from the cache
+func (self *pathCache) getHitRate() int64 {
+	hits := self.hitCount.Load()
+	total := hits + self.missCount.Load()
+	if total == 0 {
+		return 0
+	}
+	return hits * 100 / total
+}
+
+func (self *pathCache) shortestPath(srcR, dstR *model.Router) ([]*model.Router, int64, error) {
+	if srcR == nil || dstR == nil {
+		return nil, 0, errors.New("not routable (!srcR||!dstR)")
+	}
+
+	self.lock.Lock()
+	tree, found := self.trees[srcR.Id]
+	version := self.version
+	self.lock.Unlock()
+
+	if found && tree.src == srcR {
+		self.hitCount.Add(1)
+		self.hits.Mark(1)
+		return tree.getPath(dstR)
+	}
+
+	self.missCount.Add(1)
+	self.misses.Mark(1)
+
+	tree = self.network.buildPathTree(srcR, nil, nil)
+
+	self.lock.Lock()
+	if self.version == version {
+		self.trees[srcR.Id] = tree
+	}
+	self.lock.Unlock()
+
+	return tree.getPath(dstR)
+}
+
+func (self *pathCache) LinkChanged(link *model.Link) {
+	minRouterCost := self.network.options.MinRouterCost
+	src := link.Src
+	dst := link.GetDest()
+	usable := link.IsUsable()
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.version++
+	for id, tree := range self.trees {
+		if tree.usesLink(link) ||
+			(usable && (tree.improvedBy(src, dst, link, minRouterCost) || tree.improvedBy(dst, src, link, minRouterCost))) {
+			delete(self.trees, id)
+			self.invalidations.Mark(1)
+		}
+	}
+}
+
+func (self *pathCache) RouterChanged(router *model.Router) {
+	connected := router.Connected.Load()
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.version++
+	for id, tree := range self.trees {
+		// new routers can provide shorter paths anywhere, while routers going away only affect trees which reach them
+		if _, reached := tree.dist[router.Id]; connected || reached {
+			delete(self.trees, id)
+			self.invalidations.Mark(1)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathCache(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config, ctx)
+	req.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	req.NoError(err)
+
+	r0 := model.NewRouterForTest("r0", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r0)
+
+	r1 := model.NewRouterForTest("r1", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r1)
+
+	r2 := model.NewRouterForTest("r2", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r2)
+
+	r3 := model.NewRouterForTest("r3", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r3)
+
+	newPathTestLink(network, "l0", r0, r1)
+	l1 := newPathTestLink(network, "l1", r1, r3)
+	l2 := newPathTestLink(network, "l2", r0, r2)
+	l2.SetStaticCost(2)
+	l3 := newPathTestLink(network, "l3", r2, r3)
+
+	cache := network.pathCache
+
+	path, cost, err := cache.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r1, r3}, path)
+	req.Equal(int64(2), cost)
+	req.Equal(int64(1), cache.missCount.Load())
+
+	// other destinations from the same source are answered from the same tree
+	path, _, err = cache.shortestPath(r0, r2)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r2}, path)
+	req.Equal(int64(1), cache.hitCount.Load())
+	req.Equal(int64(50), cache.getHitRate())
+
+	// making an unused link more expensive doesn't affect the tree
+	l3.SetStaticCost(20)
+	_, _, err = cache.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal(int64(2), cache.hitCount.Load())
+
+	// making a used link more expensive does
+	l1.SetStaticCost(50)
+	path, cost, err = cache.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r2, r3}, path)
+	req.Equal(int64(22), cost)
+	req.Equal(int64(2), cache.missCount.Load())
+
+	// as does making an unused link cheap enough to shorten a path
+	l1.SetStaticCost(1)
+	path, _, err = cache.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r1, r3}, path)
+	req.Equal(int64(3), cache.missCount.Load())
+
+	// links going down
+	l1.SetState(model.Failed)
+	path, _, err = cache.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r2, r3}, path)
+	req.Equal(int64(4), cache.missCount.Load())
+
+	// routers disconnecting only affect trees which reach them
+	r4 := model.NewRouterForTest("r4", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r4)
+	_, _, err = cache.shortestPath(r1, r0)
+	req.NoError(err)
+	network.Router.MarkDisconnected(r4)
+	hits := cache.hitCount.Load()
+	_, _, err = cache.shortestPath(r1, r0)
+	req.NoError(err)
+	req.Equal(hits+1, cache.hitCount.Load())
+
+	network.Router.MarkDisconnected(r2)
+	_, _, err = cache.shortestPath(r0, r3)
+	req.Error(err)
+}
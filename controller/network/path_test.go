@@ -17,7 +17,9 @@
 package network
 
 import (
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	config2 "github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/model"
 	"testing"
 	"time"
@@ -132,10 +134,15 @@ func TestTransitPath2(t *testing.T) {
 	assert.Equal(t, l1, path.Links[1])
 	assert.Equal(t, r2, path.EgressRouter())
 
+	path.Priority = db.ServicePriorityBulk
+
 	terminator := &model.Terminator{Address: addr, Binding: "transport"}
 	routeMessages := network.CreateRouteMessages(path, 0, "s0", terminator, time.Now().Add(config2.DefaultOptionsRouteTimeout))
 	assert.NotNil(t, routeMessages)
 	assert.Equal(t, 3, len(routeMessages))
+	for _, rm := range routeMessages {
+		assert.Equal(t, ctrl_pb.PriorityClass_PriorityBulk, rm.Priority)
+	}
 
 	// ingress route message
 	rm0 := routeMessages[0]
@@ -597,6 +604,92 @@ func TestMinRouterCost(t *testing.T) {
 	req.Equal(int64(222), cost)
 }
 
+func TestMultipathAlternatePath(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config, ctx)
+	req.NoError(err)
+
+	addr := "tcp:0.0.0.0:0"
+	transportAddr, err := tcp.AddressParser{}.Parse(addr)
+	req.NoError(err)
+
+	r0 := model.NewRouterForTest("r0", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r0)
+
+	r1 := model.NewRouterForTest("r1", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r1)
+
+	r2 := model.NewRouterForTest("r2", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r2)
+
+	r3 := model.NewRouterForTest("r3", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r3)
+
+	l0 := newPathTestLink(network, "l0", r0, r1)
+	l1 := newPathTestLink(network, "l1", r1, r3)
+	l2 := newPathTestLink(network, "l2", r0, r2)
+	l2.SetStaticCost(10)
+	l3 := newPathTestLink(network, "l3", r2, r3)
+
+	path, err := network.CreatePath(r0, r3)
+	req.NoError(err)
+	req.Nil(path.Alternate)
+	req.Equal([]*model.Router{r0, r1, r3}, path.RouteNodes())
+
+	network.setAlternatePath(path, db.ServiceMultipathDuplicate)
+	path.Priority = db.ServicePriorityInteractive
+	req.NotNil(path.Alternate)
+	req.Equal([]*model.Router{r0, r2, r3}, path.Alternate.Nodes)
+	req.Equal([]*model.Link{l2, l3}, path.Alternate.Links)
+	req.Equal([]*model.Router{r0, r1, r2, r3}, path.RouteNodes())
+	req.True(path.UsesLink(l3))
+
+	terminator := &model.Terminator{Address: addr, Binding: "transport"}
+	routeMessages := network.CreateRouteMessages(path, 0, "s0", terminator, time.Now().Add(config2.DefaultOptionsRouteTimeout))
+	req.Len(routeMessages, 4)
+	for _, rm := range routeMessages {
+		req.Equal(ctrl_pb.MultipathMode_MultipathDuplicate, rm.Multipath)
+		req.Equal(ctrl_pb.PriorityClass_PriorityInteractive, rm.Priority)
+	}
+
+	// the ingress router forwards to both paths, primary first
+	rm0 := routeMessages[0]
+	req.Len(rm0.Forwards, 4)
+	req.Equal(path.IngressId, rm0.Forwards[0].SrcAddress)
+	req.Equal(l0.Id, rm0.Forwards[0].DstAddress)
+	req.Equal(path.IngressId, rm0.Forwards[2].SrcAddress)
+	req.Equal(l2.Id, rm0.Forwards[2].DstAddress)
+
+	// the egress router is last and also forwards to both paths
+	rm3 := routeMessages[3]
+	req.NotNil(rm3.Egress)
+	req.Len(rm3.Forwards, 4)
+	req.Equal(path.EgressId, rm3.Forwards[0].SrcAddress)
+	req.Equal(l1.Id, rm3.Forwards[0].DstAddress)
+	req.Equal(path.EgressId, rm3.Forwards[2].SrcAddress)
+	req.Equal(l3.Id, rm3.Forwards[2].DstAddress)
+
+	// reroutes keep the multipath mode and priority class
+	updated, err := network.UpdatePath(path)
+	req.NoError(err)
+	req.True(updated.EqualPath(path))
+	req.Equal(db.ServiceMultipathDuplicate, updated.Multipath)
+	req.Equal(db.ServicePriorityInteractive, updated.Priority)
+
+	// without a link-disjoint path, the circuit is single path
+	l2.SetState(model.Failed)
+	network.setAlternatePath(path, db.ServiceMultipathFailover)
+	req.Nil(path.Alternate)
+	req.Equal(db.ServiceMultipathFailover, path.Multipath)
+}
+
 func newPathTestLink(network *Network, id string, srcR, destR *model.Router) *model.Link {
 	l := model.NewTestLink(id, srcR, destR)
 	l.SrcLatency = 0
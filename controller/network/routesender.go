@@ -65,6 +65,7 @@ type routeSender struct {
 	attendance      map[string]bool
 	serviceCounters ServiceCounters
 	terminators     *model.TerminatorManager
+	started         time.Time
 }
 
 func newRouteSender(circuitId string, timeout time.Duration, serviceCounters ServiceCounters, terminators *model.TerminatorManager) *routeSender {
@@ -81,9 +82,10 @@ func newRouteSender(circuitId string, timeout time.Duration, serviceCounters Ser
 func (self *routeSender) route(attempt uint32, path *model.Path, routeMsgs []*ctrl_pb.Route, strategy xt.Strategy, terminator xt.Terminator, ctx logcontext.Context) (peerData xt.PeerData, cleanups map[string]struct{}, err CircuitError) {
 	logger := pfxlog.ChannelLogger(logcontext.EstablishPath).Wire(ctx)
 
+	self.started = time.Now()
+
 	// send route messages
-	for i := 0; i < len(path.Nodes); i++ {
-		r := path.Nodes[i]
+	for i, r := range path.RouteNodes() {
 		msg := routeMsgs[i]
 		logger.Debugf("sending route message to [r/%s] for attempt [#%d]", r.Id, msg.Attempt)
 		go self.sendRoute(r, msg, ctx)
@@ -140,7 +142,7 @@ func (self *routeSender) handleRouteSend(attempt uint32, path *model.Path, strat
 			self.attendance[status.Router.Id] = true
 			if status.Router.Id == terminator.GetRouterId() {
 				peerData = status.PeerData
-				strategy.NotifyEvent(xt.NewDialSucceeded(terminator))
+				strategy.NotifyEvent(xt.NewDialSucceededWithLatency(terminator, status.DialDuration, time.Since(self.started)))
 				self.serviceCounters.ServiceDialSuccess(terminator.GetServiceId(), terminator.GetId())
 			}
 		} else {
@@ -222,7 +224,7 @@ func (self *routeSender) sendRoute(r *model.Router, routeMsg *ctrl_pb.Route, ctx
 
 func (self *routeSender) cleanups(path *model.Path) map[string]struct{} {
 	cleanups := make(map[string]struct{})
-	for _, r := range path.Nodes {
+	for _, r := range path.RouteNodes() {
 		success, found := self.attendance[r.Id]
 		if found && success {
 			cleanups[r.Id] = struct{}{}
@@ -239,6 +241,9 @@ type RouteStatus struct {
 	Err       string
 	PeerData  xt.PeerData
 	ErrorCode *byte
+
+	// DialDuration is how long the router took to dial the terminator, if it was reported
+	DialDuration time.Duration
 }
 
 type routeTimeoutError struct {
@@ -153,6 +153,7 @@ func (c *Controller) GetMgmtHandlers() []channel.TypedReceiveHandler {
 	return []channel.TypedReceiveHandler{
 		handler_edge_mgmt.NewInitEdgeHandler(c.AppEnv),
 		handler_edge_mgmt.NewValidateRouterDataModelHandler(c.AppEnv),
+		handler_edge_mgmt.NewSimulatePoliciesHandler(c.AppEnv),
 	}
 }
 
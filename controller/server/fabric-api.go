@@ -13,6 +13,7 @@ import (
 	"github.com/openziti/ziti/controller/apierror"
 	"github.com/openziti/foundation/v2/errorz"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -38,7 +39,7 @@ func (self *fabricWrapper) WrapRequest(handler api_impl.RequestHandler, request
 			return
 		}
 
-		if !permissions.IsAdmin().IsAllowed(rc.ActivePermissions...) {
+		if !isFabricRequestAllowed(request, rc.ActivePermissions) {
 			rc.RespondWithApiError(errorz.NewUnauthorized())
 			return
 		}
@@ -47,6 +48,28 @@ func (self *fabricWrapper) WrapRequest(handler api_impl.RequestHandler, request
 	})
 }
 
+// isFabricRequestAllowed checks the request against the entity type in its path, e.g. 'services' for
+// /fabric/v1/services/<id>. Inspections don't change anything, so they only require read access.
+func isFabricRequestAllowed(request *http.Request, activePermissions []string) bool {
+	path := strings.TrimPrefix(request.URL.Path, api_impl.FabricRestApiRootPath)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 {
+		return permissions.IsAdmin().IsAllowed(activePermissions...)
+	}
+	entityType := segments[1]
+
+	if request.Method == http.MethodGet || entityType == api_impl.EntityNameInspect {
+		return permissions.CanRead(entityType).IsAllowed(activePermissions...)
+	}
+
+	// fabric services have no role attributes, so service operators limited to role attributes can't modify them here
+	if _, unrestricted := permissions.GetServiceOperatorScope(activePermissions...); !unrestricted {
+		return false
+	}
+
+	return permissions.CanWrite(entityType).IsAllowed(activePermissions...)
+}
+
 func (self *fabricWrapper) WrapHttpHandler(handler http.Handler) http.Handler {
 	wrapped := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		rw.Header().Set(ZitiInstanceId, self.ae.InstanceId)
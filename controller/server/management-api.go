@@ -111,7 +111,9 @@ func NewManagementApiHandler(ae *env.AppEnv, options map[interface{}]interface{}
 	return managementApi, nil
 }
 func (managementApi ManagementApiHandler) newHandler(ae *env.AppEnv) http.Handler {
-	innerManagementHandler := ae.ManagementApi.Serve(nil)
+	innerManagementHandler := ae.ManagementApi.Serve(func(next http.Handler) http.Handler {
+		return env.WrapManagementApiOperations(ae, next)
+	})
 
 	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		rw.Header().Set(ZitiInstanceId, ae.InstanceId)
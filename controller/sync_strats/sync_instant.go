@@ -1447,6 +1447,22 @@ func newServicePolicy(storeModel *db.ServicePolicy) *edge_ctrl_pb.DataState_Serv
 		PolicyType: edge_ctrl_pb.PolicyType(storeModel.PolicyType.Id()),
 	}
 
+	if storeModel.ValidFrom != nil {
+		servicePolicy.ValidFrom = timestamppb.New(*storeModel.ValidFrom)
+	}
+
+	if storeModel.ValidUntil != nil {
+		servicePolicy.ValidUntil = timestamppb.New(*storeModel.ValidUntil)
+	}
+
+	for _, schedule := range storeModel.Schedules {
+		servicePolicy.Schedules = append(servicePolicy.Schedules, &edge_ctrl_pb.DataState_ServicePolicy_ScheduleWindow{
+			Cron:            schedule.Cron,
+			DurationSeconds: int64(schedule.Duration / time.Second),
+			Timezone:        schedule.Timezone,
+		})
+	}
+
 	return servicePolicy
 }
 
@@ -1580,6 +1596,15 @@ func newPostureCheck(postureModel *db.PostureCheck) *edge_ctrl_pb.DataState_Post
 				Domains: subType.Domains,
 			},
 		}
+	case *db.PostureCheckSourceIp:
+		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_SourceIp_{
+			SourceIp: &edge_ctrl_pb.DataState_PostureCheck_SourceIp{
+				AllowedCidrs:     subType.AllowedCidrs,
+				DeniedCidrs:      subType.DeniedCidrs,
+				AllowedCountries: subType.AllowedCountries,
+				DeniedCountries:  subType.DeniedCountries,
+			},
+		}
 	case *db.PostureCheckMacAddresses:
 		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_Mac_{
 			Mac: &edge_ctrl_pb.DataState_PostureCheck_Mac{
@@ -16,6 +16,8 @@
 
 package xt
 
+import "time"
+
 func NewStrategyChangeEvent(serviceId string, current, added, changed, removed []Terminator) StrategyChangeEvent {
 	return &strategyChangeEvent{
 		serviceId: serviceId,
@@ -72,6 +74,17 @@ func NewDialSucceeded(terminator Terminator) TerminatorEvent {
 	}
 }
 
+func NewDialSucceededWithLatency(terminator Terminator, dialDuration, setupDuration time.Duration) TerminatorEvent {
+	return &latencyEvent{
+		defaultEvent: defaultEvent{
+			terminator: terminator,
+			eventType:  eventTypeSucceeded,
+		},
+		dialDuration:  dialDuration,
+		setupDuration: setupDuration,
+	}
+}
+
 func NewCircuitRemoved(terminator Terminator) TerminatorEvent {
 	return &defaultEvent{
 		terminator: terminator,
@@ -106,6 +119,26 @@ func (event *defaultEvent) Accept(visitor EventVisitor) {
 	}
 }
 
+var _ LatencyEvent = (*latencyEvent)(nil)
+
+type latencyEvent struct {
+	defaultEvent
+	dialDuration  time.Duration
+	setupDuration time.Duration
+}
+
+func (event *latencyEvent) GetDialDuration() time.Duration {
+	return event.dialDuration
+}
+
+func (event *latencyEvent) GetSetupDuration() time.Duration {
+	return event.setupDuration
+}
+
+func (event *latencyEvent) Accept(visitor EventVisitor) {
+	visitor.VisitDialSucceeded(event)
+}
+
 var _ EventVisitor = DefaultEventVisitor{}
 
 type DefaultEventVisitor struct{}
@@ -68,6 +68,12 @@ type CreateCircuitParams interface {
 	GetLogContext() logcontext.Context
 }
 
+// IdentityCircuitParams is implemented by CreateCircuitParams which know the id of the identity dialing the service
+type IdentityCircuitParams interface {
+	CreateCircuitParams
+	GetIdentityId() string
+}
+
 type Strategy interface {
 	Select(param CreateCircuitParams, terminators []CostedTerminator) (CostedTerminator, PeerData, error)
 	HandleTerminatorChange(event StrategyChangeEvent) error
@@ -89,6 +95,15 @@ type TerminatorEvent interface {
 	Accept(visitor EventVisitor)
 }
 
+// LatencyEvent is implemented by dial succeeded events which carry timing information. The dial duration is how long
+// the hosting router took to dial the terminator, as reported by that router, and will be zero if the router didn't
+// report it. The setup duration is how long the controller waited for the terminator router to respond to the route.
+type LatencyEvent interface {
+	TerminatorEvent
+	GetDialDuration() time.Duration
+	GetSetupDuration() time.Duration
+}
+
 type EventVisitor interface {
 	VisitDialFailed(event TerminatorEvent)
 	VisitDialSucceeded(event TerminatorEvent)
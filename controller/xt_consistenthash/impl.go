@@ -0,0 +1,272 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_consistenthash
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/openziti/sdk-golang/ziti/edge"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Name is the strategy which hashes the dialing identity id
+	Name = "consistent-hash"
+
+	// AppDataName is the strategy which hashes the HashKeyAppDataKey field of the dial app data
+	AppDataName = "consistent-hash-app-data"
+
+	// SourceAddressName is the strategy which hashes the SourceIpAppDataKey field of the dial app data, as set by
+	// intercepting tunnelers
+	SourceAddressName = "consistent-hash-source-address"
+
+	HashKeyAppDataKey   = "hash_key"
+	SourceIpAppDataKey  = "src_ip"
+	virtualNodesPerTerm = 64
+)
+
+/**
+The consistent hash strategies map a key taken from the dial onto a hash ring of the available terminators, so the same
+key keeps landing on the same terminator without any cooperation from the client. When terminators come and go, only
+the keys which hashed to the affected part of the ring move. This gives stateful backends, like caches or game servers,
+affinity without having to pass stickiness tokens around.
+
+There are three variants, which differ only in where the key comes from:
+  - consistent-hash: the id of the dialing identity
+  - consistent-hash-app-data: the hash_key field of the dial app data
+  - consistent-hash-source-address: the src_ip field of the dial app data, which intercepting tunnelers set
+
+If the key isn't available for a dial, the dialing identity id is used, and failing that the client token.
+
+Only terminators with the best available precedence are placed on the ring. Costs are adjusted for open circuits and
+dial failures in the same way as smart routing, so failing terminators will drop out of the ring when their precedence
+changes, but cost otherwise doesn't influence selection.
+*/
+
+type keySource int
+
+const (
+	identityKeySource keySource = iota
+	appDataKeySource
+	sourceAddressKeySource
+)
+
+func NewFactory() xt.Factory {
+	return &factory{name: Name, keySource: identityKeySource}
+}
+
+func NewAppDataFactory() xt.Factory {
+	return &factory{name: AppDataName, keySource: appDataKeySource}
+}
+
+func NewSourceAddressFactory() xt.Factory {
+	return &factory{name: SourceAddressName, keySource: sourceAddressKeySource}
+}
+
+type factory struct {
+	name      string
+	keySource keySource
+}
+
+func (self *factory) GetStrategyName() string {
+	return self.name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+			CircuitCost:  2,
+		},
+		keySource: self.keySource,
+		rings:     cmap.New[*hashRing](),
+	}
+	strategy.CostVisitor.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	keySource keySource
+	rings     cmap.ConcurrentMap[string, *hashRing]
+}
+
+func (self *strategy) Select(params xt.CreateCircuitParams, terminators []xt.CostedTerminator) (xt.CostedTerminator, xt.PeerData, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil, nil
+	}
+
+	key := self.getKey(params)
+	if key == "" {
+		return terminators[0], nil, nil
+	}
+
+	ring := self.getRing(params.GetServiceId(), terminators)
+	terminatorId := ring.get(key)
+	for _, t := range terminators {
+		if t.GetId() == terminatorId {
+			return t, nil, nil
+		}
+	}
+
+	return terminators[0], nil, nil
+}
+
+func (self *strategy) getKey(params xt.CreateCircuitParams) string {
+	if self.keySource != identityKeySource {
+		if key := self.getAppDataKey(params); key != "" {
+			return key
+		}
+	}
+
+	if identityParams, ok := params.(xt.IdentityCircuitParams); ok {
+		if identityId := identityParams.GetIdentityId(); identityId != "" {
+			return identityId
+		}
+	}
+
+	if clientId := params.GetClientId(); clientId != nil {
+		return clientId.Token
+	}
+
+	return ""
+}
+
+func (self *strategy) getAppDataKey(params xt.CreateCircuitParams) string {
+	clientId := params.GetClientId()
+	if clientId == nil {
+		return ""
+	}
+
+	appDataJson, found := clientId.Data[edge.AppDataHeader]
+	if !found || len(appDataJson) == 0 {
+		return ""
+	}
+
+	appData := map[string]interface{}{}
+	if err := json.Unmarshal(appDataJson, &appData); err != nil {
+		return ""
+	}
+
+	field := HashKeyAppDataKey
+	if self.keySource == sourceAddressKeySource {
+		field = SourceIpAppDataKey
+	}
+
+	if val, ok := appData[field].(string); ok {
+		return val
+	}
+	return ""
+}
+
+// getRing returns the ring for the given service and set of terminators, building a new one if the set of terminators
+// has changed since the last selection
+func (self *strategy) getRing(serviceId string, terminators []xt.CostedTerminator) *hashRing {
+	ids := make([]string, 0, len(terminators))
+	for _, t := range terminators {
+		ids = append(ids, t.GetId())
+	}
+	sort.Strings(ids)
+	members := strings.Join(ids, ",")
+
+	if ring, found := self.rings.Get(serviceId); found && ring.members == members {
+		return ring
+	}
+
+	ring := newHashRing(members, ids)
+	self.rings.Set(serviceId, ring)
+	return ring
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+	}
+	if len(event.GetCurrent()) == 0 {
+		self.rings.Remove(event.GetServiceId())
+	}
+	return nil
+}
+
+type ringPoint struct {
+	hash         uint64
+	terminatorId string
+}
+
+type hashRing struct {
+	members string
+	points  []ringPoint
+}
+
+func newHashRing(members string, terminatorIds []string) *hashRing {
+	ring := &hashRing{
+		members: members,
+		points:  make([]ringPoint, 0, len(terminatorIds)*virtualNodesPerTerm),
+	}
+
+	for _, terminatorId := range terminatorIds {
+		for i := 0; i < virtualNodesPerTerm; i++ {
+			ring.points = append(ring.points, ringPoint{
+				hash:         hashKey(terminatorId + "#" + strconv.Itoa(i)),
+				terminatorId: terminatorId,
+			})
+		}
+	}
+
+	sort.Slice(ring.points, func(i, j int) bool {
+		if ring.points[i].hash == ring.points[j].hash {
+			return ring.points[i].terminatorId < ring.points[j].terminatorId
+		}
+		return ring.points[i].hash < ring.points[j].hash
+	})
+
+	return ring
+}
+
+// get returns the terminator owning the first point on the ring at or after the hash of the key
+func (self *hashRing) get(key string) string {
+	if len(self.points) == 0 {
+		return ""
+	}
+
+	h := hashKey(key)
+	idx := sort.Search(len(self.points), func(i int) bool {
+		return self.points[i].hash >= h
+	})
+	if idx == len(self.points) {
+		idx = 0
+	}
+	return self.points[idx].terminatorId
+}
+
+func hashKey(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,158 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_consistenthash
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openziti/identity"
+	"github.com/openziti/sdk-golang/ziti/edge"
+	"github.com/openziti/ziti/common/logcontext"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+type testCircuitParams struct {
+	identityId string
+	appData    string
+}
+
+func (self *testCircuitParams) GetServiceId() string {
+	return "svc"
+}
+
+func (self *testCircuitParams) GetClientId() *identity.TokenId {
+	result := &identity.TokenId{Token: "session-" + self.identityId, Data: map[uint32][]byte{}}
+	if self.appData != "" {
+		result.Data[edge.AppDataHeader] = []byte(self.appData)
+	}
+	return result
+}
+
+func (self *testCircuitParams) GetLogContext() logcontext.Context {
+	return logcontext.NewContext()
+}
+
+func (self *testCircuitParams) GetIdentityId() string {
+	return self.identityId
+}
+
+func newTestTerminators(count int) []xt.CostedTerminator {
+	var result []xt.CostedTerminator
+	for i := 0; i < count; i++ {
+		result = append(result, &model.RoutingTerminator{
+			RouteCost: uint32(i),
+			Terminator: &model.Terminator{
+				BaseEntity: models.BaseEntity{Id: fmt.Sprintf("t%d", i)},
+				Precedence: xt.Precedences.Default,
+			},
+		})
+	}
+	return result
+}
+
+func selectId(req *require.Assertions, s xt.Strategy, params xt.CreateCircuitParams, terminators []xt.CostedTerminator) string {
+	selected, peerData, err := s.Select(params, terminators)
+	req.NoError(err)
+	req.Nil(peerData)
+	return selected.GetId()
+}
+
+func Test_ConsistentHash_IdentityAffinity(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	terminators := newTestTerminators(5)
+
+	assignments := map[string]string{}
+	used := map[string]struct{}{}
+	for i := 0; i < 200; i++ {
+		identityId := fmt.Sprintf("identity-%d", i)
+		params := &testCircuitParams{identityId: identityId}
+		assignments[identityId] = selectId(req, s, params, terminators)
+		used[assignments[identityId]] = struct{}{}
+
+		// the same key always maps to the same terminator, regardless of terminator order
+		reversed := []xt.CostedTerminator{terminators[4], terminators[3], terminators[2], terminators[1], terminators[0]}
+		req.Equal(assignments[identityId], selectId(req, s, params, reversed))
+	}
+	req.Len(used, 5)
+
+	// removing a terminator should only move the keys which were assigned to it
+	remaining := append([]xt.CostedTerminator{}, terminators[:2]...)
+	remaining = append(remaining, terminators[3:]...)
+	for identityId, terminatorId := range assignments {
+		selected := selectId(req, s, &testCircuitParams{identityId: identityId}, remaining)
+		if terminatorId != "t2" {
+			req.Equal(terminatorId, selected)
+		} else {
+			req.NotEqual("t2", selected)
+		}
+	}
+
+	// adding a terminator should only move keys onto the new terminator
+	added := append(newTestTerminators(5), newTestTerminators(6)[5])
+	for identityId, terminatorId := range assignments {
+		selected := selectId(req, s, &testCircuitParams{identityId: identityId}, added)
+		if selected != "t5" {
+			req.Equal(terminatorId, selected)
+		}
+	}
+}
+
+func Test_ConsistentHash_AppDataKeys(t *testing.T) {
+	req := require.New(t)
+
+	terminators := newTestTerminators(8)
+	appDataStrategy := NewAppDataFactory().NewStrategy()
+	sourceStrategy := NewSourceAddressFactory().NewStrategy()
+	identityStrategy := NewFactory().NewStrategy()
+
+	// with the same hash key, different identities land on the same terminator
+	expected := selectId(req, appDataStrategy, &testCircuitParams{identityId: "a", appData: `{"hash_key": "user-1"}`}, terminators)
+	for i := 0; i < 20; i++ {
+		params := &testCircuitParams{identityId: fmt.Sprintf("identity-%d", i), appData: `{"hash_key": "user-1"}`}
+		req.Equal(expected, selectId(req, appDataStrategy, params, terminators))
+	}
+
+	expected = selectId(req, sourceStrategy, &testCircuitParams{identityId: "a", appData: `{"src_ip": "10.0.0.1"}`}, terminators)
+	for i := 0; i < 20; i++ {
+		params := &testCircuitParams{identityId: fmt.Sprintf("identity-%d", i), appData: `{"src_ip": "10.0.0.1", "src_port": "1234"}`}
+		req.Equal(expected, selectId(req, sourceStrategy, params, terminators))
+	}
+
+	// without the app data key, fall back to the identity
+	params := &testCircuitParams{identityId: "identity-1", appData: `{"dst_ip": "10.0.0.1"}`}
+	req.Equal(selectId(req, identityStrategy, params, terminators), selectId(req, sourceStrategy, params, terminators))
+	params.appData = "not json"
+	req.Equal(selectId(req, identityStrategy, params, terminators), selectId(req, appDataStrategy, params, terminators))
+}
+
+func Test_ConsistentHash_Precedence(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	terminators := newTestTerminators(3)
+	terminators[0].(*model.RoutingTerminator).Precedence = xt.Precedences.Required
+
+	for i := 0; i < 20; i++ {
+		req.Equal("t0", selectId(req, s, &testCircuitParams{identityId: fmt.Sprintf("identity-%d", i)}, terminators))
+	}
+}
@@ -0,0 +1,127 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_leastconnections
+
+import (
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"math"
+	"time"
+)
+
+const (
+	Name = "least-connections"
+)
+
+/**
+The least connections strategy tracks how many circuits are currently open to each terminator and selects the
+terminator with the fewest, weighted by terminator cost. Each terminator is scored as (open circuits + 1) * cost, so a
+terminator with twice the cost of another should end up with roughly half as many circuits. Only terminators with the
+best available precedence are considered.
+
+Circuits are counted when the hosting router reports a successful dial and released when the circuit is removed. This
+works well for backends where requests have very uneven durations, since hosts stuck with long-running requests stop
+getting new circuits until they catch up. Dial failures raise the terminator cost in the same way as smart routing.
+Open circuits aren't added to the cost, as they're already accounted for in the score.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+		},
+		circuits: cmap.New[int64](),
+	}
+	strategy.CostVisitor.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	circuits cmap.ConcurrentMap[string, int64]
+}
+
+func (self *strategy) Select(_ xt.CreateCircuitParams, terminators []xt.CostedTerminator) (xt.CostedTerminator, xt.PeerData, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil, nil
+	}
+
+	var selected xt.CostedTerminator
+	var selectedScore float64
+	for _, t := range terminators {
+		score := self.score(t)
+		if selected == nil || score < selectedScore {
+			selected = t
+			selectedScore = score
+		}
+	}
+
+	return selected, nil, nil
+}
+
+func (self *strategy) score(t xt.CostedTerminator) float64 {
+	cost := float64(t.GetPrecedence().Unbias(t.GetRouteCost()))
+	if cost == 0 {
+		cost = 1
+	}
+	circuits, _ := self.circuits.Get(t.GetId())
+	return float64(circuits+1) * cost
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(self)
+}
+
+func (self *strategy) VisitDialSucceeded(event xt.TerminatorEvent) {
+	self.CostVisitor.VisitDialSucceeded(event)
+	self.circuits.Upsert(event.GetTerminator().GetId(), 1, func(exist bool, valueInMap int64, newValue int64) int64 {
+		if exist {
+			return valueInMap + newValue
+		}
+		return newValue
+	})
+}
+
+func (self *strategy) VisitCircuitRemoved(event xt.TerminatorEvent) {
+	self.CostVisitor.VisitCircuitRemoved(event)
+	self.circuits.Upsert(event.GetTerminator().GetId(), 0, func(exist bool, valueInMap int64, _ int64) int64 {
+		if exist && valueInMap > 0 {
+			return valueInMap - 1
+		}
+		return 0
+	})
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+		self.circuits.Remove(t.GetId())
+	}
+	return nil
+}
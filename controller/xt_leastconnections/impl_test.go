@@ -0,0 +1,88 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_leastconnections
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTerminator(id string, cost uint32, precedence xt.Precedence) *model.RoutingTerminator {
+	return &model.RoutingTerminator{
+		RouteCost: precedence.GetBiasedCost(cost),
+		Terminator: &model.Terminator{
+			BaseEntity: models.BaseEntity{Id: id},
+			Precedence: precedence,
+		},
+	}
+}
+
+func Test_LeastConnections_Select(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(*strategy)
+	t1 := newTestTerminator("t1", 10, xt.Precedences.Default)
+	t2 := newTestTerminator("t2", 10, xt.Precedences.Default)
+	t3 := newTestTerminator("t3", 20, xt.Precedences.Default)
+	terminators := []xt.CostedTerminator{t1, t2, t3}
+
+	selectAndDial := func() xt.CostedTerminator {
+		selected, _, err := s.Select(nil, terminators)
+		req.NoError(err)
+		s.NotifyEvent(xt.NewDialSucceeded(selected))
+		return selected
+	}
+
+	req.Equal("t1", selectAndDial().GetId())
+	req.Equal("t2", selectAndDial().GetId())
+
+	// t3 costs twice as much, so it only wins once the others have twice as many circuits
+	req.Equal("t1", selectAndDial().GetId())
+	req.Equal("t2", selectAndDial().GetId())
+	req.Equal("t3", selectAndDial().GetId())
+
+	// closing circuits on t1 makes it the best choice again
+	s.NotifyEvent(xt.NewCircuitRemoved(t1))
+	s.NotifyEvent(xt.NewCircuitRemoved(t1))
+	s.NotifyEvent(xt.NewCircuitRemoved(t1))
+	count, _ := s.circuits.Get("t1")
+	req.Equal(int64(0), count)
+	req.Equal("t1", selectAndDial().GetId())
+
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, nil, nil, xt.TList(t1))))
+	req.False(s.circuits.Has("t1"))
+}
+
+func Test_LeastConnections_Precedence(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(*strategy)
+	required := newTestTerminator("required", 10, xt.Precedences.Required)
+	other := newTestTerminator("default", 1, xt.Precedences.Default)
+
+	for i := 0; i < 5; i++ {
+		s.NotifyEvent(xt.NewDialSucceeded(required))
+	}
+
+	selected, _, err := s.Select(nil, []xt.CostedTerminator{required, other})
+	req.NoError(err)
+	req.Equal("required", selected.GetId())
+}
@@ -0,0 +1,196 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_lowestlatency
+
+import (
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	Name = "lowest-latency"
+
+	// weight given to each new latency sample when updating the moving averages
+	ewmaAlpha = 0.3
+
+	// latency cost is expressed in milliseconds, the same unit link latency contributes to path costs
+	maxLatencyCost = math.MaxUint16 / 4
+)
+
+/**
+The lowest latency strategy keeps an exponentially weighted moving average of how long each terminator takes to dial,
+as reported by the hosting router, and of how long circuit setup to that terminator takes, as seen by the controller.
+The averages are added to the terminator's dynamic cost, in milliseconds, so the terminator with the best combined
+path and terminator latency ends up first in the list and is selected. Setup time includes the dial plus the control
+plane round trip to the hosting router, so it's given half the weight of the dial latency.
+
+Like smart routing, it also adds cost for each open circuit and for dial failures. Latency averages decay over time, so
+terminators which were slow at some point will eventually be tried again and get fresh measurements.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+			CircuitCost:  2,
+		},
+		latencies: cmap.New[*terminatorLatency](),
+	}
+	strategy.CostVisitor.FailureCosts.CreditOverTime(5, time.Minute)
+	strategy.decayOverTime(0.8, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	latencies cmap.ConcurrentMap[string, *terminatorLatency]
+}
+
+func (self *strategy) Select(_ xt.CreateCircuitParams, terminators []xt.CostedTerminator) (xt.CostedTerminator, xt.PeerData, error) {
+	return terminators[0], nil, nil
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(self)
+}
+
+func (self *strategy) VisitDialSucceeded(event xt.TerminatorEvent) {
+	self.CostVisitor.VisitDialSucceeded(event)
+	if latencyEvent, ok := event.(xt.LatencyEvent); ok {
+		self.recordLatency(latencyEvent)
+	}
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+		self.latencies.Remove(t.GetId())
+	}
+	return nil
+}
+
+func (self *strategy) getLatency(terminatorId string) *terminatorLatency {
+	return self.latencies.Upsert(terminatorId, nil, func(exist bool, valueInMap *terminatorLatency, _ *terminatorLatency) *terminatorLatency {
+		if exist {
+			return valueInMap
+		}
+		return &terminatorLatency{}
+	})
+}
+
+func (self *strategy) recordLatency(event xt.LatencyEvent) {
+	terminatorId := event.GetTerminator().GetId()
+	latency := self.getLatency(terminatorId)
+
+	latency.Lock()
+	defer latency.Unlock()
+
+	if dialDuration := event.GetDialDuration(); dialDuration > 0 {
+		latency.dial.add(float64(dialDuration))
+	}
+
+	if setupDuration := event.GetSetupDuration(); setupDuration > 0 {
+		latency.setup.add(float64(setupDuration))
+	}
+
+	latency.updateCost(terminatorId)
+}
+
+func (self *strategy) decayOverTime(factor float64, period time.Duration) *time.Ticker {
+	ticker := time.NewTicker(period)
+	go func() {
+		for range ticker.C {
+			self.latencies.IterCb(func(terminatorId string, latency *terminatorLatency) {
+				latency.Lock()
+				defer latency.Unlock()
+
+				latency.dial.value *= factor
+				latency.setup.value *= factor
+				latency.updateCost(terminatorId)
+			})
+		}
+	}()
+	return ticker
+}
+
+type terminatorLatency struct {
+	sync.Mutex
+	dial  ewma
+	setup ewma
+
+	// the portion of the terminator's dynamic cost currently contributed by latency
+	cost uint16
+}
+
+func (self *terminatorLatency) getCost() uint16 {
+	millis := (self.dial.value + self.setup.value/2) / float64(time.Millisecond)
+	if millis >= maxLatencyCost {
+		return maxLatencyCost
+	}
+	return uint16(math.Round(millis))
+}
+
+// updateCost replaces the previous latency contribution to the dynamic cost with the current one, leaving whatever
+// circuit and failure costs have been applied in place. Must be called with the lock held.
+func (self *terminatorLatency) updateCost(terminatorId string) {
+	oldCost := self.cost
+	newCost := self.getCost()
+	if oldCost == newCost {
+		return
+	}
+	self.cost = newCost
+
+	xt.GlobalCosts().UpdateDynamicCost(terminatorId, func(cost uint16) uint16 {
+		if cost < oldCost {
+			cost = 0
+		} else {
+			cost -= oldCost
+		}
+		if cost > math.MaxUint16-newCost {
+			return math.MaxUint16
+		}
+		return cost + newCost
+	})
+}
+
+type ewma struct {
+	value       float64
+	initialized bool
+}
+
+func (self *ewma) add(sample float64) {
+	if !self.initialized {
+		self.value = sample
+		self.initialized = true
+		return
+	}
+	self.value = ewmaAlpha*sample + (1-ewmaAlpha)*self.value
+}
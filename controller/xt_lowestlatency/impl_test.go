@@ -0,0 +1,80 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_lowestlatency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/xt"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTerminator(id string) *model.Terminator {
+	xt.GlobalCosts().ClearCost(id)
+	return &model.Terminator{BaseEntity: models.BaseEntity{Id: id}}
+}
+
+func Test_LowestLatency_Costs(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(*strategy)
+	fast := newTestTerminator("lowest-latency-fast")
+	slow := newTestTerminator("lowest-latency-slow")
+
+	s.NotifyEvent(xt.NewDialSucceededWithLatency(fast, 10*time.Millisecond, 20*time.Millisecond))
+	s.NotifyEvent(xt.NewDialSucceededWithLatency(slow, 200*time.Millisecond, 220*time.Millisecond))
+
+	// circuit cost plus dial latency plus half the setup time
+	req.Equal(uint16(2+10+10), xt.GlobalCosts().GetDynamicCost(fast.Id))
+	req.Equal(uint16(2+200+110), xt.GlobalCosts().GetDynamicCost(slow.Id))
+
+	// new samples move the average, replacing the previous latency contribution
+	s.NotifyEvent(xt.NewCircuitRemoved(slow))
+	s.NotifyEvent(xt.NewDialSucceededWithLatency(slow, 100*time.Millisecond, 120*time.Millisecond))
+	req.Equal(uint16(2+170+95), xt.GlobalCosts().GetDynamicCost(slow.Id))
+
+	// events without latency information only affect circuit costs
+	s.NotifyEvent(xt.NewDialSucceeded(fast))
+	req.Equal(uint16(4+10+10), xt.GlobalCosts().GetDynamicCost(fast.Id))
+
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, nil, nil, xt.TList(fast, slow))))
+	req.False(s.latencies.Has(fast.Id))
+	req.False(s.latencies.Has(slow.Id))
+}
+
+func Test_LowestLatency_Decay(t *testing.T) {
+	req := require.New(t)
+
+	s := &strategy{latencies: cmap.New[*terminatorLatency]()}
+	terminator := newTestTerminator("lowest-latency-decay")
+
+	latency := s.getLatency(terminator.Id)
+	latency.dial.add(float64(100 * time.Millisecond))
+	latency.updateCost(terminator.Id)
+	req.Equal(uint16(100), xt.GlobalCosts().GetDynamicCost(terminator.Id))
+
+	ticker := s.decayOverTime(0.5, 10*time.Millisecond)
+	defer ticker.Stop()
+
+	req.Eventually(func() bool {
+		return xt.GlobalCosts().GetDynamicCost(terminator.Id) < 10
+	}, time.Second, 10*time.Millisecond)
+}
@@ -49,6 +49,13 @@ type Destination interface {
 	InspectCircuit(detail *inspect.CircuitInspectDetail)
 }
 
+// PriorityDestination is implemented by destinations, such as links, which schedule what they send using the priority
+// class of the circuit
+type PriorityDestination interface {
+	SendPayloadWithPriority(payload *xgress.Payload, priority ctrl_pb.PriorityClass) error
+	SendAcknowledgementWithPriority(acknowledgement *xgress.Acknowledgement, priority ctrl_pb.PriorityClass) error
+}
+
 type XgressDestination interface {
 	Destination
 	Unrouted()
@@ -135,6 +142,7 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 	} else {
 		circuitFt = newForwardTable(ctrlId)
 	}
+	sources := map[string]struct{}{}
 	for _, forward := range route.Forwards {
 		if !forwarder.HasDestination(xgress.Address(forward.DstAddress)) {
 			if forward.DstType == ctrl_pb.DestType_Link {
@@ -146,8 +154,16 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 			}
 			// It's an ingress destination, which isn't established until after routing has completed
 		}
-		circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		// the first forward for a source is the primary destination, any later one is the multipath alternate
+		if _, found := sources[forward.SrcAddress]; found {
+			circuitFt.setAlternateAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		} else {
+			sources[forward.SrcAddress] = struct{}{}
+			circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		}
 	}
+	circuitFt.setMultipathMode(route.Multipath)
+	circuitFt.setPriority(route.Priority)
 	forwarder.circuits.setForwardTable(circuitId, circuitFt)
 	return nil
 }
@@ -178,19 +194,36 @@ func (forwarder *Forwarder) forwardPayload(srcAddr xgress.Address, payload *xgre
 
 	circuitId := payload.GetCircuitId()
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, markActive); found {
-		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
+		dstAddrs := forwardTable.getForwardAddresses(srcAddr)
+		if len(dstAddrs) == 0 {
+			return errors.Errorf("cannot forward payload, no destination address for circuit=%v src=%v", circuitId, srcAddr)
+		}
+
+		// in duplicate mode payloads go down every path, otherwise they go to the first destination which accepts them
+		duplicate := forwardTable.getMultipathMode() == ctrl_pb.MultipathMode_MultipathDuplicate
+		priority := forwardTable.getPriority()
+		sent := false
+		var errs errorz.MultipleErrors
+		for _, dstAddr := range dstAddrs {
 			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
-				if err := dst.SendPayload(payload); err != nil {
-					return err
+				if err := sendPayload(dst, payload, priority); err != nil {
+					errs = append(errs, err)
+					continue
 				}
 				log.WithFields(payload.GetLoggerFields()).Debugf("=> %s", string(dstAddr))
-				return nil
+				if !duplicate {
+					return nil
+				}
+				sent = true
 			} else {
-				return errors.Errorf("cannot forward payload, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr)
+				errs = append(errs, errors.Errorf("cannot forward payload, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr))
 			}
-		} else {
-			return errors.Errorf("cannot forward payload, no destination address for circuit=%v src=%v", circuitId, srcAddr)
 		}
+
+		if sent {
+			return nil
+		}
+		return errs.ToError()
 	} else {
 		return errors.Errorf("cannot forward payload, no forward table for circuit=%v src=%v", circuitId, srcAddr)
 	}
@@ -201,22 +234,26 @@ func (forwarder *Forwarder) ForwardAcknowledgement(srcAddr xgress.Address, ackno
 
 	circuitId := acknowledgement.CircuitId
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, true); found {
-		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
+		dstAddrs := forwardTable.getForwardAddresses(srcAddr)
+		if len(dstAddrs) == 0 {
+			return errors.Errorf("cannot acknowledge, no destination address for circuit=%v src=%v", circuitId, srcAddr)
+		}
+
+		priority := forwardTable.getPriority()
+		var errs errorz.MultipleErrors
+		for _, dstAddr := range dstAddrs {
 			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
-				if err := dst.SendAcknowledgement(acknowledgement); err != nil {
-					return err
+				if err := sendAcknowledgement(dst, acknowledgement, priority); err != nil {
+					errs = append(errs, err)
+					continue
 				}
 				log.Debugf("=> %s", string(dstAddr))
 				return nil
-
 			} else {
-				return errors.Errorf("cannot acknowledge, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr)
+				errs = append(errs, errors.Errorf("cannot acknowledge, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr))
 			}
-
-		} else {
-			return errors.Errorf("cannot acknowledge, no destination address for circuit=%v src=%v", circuitId, srcAddr)
 		}
-
+		return errs.ToError()
 	} else {
 		return errors.Errorf("cannot acknowledge, no forward table for circuit=%v src=%v", circuitId, srcAddr)
 	}
@@ -229,20 +266,31 @@ func (forwarder *Forwarder) ForwardControl(srcAddr xgress.Address, control *xgre
 	var err error
 
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, true); found {
-		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
-			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
-				if control.IsTypeTraceRoute() {
-					hops := control.DecrementAndGetHop()
-					if hops == 0 {
-						resp := control.CreateTraceResponse("forwarder", forwarder.metricsRegistry.SourceId())
-						return forwarder.ForwardControl(dstAddr, resp)
+		if dstAddrs := forwardTable.getForwardAddresses(srcAddr); len(dstAddrs) > 0 {
+			var errs errorz.MultipleErrors
+			hopDecremented := false
+			for _, dstAddr := range dstAddrs {
+				if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+					if control.IsTypeTraceRoute() && !hopDecremented {
+						hopDecremented = true
+						hops := control.DecrementAndGetHop()
+						if hops == 0 {
+							resp := control.CreateTraceResponse("forwarder", forwarder.metricsRegistry.SourceId())
+							return forwarder.ForwardControl(dstAddr, resp)
+						}
 					}
+					if sendErr := dst.SendControl(control); sendErr != nil {
+						errs = append(errs, sendErr)
+						continue
+					}
+					log.Debugf("=> %s", string(dstAddr))
+					errs = nil
+					break
+				} else {
+					errs = append(errs, errors.Errorf("cannot forward control, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr))
 				}
-				err = dst.SendControl(control)
-				log.Debugf("=> %s", string(dstAddr))
-			} else {
-				err = errors.Errorf("cannot forward control, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr)
 			}
+			err = errs.ToError()
 		} else {
 			err = errors.Errorf("cannot forward control, no destination address for circuit=%v src=%v", circuitId, srcAddr)
 		}
@@ -265,6 +313,20 @@ func (forwarder *Forwarder) ForwardControl(srcAddr xgress.Address, control *xgre
 	return err
 }
 
+func sendPayload(dst Destination, payload *xgress.Payload, priority ctrl_pb.PriorityClass) error {
+	if priorityDst, ok := dst.(PriorityDestination); ok {
+		return priorityDst.SendPayloadWithPriority(payload, priority)
+	}
+	return dst.SendPayload(payload)
+}
+
+func sendAcknowledgement(dst Destination, acknowledgement *xgress.Acknowledgement, priority ctrl_pb.PriorityClass) error {
+	if priorityDst, ok := dst.(PriorityDestination); ok {
+		return priorityDst.SendAcknowledgementWithPriority(acknowledgement, priority)
+	}
+	return dst.SendAcknowledgement(acknowledgement)
+}
+
 func (forwarder *Forwarder) ReportForwardingFault(circuitId string, ctrlId string) {
 	if forwarder.faulter != nil {
 		forwarder.faulter.Report(circuitId, ctrlId)
@@ -324,6 +386,7 @@ func (forwarder *Forwarder) InspectCircuit(circuitId string, getRelatedGoroutine
 	if ft, found := forwarder.circuits.circuits.Get(circuitId); found {
 		result := &inspect.CircuitInspectDetail{
 			CircuitId:     circuitId,
+			Priority:      ft.getPriority().String(),
 			Forwards:      map[string]string{},
 			XgressDetails: map[string]*inspect.XgressDetail{},
 			LinkDetails:   map[string]*inspect.LinkInspectDetail{},
@@ -0,0 +1,197 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type testDestination struct {
+	failed   bool
+	payloads []int32
+	acks     int
+	controls int
+}
+
+func (self *testDestination) SendPayload(payload *xgress.Payload) error {
+	if self.failed {
+		return errors.New("link closed")
+	}
+	self.payloads = append(self.payloads, payload.Sequence)
+	return nil
+}
+
+func (self *testDestination) SendAcknowledgement(*xgress.Acknowledgement) error {
+	if self.failed {
+		return errors.New("link closed")
+	}
+	self.acks++
+	return nil
+}
+
+func (self *testDestination) SendControl(*xgress.Control) error {
+	if self.failed {
+		return errors.New("link closed")
+	}
+	self.controls++
+	return nil
+}
+
+func (self *testDestination) InspectCircuit(*inspect.CircuitInspectDetail) {}
+
+func newTestPayload(sequence int32) *xgress.Payload {
+	return &xgress.Payload{
+		Header:   xgress.Header{CircuitId: "c1"},
+		Sequence: sequence,
+	}
+}
+
+func newMultipathTestForwarder(req *require.Assertions, mode ctrl_pb.MultipathMode) (*Forwarder, *testDestination, *testDestination) {
+	closeNotify := make(chan struct{})
+	forwarder := NewForwarder(nil, nil, nil, closeNotify)
+
+	primary := &testDestination{}
+	alternate := &testDestination{}
+	forwarder.destinations.addDestination("l1", primary)
+	forwarder.destinations.addDestination("l2", alternate)
+
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c1",
+		Multipath: mode,
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l1", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l1", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+			{SrcAddress: "ingress", DstAddress: "l2", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l2", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+	}))
+
+	return forwarder, primary, alternate
+}
+
+func TestForwarder_MultipathDuplicate(t *testing.T) {
+	req := require.New(t)
+
+	forwarder, primary, alternate := newMultipathTestForwarder(req, ctrl_pb.MultipathMode_MultipathDuplicate)
+
+	req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(1)))
+	req.Equal([]int32{1}, primary.payloads)
+	req.Equal([]int32{1}, alternate.payloads)
+
+	// acks and control messages only need to arrive once
+	req.NoError(forwarder.ForwardAcknowledgement("ingress", xgress.NewAcknowledgement("c1", xgress.Initiator)))
+	req.Equal(1, primary.acks)
+	req.Equal(0, alternate.acks)
+
+	// losing one path doesn't interrupt the circuit
+	primary.failed = true
+	req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(2)))
+	req.Equal([]int32{1, 2}, alternate.payloads)
+	req.NoError(forwarder.ForwardControl("ingress", &xgress.Control{CircuitId: "c1"}))
+	req.Equal(1, alternate.controls)
+
+	alternate.failed = true
+	req.Error(forwarder.ForwardPayload("ingress", newTestPayload(3)))
+}
+
+func TestForwarder_MultipathFailover(t *testing.T) {
+	req := require.New(t)
+
+	forwarder, primary, alternate := newMultipathTestForwarder(req, ctrl_pb.MultipathMode_MultipathFailover)
+
+	req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(1)))
+	req.Equal([]int32{1}, primary.payloads)
+	req.Empty(alternate.payloads)
+
+	primary.failed = true
+	req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(2)))
+	req.NoError(forwarder.ForwardAcknowledgement("ingress", xgress.NewAcknowledgement("c1", xgress.Initiator)))
+	req.Equal([]int32{2}, alternate.payloads)
+	req.Equal(1, alternate.acks)
+}
+
+func TestForwarder_MultipathNone(t *testing.T) {
+	req := require.New(t)
+
+	forwarder, primary, alternate := newMultipathTestForwarder(req, ctrl_pb.MultipathMode_MultipathNone)
+
+	primary.failed = true
+	req.Error(forwarder.ForwardPayload("ingress", newTestPayload(1)))
+	req.Empty(alternate.payloads)
+
+	// re-routing without the alternate forward clears it
+	forwarder, primary, alternate = newMultipathTestForwarder(req, ctrl_pb.MultipathMode_MultipathFailover)
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c1",
+		Multipath: ctrl_pb.MultipathMode_MultipathFailover,
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l1", DstType: ctrl_pb.DestType_Link},
+		},
+	}))
+	primary.failed = true
+	req.Error(forwarder.ForwardPayload("ingress", newTestPayload(1)))
+	req.Empty(alternate.payloads)
+}
+
+type testPriorityDestination struct {
+	testDestination
+	priorities []ctrl_pb.PriorityClass
+}
+
+func (self *testPriorityDestination) SendPayloadWithPriority(payload *xgress.Payload, priority ctrl_pb.PriorityClass) error {
+	self.priorities = append(self.priorities, priority)
+	return self.SendPayload(payload)
+}
+
+func (self *testPriorityDestination) SendAcknowledgementWithPriority(ack *xgress.Acknowledgement, priority ctrl_pb.PriorityClass) error {
+	self.priorities = append(self.priorities, priority)
+	return self.SendAcknowledgement(ack)
+}
+
+func TestForwarder_Priority(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	forwarder := NewForwarder(nil, nil, nil, closeNotify)
+
+	link := &testPriorityDestination{}
+	forwarder.destinations.addDestination("l1", link)
+
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c1",
+		Priority:  ctrl_pb.PriorityClass_PriorityInteractive,
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l1", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l1", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+	}))
+
+	req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(1)))
+	req.NoError(forwarder.ForwardAcknowledgement("ingress", xgress.NewAcknowledgement("c1", xgress.Initiator)))
+	req.Equal([]int32{1}, link.payloads)
+	req.Equal(1, link.acks)
+	req.Equal([]ctrl_pb.PriorityClass{ctrl_pb.PriorityClass_PriorityInteractive, ctrl_pb.PriorityClass_PriorityInteractive}, link.priorities)
+
+	req.Equal(ctrl_pb.PriorityClass_PriorityInteractive.String(), forwarder.InspectCircuit("c1", false).Priority)
+}
@@ -18,6 +18,7 @@ package forwarder
 
 import (
 	"fmt"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/router/xgress"
 	"github.com/orcaman/concurrent-map/v2"
 	"reflect"
@@ -65,29 +66,64 @@ func (st *circuitTable) debug() string {
 	return out
 }
 
-// forwardTable implements a directory of destinations, keyed by source address.
+// forwardTable implements a directory of destinations, keyed by source address. Multipath circuits may also have an
+// alternate destination for a source, which is used according to the multipath mode.
 type forwardTable struct {
 	ctrlId       string
 	last         int64
+	multipath    atomic.Int32
+	priority     atomic.Int32
 	destinations cmap.ConcurrentMap[string, string]
+	alternates   cmap.ConcurrentMap[string, string]
 }
 
 func newForwardTable(ctrlId string) *forwardTable {
 	return &forwardTable{
 		ctrlId:       ctrlId,
 		destinations: cmap.New[string](),
+		alternates:   cmap.New[string](),
 	}
 }
 
 func (ft *forwardTable) setForwardAddress(src, dst xgress.Address) {
 	ft.destinations.Set(string(src), string(dst))
+	ft.alternates.Remove(string(src))
 }
 
-func (ft *forwardTable) getForwardAddress(src xgress.Address) (xgress.Address, bool) {
-	if dst, found := ft.destinations.Get(string(src)); found {
-		return xgress.Address(dst), true
+func (ft *forwardTable) setAlternateAddress(src, dst xgress.Address) {
+	ft.alternates.Set(string(src), string(dst))
+}
+
+func (ft *forwardTable) setMultipathMode(mode ctrl_pb.MultipathMode) {
+	ft.multipath.Store(int32(mode))
+}
+
+func (ft *forwardTable) getMultipathMode() ctrl_pb.MultipathMode {
+	return ctrl_pb.MultipathMode(ft.multipath.Load())
+}
+
+func (ft *forwardTable) setPriority(priority ctrl_pb.PriorityClass) {
+	ft.priority.Store(int32(priority))
+}
+
+func (ft *forwardTable) getPriority() ctrl_pb.PriorityClass {
+	return ctrl_pb.PriorityClass(ft.priority.Load())
+}
+
+// getForwardAddresses returns the destination for the source followed by the alternate destination, if there is one
+// and multipath is enabled
+func (ft *forwardTable) getForwardAddresses(src xgress.Address) []xgress.Address {
+	dst, found := ft.destinations.Get(string(src))
+	if !found {
+		return nil
 	}
-	return "", false
+	result := []xgress.Address{xgress.Address(dst)}
+	if ft.getMultipathMode() != ctrl_pb.MultipathMode_MultipathNone {
+		if alternate, found := ft.alternates.Get(string(src)); found {
+			result = append(result, xgress.Address(alternate))
+		}
+	}
+	return result
 }
 
 func (ft *forwardTable) debug() string {
@@ -95,6 +131,9 @@ func (ft *forwardTable) debug() string {
 	for i := range ft.destinations.IterBuffered() {
 		out += fmt.Sprintf("\t\t@/%s -> @/%s\n", i.Key, i.Val)
 	}
+	for i := range ft.alternates.IterBuffered() {
+		out += fmt.Sprintf("\t\t@/%s -> @/%s (%s alternate)\n", i.Key, i.Val, ft.getMultipathMode().String())
+	}
 	return out
 }
 
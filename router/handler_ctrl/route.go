@@ -94,14 +94,14 @@ func (rh *routeHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 		if route.Egress != nil {
 			if rh.forwarder.HasDestination(xgress.Address(route.Egress.Address)) {
 				log.Warnf("destination exists for [%s]", route.Egress.Address)
-				rh.completeRoute(msg, int(route.Attempt), route, nil, log)
+				rh.completeRoute(msg, int(route.Attempt), route, nil, 0, log)
 				return
 			} else {
 				rh.connectEgress(msg, int(route.Attempt), ch, route, ctx, time.Now().Add(time.Duration(route.Timeout)))
 				return
 			}
 		} else {
-			rh.completeRoute(msg, int(route.Attempt), route, nil, log)
+			rh.completeRoute(msg, int(route.Attempt), route, nil, 0, log)
 		}
 	}
 
@@ -113,7 +113,7 @@ func (rh *routeHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 	}
 }
 
-func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *ctrl_pb.Route, peerData xt.PeerData, log *logrus.Entry) {
+func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *ctrl_pb.Route, peerData xt.PeerData, dialDuration time.Duration, log *logrus.Entry) {
 	if err := rh.forwarder.Route(rh.ch.Id(), route); err != nil {
 		rh.fail(msg, attempt, route, err, ctrl_msg.ErrorTypeGeneric, log)
 		return
@@ -126,6 +126,11 @@ func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *
 		response.Headers[int32(k)] = v
 	}
 
+	// report how long the terminator dial took, so latency aware strategies on the controller can use it
+	if dialDuration > 0 {
+		response.PutUint64Header(ctrl_msg.RouteResultDialDurationHeader, uint64(dialDuration))
+	}
+
 	response.ReplyTo(msg)
 
 	log.Debug("sending success response")
@@ -171,8 +176,9 @@ func (rh *routeHandler) connectEgress(msg *channel.Message, attempt int, ch chan
 			}
 
 			params := newDialParams(rh.ch.Id(), route, bindHandler, ctx, deadline)
+			dialStart := time.Now()
 			if peerData, err := dialer.Dial(params); err == nil {
-				rh.completeRoute(msg, attempt, route, peerData, log)
+				rh.completeRoute(msg, attempt, route, peerData, time.Since(dialStart), log)
 			} else {
 				var errCode byte
 
@@ -223,5 +223,22 @@ func (handler *xgressPeekHandler) Tx(x *xgress.Xgress, payload *xgress.Payload)
 	}
 }
 
+// BandwidthLimited records the bytes held back by bandwidth limits as usage, so they're reported in usage events along
+// with the circuit's other traffic
+func (handler *xgressPeekHandler) BandwidthLimited(x *xgress.Xgress, rx bool, size int, _ time.Duration) {
+	usageType := "egress"
+	if x.Originator() == xgress.Initiator {
+		usageType = "ingress"
+	}
+
+	if rx {
+		usageType += ".rx.limited"
+	} else {
+		usageType += ".tx.limited"
+	}
+
+	handler.usageCounter.Update(x, usageType, time.Now(), uint64(size))
+}
+
 func (handler *xgressPeekHandler) Close(*xgress.Xgress) {
 }
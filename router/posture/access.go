@@ -6,6 +6,7 @@ import (
 	"github.com/openziti/ziti/common"
 	"github.com/openziti/ziti/common/eid"
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"time"
 )
 
 func HasAccess(rdm *common.RouterDataModel, identityId string, serviceId string, cache *Cache, policyType edge_ctrl_pb.PolicyType) (*common.ServicePolicy, error) {
@@ -35,6 +36,7 @@ func HasAccess(rdm *common.RouterDataModel, identityId string, serviceId string,
 
 func IsPassing(accessPolicies *common.AccessPolicies, cache *Cache) (*common.ServicePolicy, *PolicyAccessErrors) {
 	errs := &PolicyAccessErrors{}
+	now := time.Now()
 
 	for _, policy := range accessPolicies.Policies {
 		policyErr := &PolicyAccessError{
@@ -43,6 +45,12 @@ func IsPassing(accessPolicies *common.AccessPolicies, cache *Cache) (*common.Ser
 			Errors: []error{},
 		}
 
+		if !policy.IsActiveAt(now) {
+			policyErr.Errors = append(policyErr.Errors, &OutsideScheduleError{When: now})
+			*errs = append(*errs, policyErr)
+			continue
+		}
+
 		for postureCheckId := range policy.PostureChecks {
 			postureCheck, ok := accessPolicies.PostureChecks[postureCheckId]
 
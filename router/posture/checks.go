@@ -62,6 +62,11 @@ func CtrlCheckToLogic(postureCheck *edge_ctrl_pb.DataState_PostureCheck) Check {
 			DataState_PostureCheck:     postureCheck,
 			DataState_PostureCheck_Mfa: subCheck.Mfa,
 		}
+	case *edge_ctrl_pb.DataState_PostureCheck_SourceIp_:
+		return &SourceIpCheck{
+			DataState_PostureCheck:          postureCheck,
+			DataState_PostureCheck_SourceIp: subCheck.SourceIp,
+		}
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
 	"github.com/pkg/errors"
+	"time"
 )
 
 type CheckError struct {
@@ -23,6 +24,16 @@ func (e *NoPoliciesError) Error() string {
 	return "no policies provide access"
 }
 
+// OutsideScheduleError indicates that a policy has validity dates or schedule windows which don't include the
+// time access was evaluated.
+type OutsideScheduleError struct {
+	When time.Time
+}
+
+func (e *OutsideScheduleError) Error() string {
+	return fmt.Sprintf("policy is not in effect at %s, outside its scheduled access windows", e.When.UTC().Format(time.RFC3339))
+}
+
 type PolicyAccessError struct {
 	Id     string
 	Name   string
@@ -0,0 +1,18 @@
+package posture
+
+import (
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+)
+
+// SourceIpCheck is only enforced by the controller, which sees the address clients authenticate and create sessions
+// from. Routers don't receive posture data for SDK connections, so the check always passes here. Sessions for
+// router hosted tunnelers are still created through the controller, which evaluates the check against the router's
+// address.
+type SourceIpCheck struct {
+	*edge_ctrl_pb.DataState_PostureCheck
+	*edge_ctrl_pb.DataState_PostureCheck_SourceIp
+}
+
+func (m *SourceIpCheck) Evaluate(*Cache) *CheckError {
+	return nil
+}
@@ -0,0 +1,213 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/common/bandwidth"
+	"golang.org/x/time/rate"
+)
+
+// BandwidthLimitHandler may be implemented by a PeekHandler to be notified when traffic is held back by a bandwidth
+// limit. rx is true for data read from the peer and false for data written to the peer
+type BandwidthLimitHandler interface {
+	BandwidthLimited(x *Xgress, rx bool, size int, delay time.Duration)
+}
+
+// bandwidthLimiter holds the token buckets which apply to an xgress. Data read from the peer draws on the ingress
+// buckets and data written to the peer draws on the egress buckets.
+//
+// Service limits apply to each circuit and are enforced by the initiating xgress. Identity limits apply to all of an
+// identity's circuits on a router, so the dialing identity's limits are shared by its initiating xgresses and the
+// hosting identity's limits by its terminating xgresses
+type bandwidthLimiter struct {
+	ingress  []*rate.Limiter
+	egress   []*rate.Limiter
+	identity *identityLimiter
+}
+
+type identityLimiter struct {
+	id       string
+	refCount int
+	ingress  *rate.Limiter
+	egress   *rate.Limiter
+}
+
+var identityLimiters = struct {
+	sync.Mutex
+	limiters map[string]*identityLimiter
+}{
+	limiters: map[string]*identityLimiter{},
+}
+
+func newBandwidthLimiter(x *Xgress) *bandwidthLimiter {
+	if len(x.tags) == 0 {
+		return nil
+	}
+
+	log := pfxlog.ContextLogger(x.Label())
+	result := &bandwidthLimiter{}
+
+	if !x.IsTerminator() {
+		limits, err := bandwidth.FromTags(x.tags, bandwidth.TagService)
+		if err != nil {
+			log.WithError(err).Warn("invalid service bandwidth limits, not applying")
+		} else if limits != nil {
+			result.ingress = appendLimiter(result.ingress, limits.IngressRate, limits.GetIngressBurst())
+			result.egress = appendLimiter(result.egress, limits.EgressRate, limits.GetEgressBurst())
+		}
+	}
+
+	tag, identityId := bandwidth.TagClient, x.tags["clientId"]
+	if x.IsTerminator() {
+		tag, identityId = bandwidth.TagHost, x.tags["hostId"]
+	}
+
+	limits, err := bandwidth.FromTags(x.tags, tag)
+	if err != nil {
+		log.WithError(err).Warn("invalid identity bandwidth limits, not applying")
+	} else if limits != nil && identityId != "" {
+		result.identity = acquireIdentityLimiter(identityId, limits)
+		if result.identity.ingress != nil {
+			result.ingress = append(result.ingress, result.identity.ingress)
+		}
+		if result.identity.egress != nil {
+			result.egress = append(result.egress, result.identity.egress)
+		}
+	}
+
+	if len(result.ingress) == 0 && len(result.egress) == 0 {
+		result.release()
+		return nil
+	}
+
+	return result
+}
+
+func appendLimiter(limiters []*rate.Limiter, bytesPerSecond, burst int64) []*rate.Limiter {
+	if limiter := newLimiter(bytesPerSecond, burst); limiter != nil {
+		return append(limiters, limiter)
+	}
+	return limiters
+}
+
+func newLimiter(bytesPerSecond, burst int64) *rate.Limiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), int(max(burst, 1)))
+}
+
+// acquireIdentityLimiter returns the shared buckets for the identity. Limits arrive with each circuit, so if they've
+// changed since the buckets were created, the buckets are updated to match
+func acquireIdentityLimiter(identityId string, limits *bandwidth.Limits) *identityLimiter {
+	identityLimiters.Lock()
+	defer identityLimiters.Unlock()
+
+	result, found := identityLimiters.limiters[identityId]
+	if !found {
+		result = &identityLimiter{id: identityId}
+		identityLimiters.limiters[identityId] = result
+	}
+	result.refCount++
+	result.ingress = updateLimiter(result.ingress, limits.IngressRate, limits.GetIngressBurst())
+	result.egress = updateLimiter(result.egress, limits.EgressRate, limits.GetEgressBurst())
+	return result
+}
+
+func updateLimiter(limiter *rate.Limiter, bytesPerSecond, burst int64) *rate.Limiter {
+	if limiter == nil || bytesPerSecond <= 0 {
+		return newLimiter(bytesPerSecond, burst)
+	}
+	now := time.Now()
+	if limiter.Limit() != rate.Limit(bytesPerSecond) {
+		limiter.SetLimitAt(now, rate.Limit(bytesPerSecond))
+	}
+	if int64(limiter.Burst()) != max(burst, 1) {
+		limiter.SetBurstAt(now, int(max(burst, 1)))
+	}
+	return limiter
+}
+
+func (self *bandwidthLimiter) release() {
+	if self.identity == nil {
+		return
+	}
+
+	identityLimiters.Lock()
+	defer identityLimiters.Unlock()
+
+	self.identity.refCount--
+	if self.identity.refCount <= 0 {
+		delete(identityLimiters.limiters, self.identity.id)
+	}
+	self.identity = nil
+}
+
+// waitIngress blocks until the ingress buckets allow size bytes read from the peer to be forwarded. It returns false
+// if the xgress was closed while waiting
+func (self *bandwidthLimiter) waitIngress(x *Xgress, size int) bool {
+	return self.wait(x, self.ingress, true, size)
+}
+
+// waitEgress blocks until the egress buckets allow size bytes to be written to the peer. It returns false if the
+// xgress was closed while waiting
+func (self *bandwidthLimiter) waitEgress(x *Xgress, size int) bool {
+	return self.wait(x, self.egress, false, size)
+}
+
+func (self *bandwidthLimiter) wait(x *Xgress, limiters []*rate.Limiter, rx bool, size int) bool {
+	var delay time.Duration
+	for _, limiter := range limiters {
+		remaining := size
+		for remaining > 0 {
+			// a reservation can't exceed the burst, so larger payloads take tokens in burst sized chunks
+			chunk := min(remaining, limiter.Burst())
+			remaining -= chunk
+
+			reservation := limiter.ReserveN(time.Now(), chunk)
+			wait := reservation.Delay()
+			if wait <= 0 {
+				continue
+			}
+
+			delay += wait
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-x.closeNotify:
+				timer.Stop()
+				reservation.Cancel()
+				return false
+			}
+		}
+	}
+
+	if delay > 0 {
+		bandwidthLimitedMeter.Mark(1)
+		for _, peekHandler := range x.peekHandlers {
+			if handler, ok := peekHandler.(BandwidthLimitHandler); ok {
+				handler.BandwidthLimited(x, rx, size, delay)
+			}
+		}
+	}
+
+	return true
+}
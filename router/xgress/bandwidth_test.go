@@ -0,0 +1,90 @@
+package xgress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/common/bandwidth"
+	"github.com/stretchr/testify/require"
+)
+
+func newBandwidthTestXgress(originator Originator, tags map[string]string) *Xgress {
+	return NewXgress("test", "ctrl", "test", &testConn{closeNotify: make(chan struct{})}, originator, DefaultOptions(), tags)
+}
+
+func Test_BandwidthLimiterSelection(t *testing.T) {
+	req := require.New(t)
+
+	tags := map[string]string{
+		"clientId": "client",
+		"hostId":   "host",
+	}
+	(&bandwidth.Limits{IngressRate: 1000, EgressRate: 2000}).AddToTags(tags, bandwidth.TagService)
+	(&bandwidth.Limits{IngressRate: 500}).AddToTags(tags, bandwidth.TagClient)
+
+	initiator := newBandwidthTestXgress(Initiator, tags)
+	req.NotNil(initiator.bandwidth)
+	req.Len(initiator.bandwidth.ingress, 2)
+	req.Len(initiator.bandwidth.egress, 1)
+
+	// service limits are only enforced by the initiator and the host has no limits of its own
+	terminator := newBandwidthTestXgress(Terminator, tags)
+	req.Nil(terminator.bandwidth)
+
+	other := newBandwidthTestXgress(Initiator, tags)
+	req.Same(initiator.bandwidth.identity, other.bandwidth.identity)
+	req.Equal(2, initiator.bandwidth.identity.refCount)
+
+	// updated limits arriving with a new circuit apply to the shared buckets
+	(&bandwidth.Limits{IngressRate: 800}).AddToTags(tags, bandwidth.TagClient)
+	updated := newBandwidthTestXgress(Initiator, tags)
+	req.Equal(float64(800), float64(initiator.bandwidth.identity.ingress.Limit()))
+
+	initiator.bandwidth.release()
+	other.bandwidth.release()
+	updated.bandwidth.release()
+
+	identityLimiters.Lock()
+	req.Empty(identityLimiters.limiters)
+	identityLimiters.Unlock()
+
+	req.Nil(newBandwidthTestXgress(Initiator, map[string]string{"clientId": "client"}).bandwidth)
+}
+
+func Test_BandwidthLimiterWait(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	InitMetrics(metrics.NewUsageRegistry("test", map[string]string{}, closeNotify))
+
+	tags := map[string]string{}
+	(&bandwidth.Limits{IngressRate: 10000, IngressBurst: 1000}).AddToTags(tags, bandwidth.TagService)
+
+	x := newBandwidthTestXgress(Initiator, tags)
+	req.NotNil(x.bandwidth)
+
+	start := time.Now()
+	req.True(x.bandwidth.waitIngress(x, 1000))
+	req.Less(time.Since(start), 50*time.Millisecond)
+
+	// larger than the burst, so it's taken in chunks, which need 200ms worth of tokens
+	start = time.Now()
+	req.True(x.bandwidth.waitIngress(x, 2000))
+	req.GreaterOrEqual(time.Since(start), 150*time.Millisecond)
+
+	// egress isn't limited
+	start = time.Now()
+	req.True(x.bandwidth.waitEgress(x, 1000000))
+	req.Less(time.Since(start), 50*time.Millisecond)
+
+	// waiting stops when the xgress closes
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		x.Close()
+	}()
+	start = time.Now()
+	req.False(x.bandwidth.waitIngress(x, 100000))
+	req.Less(time.Since(start), 2*time.Second)
+}
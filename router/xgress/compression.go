@@ -0,0 +1,165 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"bytes"
+	"sync/atomic"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/common/compression"
+	"github.com/pkg/errors"
+)
+
+const (
+	// HeaderKeyCompression holds the id of the codec a payload's data was compressed with
+	HeaderKeyCompression = 0x20
+
+	// HeaderKeyCompressionAccept holds the ids of the codecs the sending xgress is able to decompress
+	HeaderKeyCompressionAccept = 0x21
+
+	// minCompressSize is the smallest payload worth compressing
+	minCompressSize = 128
+
+	// maxCompressSkip is the most payloads skipped after data turns out to be incompressible
+	maxCompressSkip = 64
+)
+
+// payloadCompressor compresses the payloads an xgress reads from its peer, using the codec requested by the
+// circuit's service.
+//
+// Compression is negotiated with the xgress at the other end of the circuit. Both ends advertise the codecs they can
+// decompress on the payloads they send, and an end only starts compressing once it's seen the other end advertise the
+// requested codec. Advertising stops once compressed payloads arrive, as the other end has clearly seen the
+// advertisement by then. If the other end is on a router which doesn't support compression, payloads are sent as is.
+//
+// Payloads which don't get smaller are sent uncompressed. Each time that happens, more of the following payloads are
+// sent without trying, up to maxCompressSkip, so circuits carrying already compressed data don't keep paying for it.
+type payloadCompressor struct {
+	codec       compression.Codec
+	peerAccepts atomic.Bool
+	peerSending atomic.Bool
+	skip        int
+	skipped     int
+}
+
+func newPayloadCompressor(x *Xgress) *payloadCompressor {
+	name := x.tags[compression.TagCodec]
+	if name == "" {
+		return nil
+	}
+
+	codec, found := compression.GetCodec(name)
+	if !found {
+		pfxlog.ContextLogger(x.Label()).WithField("codec", name).Warn("unsupported compression codec, not compressing")
+		return nil
+	}
+
+	return &payloadCompressor{
+		codec: codec,
+	}
+}
+
+// compress is called from the rx goroutine with each payload read from the peer, before it's forwarded
+func (self *payloadCompressor) compress(payload *Payload) {
+	if !self.peerSending.Load() {
+		if payload.Headers == nil {
+			payload.Headers = map[uint8][]byte{}
+		}
+		payload.Headers[HeaderKeyCompressionAccept] = compression.CodecIds()
+	}
+
+	if !self.peerAccepts.Load() || len(payload.Data) < minCompressSize {
+		return
+	}
+
+	if self.skipped < self.skip {
+		self.skipped++
+		compressionBypassedMeter.Mark(1)
+		return
+	}
+	self.skipped = 0
+
+	compressed := self.codec.Compress(payload.Data)
+	if compressed == nil {
+		self.skip = min(max(self.skip*2, 1), maxCompressSkip)
+		compressionBypassedMeter.Mark(1)
+		return
+	}
+	self.skip = 0
+
+	compressionRatioHistogram.Update(int64(len(compressed) * 100 / len(payload.Data)))
+	compressionBytesInMeter.Mark(int64(len(payload.Data)))
+	compressionBytesOutMeter.Mark(int64(len(compressed)))
+
+	if payload.Headers == nil {
+		payload.Headers = map[uint8][]byte{}
+	}
+	payload.Headers[HeaderKeyCompression] = []byte{self.codec.Id()}
+	payload.Data = compressed
+}
+
+// observe is called from the tx goroutine with each payload received from the other end of the circuit
+func (self *payloadCompressor) observe(payload *Payload) {
+	if !self.peerAccepts.Load() {
+		if accepted, found := payload.Headers[HeaderKeyCompressionAccept]; found && bytes.IndexByte(accepted, self.codec.Id()) >= 0 {
+			self.peerAccepts.Store(true)
+		}
+	}
+
+	if !self.peerSending.Load() {
+		if _, found := payload.Headers[HeaderKeyCompression]; found {
+			self.peerSending.Store(true)
+		}
+	}
+}
+
+// decompressPayload returns the data and headers to write to the peer for a payload received from the other end of the
+// circuit. The payload itself isn't modified, as payloads forwarded within a router are shared with the sending xgress.
+func decompressPayload(payload *Payload) ([]byte, map[uint8][]byte, error) {
+	codecId, compressed := payload.Headers[HeaderKeyCompression]
+	_, hasAccept := payload.Headers[HeaderKeyCompressionAccept]
+	if !compressed && !hasAccept {
+		return payload.Data, payload.Headers, nil
+	}
+
+	headers := make(map[uint8][]byte, len(payload.Headers))
+	for k, v := range payload.Headers {
+		if k != HeaderKeyCompression && k != HeaderKeyCompressionAccept {
+			headers[k] = v
+		}
+	}
+
+	if !compressed {
+		return payload.Data, headers, nil
+	}
+
+	if len(codecId) != 1 {
+		return nil, nil, errors.Errorf("invalid compression header on payload %d", payload.Sequence)
+	}
+
+	codec, found := compression.GetCodecById(codecId[0])
+	if !found {
+		return nil, nil, errors.Errorf("payload %d compressed with unsupported codec %d", payload.Sequence, codecId[0])
+	}
+
+	data, err := codec.Decompress(payload.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, headers, nil
+}
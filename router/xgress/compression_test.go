@@ -0,0 +1,95 @@
+package xgress
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/common/compression"
+	"github.com/stretchr/testify/require"
+)
+
+func newCompressionTestPayload(data []byte) *Payload {
+	return &Payload{
+		Header: Header{CircuitId: "test"},
+		Data:   append([]byte(nil), data...),
+	}
+}
+
+func Test_PayloadCompression(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	InitMetrics(metrics.NewUsageRegistry("test", map[string]string{}, closeNotify))
+
+	tags := map[string]string{compression.TagCodec: compression.CodecZstd}
+	initiator := newPayloadCompressor(newBandwidthTestXgress(Initiator, tags))
+	terminator := newPayloadCompressor(newBandwidthTestXgress(Terminator, tags))
+	req.NotNil(initiator)
+	req.NotNil(terminator)
+
+	data := bytes.Repeat([]byte(`{"level":"info","msg":"request complete","status":200}`), 50)
+
+	// nothing is compressed until the other end has advertised it can decompress
+	p1 := newCompressionTestPayload(data)
+	initiator.compress(p1)
+	req.Equal(data, p1.Data)
+	req.NotContains(p1.Headers, uint8(HeaderKeyCompression))
+	req.Contains(p1.Headers, uint8(HeaderKeyCompressionAccept))
+
+	terminator.observe(p1)
+	p2 := newCompressionTestPayload(data)
+	terminator.compress(p2)
+	req.Less(len(p2.Data), len(data))
+	req.Contains(p2.Headers, uint8(HeaderKeyCompression))
+
+	// the receiving end gets the original data and headers, without modifying the payload
+	compressed := p2.Data
+	decompressed, headers, err := decompressPayload(p2)
+	req.NoError(err)
+	req.Equal(data, decompressed)
+	req.Empty(headers)
+	req.Equal(compressed, p2.Data)
+
+	// once compressed payloads arrive, the advertisement is no longer needed
+	initiator.observe(p2)
+	p3 := newCompressionTestPayload(data)
+	initiator.compress(p3)
+	req.Less(len(p3.Data), len(data))
+	req.NotContains(p3.Headers, uint8(HeaderKeyCompressionAccept))
+
+	// incompressible data is sent as is and the following payloads don't try
+	random := make([]byte, 4096)
+	_, err = rand.Read(random)
+	req.NoError(err)
+	p4 := newCompressionTestPayload(random)
+	initiator.compress(p4)
+	req.Equal(random, p4.Data)
+	req.NotContains(p4.Headers, uint8(HeaderKeyCompression))
+
+	p5 := newCompressionTestPayload(data)
+	initiator.compress(p5)
+	req.Equal(data, p5.Data)
+
+	p6 := newCompressionTestPayload(data)
+	initiator.compress(p6)
+	req.Less(len(p6.Data), len(data))
+
+	decompressed, _, err = decompressPayload(p4)
+	req.NoError(err)
+	req.Equal(random, decompressed)
+}
+
+func Test_PayloadCompressionNotConfigured(t *testing.T) {
+	req := require.New(t)
+
+	req.Nil(newPayloadCompressor(newBandwidthTestXgress(Initiator, nil)))
+	req.Nil(newPayloadCompressor(newBandwidthTestXgress(Initiator, map[string]string{compression.TagCodec: "gzip"})))
+
+	payload := newCompressionTestPayload([]byte("hello"))
+	payload.Headers = map[uint8][]byte{HeaderKeyCompression: {99}}
+	_, _, err := decompressPayload(payload)
+	req.Error(err)
+}
@@ -45,8 +45,17 @@ func (buffer *LinkReceiveBuffer) Size() uint32 {
 	return atomic.LoadUint32(&buffer.size)
 }
 
+// ReceiveUnordered adds the payload to the buffer, returning false if it was dropped because the buffer is full.
+// Payloads which have already been received, either through retransmission or because they were sent down more than
+// one path of a multipath circuit, are discarded but still reported as received, so they're acknowledged again.
 func (buffer *LinkReceiveBuffer) ReceiveUnordered(payload *Payload, maxSize uint32) bool {
 	if payload.GetSequence() <= buffer.sequence {
+		duplicatePayloadsMeter.Mark(1)
+		return true
+	}
+
+	if _, found := buffer.tree.Get(payload.GetSequence()); found {
+		duplicatePayloadsMeter.Mark(1)
 		return true
 	}
 
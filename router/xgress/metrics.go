@@ -14,6 +14,12 @@ var retransmissionFailures metrics.Meter
 var ackFailures metrics.Meter
 var payloadWriteTimer metrics.Timer
 var duplicateAcksMeter metrics.Meter
+var duplicatePayloadsMeter metrics.Meter
+var bandwidthLimitedMeter metrics.Meter
+var compressionBytesInMeter metrics.Meter
+var compressionBytesOutMeter metrics.Meter
+var compressionBypassedMeter metrics.Meter
+var compressionRatioHistogram metrics.Histogram
 
 var buffersBlockedByLocalWindow int64
 var buffersBlockedByRemoteWindow int64
@@ -29,6 +35,12 @@ func InitMetrics(registry metrics.UsageRegistry) {
 	ackFailures = registry.Meter("xgress.ack_failures")
 	payloadWriteTimer = registry.Timer("xgress.tx_write_time")
 	duplicateAcksMeter = registry.Meter("xgress.ack_duplicates")
+	duplicatePayloadsMeter = registry.Meter("xgress.payload_duplicates")
+	bandwidthLimitedMeter = registry.Meter("xgress.bandwidth_limited")
+	compressionBytesInMeter = registry.Meter("xgress.compression.bytes_in")
+	compressionBytesOutMeter = registry.Meter("xgress.compression.bytes_out")
+	compressionBypassedMeter = registry.Meter("xgress.compression.bypassed")
+	compressionRatioHistogram = registry.Histogram("xgress.compression.ratio")
 
 	registry.FuncGauge("xgress.blocked_by_local_window", func() int64 {
 		return atomic.LoadInt64(&buffersBlockedByLocalWindow)
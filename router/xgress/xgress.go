@@ -154,6 +154,8 @@ type Xgress struct {
 	flags                concurrenz.AtomicBitSet
 	timeOfLastRxFromLink int64
 	tags                 map[string]string
+	bandwidth            *bandwidthLimiter
+	compressor           *payloadCompressor
 }
 
 func (self *Xgress) GetIntervalId() string {
@@ -180,6 +182,8 @@ func NewXgress(circuitId string, ctrlId string, address Address, peer Connection
 		tags:                 tags,
 	}
 	result.payloadBuffer = NewLinkSendBuffer(result)
+	result.bandwidth = newBandwidthLimiter(result)
+	result.compressor = newPayloadCompressor(result)
 	return result
 }
 
@@ -334,6 +338,10 @@ func (self *Xgress) Close() {
 
 		self.payloadBuffer.Close()
 
+		if self.bandwidth != nil {
+			self.bandwidth.release()
+		}
+
 		for _, peekHandler := range self.peekHandlers {
 			peekHandler.Close(self)
 		}
@@ -473,9 +481,24 @@ func (self *Xgress) tx() {
 			peekHandler.Tx(self, payload)
 		}
 
+		if self.compressor != nil {
+			self.compressor.observe(payload)
+		}
+
 		if !payload.IsCircuitStartFlagSet() {
+			data, headers, err := decompressPayload(payload)
+			if err != nil {
+				payloadLogger.WithError(err).Error("unable to decompress payload, closing xgress")
+				self.Close()
+				return
+			}
+
+			if self.bandwidth != nil && !self.bandwidth.waitEgress(self, len(data)) {
+				return
+			}
+
 			start := time.Now()
-			n, err := self.peer.WritePayload(payload.Data, payload.Headers)
+			n, err := self.peer.WritePayload(data, headers)
 			if err != nil {
 				payloadLogger.Warnf("write failed (%s), closing xgress", err)
 				self.Close()
@@ -544,6 +567,10 @@ func (self *Xgress) rx() {
 			return
 		}
 
+		if self.bandwidth != nil && !self.bandwidth.waitIngress(self, n) {
+			return
+		}
+
 		payload := &Payload{
 			Header: Header{
 				CircuitId: self.circuitId,
@@ -554,6 +581,10 @@ func (self *Xgress) rx() {
 			Headers:  headers,
 		}
 
+		if self.compressor != nil {
+			self.compressor.compress(payload)
+		}
+
 		// if the payload buffer is closed, we can't forward any more data, so might as well exit the rx loop
 		// The txer will still have a chance to flush any already received data
 		if !self.forwardPayload(payload) {
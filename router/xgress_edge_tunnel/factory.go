@@ -29,6 +29,7 @@ import (
 	"github.com/openziti/ziti/router/handler_edge_ctrl"
 	"github.com/openziti/ziti/router/state"
 	"github.com/openziti/ziti/router/xgress"
+	"github.com/openziti/ziti/tunnel/intercept/tproxy"
 	"github.com/pkg/errors"
 	"strings"
 	"time"
@@ -131,7 +132,9 @@ type Options struct {
 	resolver         string
 	dnsSvcIpRange    string
 	lanIf            string
+	tproxyBackend    string
 	services         []string
+	execChecksAllow  []string
 	udpIdleTimeout   time.Duration
 	udpCheckInterval time.Duration
 }
@@ -202,6 +205,20 @@ func (options *Options) load(data xgress.OptionsData) error {
 			}
 		}
 
+		if value, found := data["healthCheckExecAllow"]; found {
+			if slice, ok := value.([]interface{}); ok {
+				for _, value := range slice {
+					if strVal, ok := value.(string); ok {
+						options.execChecksAllow = append(options.execChecksAllow, strVal)
+					} else {
+						return errors.Errorf(`invalid value '%v' for healthCheckExecAllow, must be list of strings`, value)
+					}
+				}
+			} else {
+				return errors.New(`invalid value for healthCheckExecAllow, must be list of strings`)
+			}
+		}
+
 		if value, found := data["lanIf"]; found {
 			if strVal, ok := value.(string); ok {
 				options.lanIf = strVal
@@ -210,6 +227,14 @@ func (options *Options) load(data xgress.OptionsData) error {
 			}
 		}
 
+		if value, found := data["tproxyBackend"]; found {
+			if strVal, ok := value.(string); ok && stringz.Contains([]string{tproxy.BackendIptables, tproxy.BackendNftables}, strVal) {
+				options.tproxyBackend = strVal
+			} else {
+				return errors.Errorf(`invalid value '%v' for tproxyBackend, must be one of ["%s", "%s"]`, value, tproxy.BackendIptables, tproxy.BackendNftables)
+			}
+		}
+
 		if value, found := data["udpIdleTimeout"]; found {
 			if strVal, ok := value.(string); ok {
 				dur, err := time.ParseDuration(strVal)
@@ -21,6 +21,7 @@ import (
 	"github.com/openziti/ziti/router/xgress"
 	"github.com/openziti/ziti/router/state"
 	"github.com/openziti/ziti/tunnel/dns"
+	"github.com/openziti/ziti/tunnel/health"
 	"github.com/openziti/ziti/tunnel/intercept"
 	"github.com/openziti/ziti/tunnel/intercept/host"
 	"github.com/openziti/ziti/tunnel/intercept/proxy"
@@ -81,9 +82,14 @@ func (self *tunneler) Start(notifyClose <-chan struct{}) error {
 		return err
 	}
 
+	if err = health.SetExecCheckAllowList(self.listenOptions.execChecksAllow); err != nil {
+		return err
+	}
+
 	if strings.HasPrefix(self.listenOptions.mode, "tproxy") {
 		tproxyConfig := tproxy.Config{
 			LanIf:            self.listenOptions.lanIf,
+			Backend:          self.listenOptions.tproxyBackend,
 			UDPIdleTimeout:   self.listenOptions.udpIdleTimeout,
 			UDPCheckInterval: self.listenOptions.udpCheckInterval,
 		}
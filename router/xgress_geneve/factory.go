@@ -17,16 +17,73 @@
 package xgress_geneve
 
 import (
+	"github.com/openziti/metrics"
 	"github.com/openziti/ziti/router/xgress"
 	"github.com/pkg/errors"
 )
 
-type Factory struct{}
+const (
+	// DefaultBindAddress listens on all interfaces, for both IPv4 and IPv6
+	DefaultBindAddress = ""
 
-func (f Factory) CreateListener(optionsData xgress.OptionsData) (xgress.Listener, error) {
-	return &listener{}, nil
+	// DefaultPort is the IANA assigned Geneve port
+	DefaultPort = 6081
+)
+
+type Options struct {
+	bindAddress string
+	port        uint16
+}
+
+func (options *Options) load(data xgress.OptionsData) error {
+	options.bindAddress = DefaultBindAddress
+	options.port = DefaultPort
+
+	if value, found := data["options"]; found {
+		optionsData, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return errors.Errorf("invalid value '%v' for options, must be a map", value)
+		}
+		data = optionsData
+
+		if value, found := data["bindAddress"]; found {
+			if strVal, ok := value.(string); ok {
+				options.bindAddress = strVal
+			} else {
+				return errors.Errorf("invalid value '%v' for bindAddress, must be a string", value)
+			}
+		}
+
+		if value, found := data["port"]; found {
+			if intVal, ok := value.(int); ok && intVal > 0 && intVal <= 65535 {
+				options.port = uint16(intVal)
+			} else {
+				return errors.Errorf("invalid value '%v' for port, must be an integer between 1 and 65535", value)
+			}
+		}
+	}
+
+	return nil
+}
+
+type Factory struct {
+	metricsRegistry metrics.Registry
+}
+
+func NewFactory(metricsRegistry metrics.Registry) *Factory {
+	return &Factory{
+		metricsRegistry: metricsRegistry,
+	}
+}
+
+func (f *Factory) CreateListener(optionsData xgress.OptionsData) (xgress.Listener, error) {
+	options := &Options{}
+	if err := options.load(optionsData); err != nil {
+		return nil, errors.Wrap(err, "error loading geneve options")
+	}
+	return newListener(options, newListenerMetrics(f.metricsRegistry)), nil
 }
 
-func (f Factory) CreateDialer(optionsData xgress.OptionsData) (xgress.Dialer, error) {
+func (f *Factory) CreateDialer(xgress.OptionsData) (xgress.Dialer, error) {
 	return nil, errors.New("dialer not supported")
 }
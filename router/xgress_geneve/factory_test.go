@@ -0,0 +1,44 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_geneve
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsLoad(t *testing.T) {
+	req := require.New(t)
+
+	options := &Options{}
+	req.NoError(options.load(xgress.OptionsData{}))
+	req.Equal(DefaultBindAddress, options.bindAddress)
+	req.Equal(uint16(DefaultPort), options.port)
+
+	req.NoError(options.load(xgress.OptionsData{
+		"options": map[interface{}]interface{}{"bindAddress": "127.0.0.1", "port": 7000},
+	}))
+	req.Equal("127.0.0.1", options.bindAddress)
+	req.Equal(uint16(7000), options.port)
+
+	req.Error(options.load(xgress.OptionsData{"options": "port=7000"}))
+	req.Error(options.load(xgress.OptionsData{"options": nil}))
+	req.Error(options.load(xgress.OptionsData{"options": map[interface{}]interface{}{"port": 70000}}))
+	req.Error(options.load(xgress.OptionsData{"options": map[interface{}]interface{}{"bindAddress": 1}}))
+}
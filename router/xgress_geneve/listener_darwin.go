@@ -23,6 +23,10 @@ import (
 
 type listener struct{}
 
+func newListener(*Options, *listenerMetrics) *listener {
+	return &listener{}
+}
+
 func (self *listener) Listen(string, xgress.BindHandler) error {
 	return errors.New("geneve not supported in darwin")
 }
@@ -0,0 +1,145 @@
+//go:build linux || freebsd
+
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_geneve
+
+import (
+	"net"
+	"strconv"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/pkg/errors"
+)
+
+type listener struct {
+	options *Options
+	metrics *listenerMetrics
+	conn    net.PacketConn
+	fd4     int
+	fd6     int
+	closed  atomic.Bool
+}
+
+func newListener(options *Options, metrics *listenerMetrics) *listener {
+	return &listener{
+		options: options,
+		metrics: metrics,
+		fd4:     -1,
+		fd6:     -1,
+	}
+}
+
+func (self *listener) Listen(string, xgress.BindHandler) error {
+	log := pfxlog.Logger()
+
+	address := net.JoinHostPort(self.options.bindAddress, strconv.Itoa(int(self.options.port)))
+
+	// Open UDP socket to listen for Geneve Packets
+	conn, err := net.ListenPacket("udp", address)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open geneve interface - udp %v", address)
+	}
+	self.conn = conn
+	log.Infof("geneve interface started successfully - udp: %s", conn.LocalAddr().String())
+
+	// Open raw sockets to send the decapsulated packets to the networking stack
+	self.fd4, err = syscall.Socket(syscall.AF_INET, syscall.SOCK_RAW, syscall.IPPROTO_RAW)
+	if err != nil {
+		_ = conn.Close()
+		return errors.Wrap(err, "failed to open geneve interface - ipv4 raw socket")
+	}
+
+	self.fd6, err = syscall.Socket(syscall.AF_INET6, syscall.SOCK_RAW, syscall.IPPROTO_RAW)
+	if err != nil {
+		// hosts with IPv6 disabled can still handle IPv4 traffic
+		log.WithError(err).Warn("failed to open geneve interface - ipv6 raw socket, ipv6 packets will be dropped")
+		self.fd6 = -1
+	}
+
+	go self.run()
+	return nil
+}
+
+func (self *listener) run() {
+	log := pfxlog.ChannelLogger("geneveListener")
+
+	defer func() {
+		_ = syscall.Close(self.fd4)
+		if self.fd6 != -1 {
+			_ = syscall.Close(self.fd6)
+		}
+	}()
+
+	buf := make([]byte, 9000)
+	for {
+		n, _, err := self.conn.ReadFrom(buf)
+		if err != nil {
+			if self.closed.Load() {
+				return
+			}
+			log.WithError(err).Error("error reading from geneve interface - udp")
+			self.metrics.decodeErrors.Mark(1)
+			continue
+		}
+
+		self.metrics.packets.Mark(1)
+		self.metrics.bytes.Mark(int64(n))
+
+		packet, err := decapsulate(buf[:n])
+		if err != nil {
+			log.WithError(err).Debug("dropping geneve packet")
+			self.metrics.decodeErrors.Mark(1)
+			continue
+		}
+
+		log.Tracef("decapsulated packet for %v: %X", packet.dst, packet.data)
+
+		if err = self.send(packet); err != nil {
+			log.WithError(err).Errorf("failed to send decapsulated packet for %v", packet.dst)
+			self.metrics.sendErrors.Mark(1)
+		}
+	}
+}
+
+// send hands the inner packet, which already has its IP header, to the networking stack so it can be routed to the
+// tproxy intercepts
+func (self *listener) send(packet *innerPacket) error {
+	if packet.ipv6 {
+		if self.fd6 == -1 {
+			return errors.New("ipv6 raw socket not available")
+		}
+		sockAddress := &syscall.SockaddrInet6{}
+		copy(sockAddress.Addr[:], packet.dst.To16())
+		return syscall.Sendto(self.fd6, packet.data, 0, sockAddress)
+	}
+
+	sockAddress := &syscall.SockaddrInet4{}
+	copy(sockAddress.Addr[:], packet.dst.To4())
+	return syscall.Sendto(self.fd4, packet.data, 0, sockAddress)
+}
+
+func (self *listener) Close() error {
+	pfxlog.Logger().Warn("closing geneve interface")
+	if self.closed.CompareAndSwap(false, true) && self.conn != nil {
+		return self.conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_geneve
+
+import "github.com/openziti/metrics"
+
+type listenerMetrics struct {
+	packets      metrics.Meter
+	bytes        metrics.Meter
+	decodeErrors metrics.Meter
+	sendErrors   metrics.Meter
+}
+
+func newListenerMetrics(registry metrics.Registry) *listenerMetrics {
+	return &listenerMetrics{
+		packets:      registry.Meter("xgress.geneve.packets"),
+		bytes:        registry.Meter("xgress.geneve.bytes"),
+		decodeErrors: registry.Meter("xgress.geneve.decode_errors"),
+		sendErrors:   registry.Meter("xgress.geneve.send_errors"),
+	}
+}
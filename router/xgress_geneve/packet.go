@@ -0,0 +1,66 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_geneve
+
+import (
+	"net"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/pkg/errors"
+)
+
+// innerPacket is the IP packet carried inside a Geneve packet
+type innerPacket struct {
+	data []byte
+	dst  net.IP
+	ipv6 bool
+}
+
+// decapsulate strips the Geneve header, including any options, and returns the inner IPv4 or IPv6 packet
+func decapsulate(data []byte) (*innerPacket, error) {
+	packet := gopacket.NewPacket(data, layers.LayerTypeGeneve, gopacket.DecodeOptions{NoCopy: true, Lazy: true})
+
+	geneve, ok := packet.Layer(layers.LayerTypeGeneve).(*layers.Geneve)
+	if !ok {
+		if errLayer := packet.ErrorLayer(); errLayer != nil {
+			return nil, errors.Wrap(errLayer.Error(), "unable to decode geneve header")
+		}
+		return nil, errors.New("not a geneve packet")
+	}
+
+	if ip, ok := packet.Layer(layers.LayerTypeIPv4).(*layers.IPv4); ok {
+		return &innerPacket{
+			data: append(ip.LayerContents(), ip.LayerPayload()...),
+			dst:  ip.DstIP,
+		}, nil
+	}
+
+	if ip, ok := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6); ok {
+		return &innerPacket{
+			data: append(ip.LayerContents(), ip.LayerPayload()...),
+			dst:  ip.DstIP,
+			ipv6: true,
+		}, nil
+	}
+
+	if errLayer := packet.ErrorLayer(); errLayer != nil {
+		return nil, errors.Wrap(errLayer.Error(), "unable to decode inner packet")
+	}
+
+	return nil, errors.Errorf("geneve packet doesn't contain an IP packet (protocol: %v)", geneve.Protocol)
+}
@@ -0,0 +1,98 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_geneve
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/require"
+)
+
+// newGenevePacket builds a Geneve header with a single 4 byte option, such as the flow cookie added by AWS gateway
+// load balancers, followed by the given inner packet
+func newGenevePacket(protocol layers.EthernetType, inner []byte) []byte {
+	header := make([]byte, 16)
+	header[0] = 2 // options length, in 4 byte words
+	binary.BigEndian.PutUint16(header[2:4], uint16(protocol))
+	header[6] = 7 // vni
+	binary.BigEndian.PutUint16(header[8:10], 0x0108)
+	header[10] = 3
+	header[11] = 1 // option length, in 4 byte words
+	copy(header[12:], []byte{1, 2, 3, 4})
+	return append(header, inner...)
+}
+
+func serializeInnerPacket(req *require.Assertions, ip gopacket.NetworkLayer, serializableIp gopacket.SerializableLayer) []byte {
+	udp := &layers.UDP{SrcPort: 5000, DstPort: 443}
+	req.NoError(udp.SetNetworkLayerForChecksum(ip))
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	req.NoError(gopacket.SerializeLayers(buf, opts, serializableIp, udp, gopacket.Payload("hello")))
+	return buf.Bytes()
+}
+
+func TestDecapsulateIPv4(t *testing.T) {
+	req := require.New(t)
+
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolUDP,
+		SrcIP:    net.ParseIP("10.0.0.1").To4(),
+		DstIP:    net.ParseIP("10.0.0.2").To4(),
+	}
+	inner := serializeInnerPacket(req, ip, ip)
+
+	packet, err := decapsulate(newGenevePacket(layers.EthernetTypeIPv4, inner))
+	req.NoError(err)
+	req.False(packet.ipv6)
+	req.True(net.ParseIP("10.0.0.2").Equal(packet.dst))
+	req.Equal(inner, packet.data)
+}
+
+func TestDecapsulateIPv6(t *testing.T) {
+	req := require.New(t)
+
+	ip := &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		NextHeader: layers.IPProtocolUDP,
+		SrcIP:      net.ParseIP("fd00::1"),
+		DstIP:      net.ParseIP("fd00::2"),
+	}
+	inner := serializeInnerPacket(req, ip, ip)
+
+	packet, err := decapsulate(newGenevePacket(layers.EthernetTypeIPv6, inner))
+	req.NoError(err)
+	req.True(packet.ipv6)
+	req.True(net.ParseIP("fd00::2").Equal(packet.dst))
+	req.Equal(inner, packet.data)
+}
+
+func TestDecapsulateInvalid(t *testing.T) {
+	req := require.New(t)
+
+	_, err := decapsulate([]byte{0, 0, 0})
+	req.Error(err)
+
+	_, err = decapsulate(newGenevePacket(layers.EthernetTypeARP, []byte{0, 1, 2, 3}))
+	req.Error(err)
+}
@@ -123,6 +123,7 @@ func (self *dialer) dialSplit(linkId *identity.TokenId, address transport.Addres
 			dialAddress:   dial.GetAddress(),
 			iteration:     dial.GetIteration(),
 			dialed:        true,
+			scheduler:     newLinkScheduler(),
 		},
 	}
 
@@ -182,6 +183,7 @@ func (self *dialer) dialSingle(linkId *identity.TokenId, address transport.Addre
 			dialAddress:   dial.GetAddress(),
 			iteration:     dial.GetIteration(),
 			dialed:        true,
+			scheduler:     newLinkScheduler(),
 		},
 	}
 
@@ -217,6 +217,7 @@ func (self *listener) getOrCreateSplitLink(connId string, linkMeta *linkMetadata
 				dialAddress:   self.GetAdvertisement(),
 				iteration:     linkMeta.iteration,
 				dialed:        false,
+				scheduler:     newLinkScheduler(),
 			},
 			eventTime: time.Now(),
 		}
@@ -262,6 +263,7 @@ func (self *listener) bindNonSplitChannel(binding channel.Binding, linkMeta *lin
 		dialAddress:   self.GetAdvertisement(),
 		iteration:     linkMeta.iteration,
 		dialed:        false,
+		scheduler:     newLinkScheduler(),
 	}
 
 	bindHandler := self.bindHandlerFactory.NewBindHandler(xli, true, true)
@@ -0,0 +1,165 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"sync"
+	"sync/atomic"
+)
+
+// linkSchedulerQueueSize is the number of messages each priority class may have waiting to be scheduled onto a link
+// channel. Messages which don't fit are dropped, the same as messages sent to a channel with a full send queue.
+const linkSchedulerQueueSize = 64
+
+// linkSchedulerRound holds the priority classes in the order they're serviced and how many messages are taken from
+// each class per round. Interactive traffic goes first and gets the largest share of the link, while bulk traffic
+// still gets a share, so it slows down instead of stalling when the link is busy.
+var linkSchedulerRound = []struct {
+	priority ctrl_pb.PriorityClass
+	weight   int
+}{
+	{priority: ctrl_pb.PriorityClass_PriorityInteractive, weight: 8},
+	{priority: ctrl_pb.PriorityClass_PriorityDefault, weight: 4},
+	{priority: ctrl_pb.PriorityClass_PriorityBulk, weight: 1},
+}
+
+// linkScheduler queues the messages for a link channel by the priority class of their circuit and feeds them to the
+// channel using weighted round-robin. Without it, every circuit shares the channel's FIFO send queue and a few busy
+// bulk circuits can keep interactive circuits waiting behind a full queue.
+type linkScheduler struct {
+	queues      map[ctrl_pb.PriorityClass]chan channel.Sendable
+	notify      chan struct{}
+	closeNotify chan struct{}
+	started     atomic.Bool
+	closed      atomic.Bool
+	closeOnce   sync.Once
+}
+
+func newLinkScheduler() *linkScheduler {
+	result := &linkScheduler{
+		queues:      map[ctrl_pb.PriorityClass]chan channel.Sendable{},
+		notify:      make(chan struct{}, 1),
+		closeNotify: make(chan struct{}),
+	}
+	for _, class := range linkSchedulerRound {
+		result.queues[class.priority] = make(chan channel.Sendable, linkSchedulerQueueSize)
+	}
+	return result
+}
+
+// Start begins feeding queued messages to the given channel. Only the first call has any effect.
+func (self *linkScheduler) Start(ch channel.Channel) {
+	if self.started.CompareAndSwap(false, true) {
+		go self.run(ch)
+	}
+}
+
+// TrySend queues the message with the given priority class. Like channel.Channel.TrySend, it returns false if the
+// message couldn't be queued because the queue is full.
+func (self *linkScheduler) TrySend(msg *channel.Message, priority ctrl_pb.PriorityClass) (bool, error) {
+	if self.closed.Load() {
+		return false, channel.ClosedError{}
+	}
+
+	queue, found := self.queues[priority]
+	if !found {
+		priority = ctrl_pb.PriorityClass_PriorityDefault
+		queue = self.queues[priority]
+	}
+
+	select {
+	case queue <- msg.WithPriority(getChannelPriority(priority)).ToSendable():
+	default:
+		return false, nil
+	}
+
+	select {
+	case self.notify <- struct{}{}:
+	default:
+	}
+	return true, nil
+}
+
+func (self *linkScheduler) QueuedMsgs() map[string]int {
+	result := map[string]int{}
+	for priority, queue := range self.queues {
+		result[priority.String()] = len(queue)
+	}
+	return result
+}
+
+func (self *linkScheduler) Close() {
+	self.closeOnce.Do(func() {
+		self.closed.Store(true)
+		close(self.closeNotify)
+	})
+}
+
+func (self *linkScheduler) run(ch channel.Channel) {
+	defer self.Close()
+
+	for {
+		sent := 0
+		for _, class := range linkSchedulerRound {
+			count, err := self.sendQueued(ch, self.queues[class.priority], class.weight)
+			if err != nil {
+				pfxlog.Logger().WithField("linkId", ch.Id()).WithError(err).Debug("link scheduler exiting")
+				return
+			}
+			sent += count
+		}
+
+		if sent == 0 {
+			select {
+			case <-self.notify:
+			case <-self.closeNotify:
+				return
+			}
+		}
+	}
+}
+
+// sendQueued sends up to limit messages from the queue to the channel, blocking while the channel send queue is full
+func (self *linkScheduler) sendQueued(ch channel.Channel, queue chan channel.Sendable, limit int) (int, error) {
+	for i := 0; i < limit; i++ {
+		select {
+		case msg := <-queue:
+			if err := ch.Send(msg); err != nil {
+				return i, err
+			}
+		default:
+			return i, nil
+		}
+	}
+	return limit, nil
+}
+
+// getChannelPriority maps priority classes to channel priorities, so batches written by the channel are also ordered
+// by priority class
+func getChannelPriority(priority ctrl_pb.PriorityClass) channel.Priority {
+	switch priority {
+	case ctrl_pb.PriorityClass_PriorityInteractive:
+		return channel.High
+	case ctrl_pb.PriorityClass_PriorityBulk:
+		return channel.Low
+	default:
+		return channel.Standard
+	}
+}
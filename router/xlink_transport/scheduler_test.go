@@ -0,0 +1,120 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type recordingChannel struct {
+	channel.Channel
+	sent chan channel.Sendable
+}
+
+func (self *recordingChannel) Id() string {
+	return "test"
+}
+
+func (self *recordingChannel) Send(s channel.Sendable) error {
+	self.sent <- s
+	return nil
+}
+
+func newPriorityTestMsg(priority ctrl_pb.PriorityClass) *channel.Message {
+	return channel.NewMessage(channel.ContentTypePingType, []byte(priority.String()))
+}
+
+func TestLinkScheduler_WeightedRoundRobin(t *testing.T) {
+	req := require.New(t)
+
+	scheduler := newLinkScheduler()
+	defer scheduler.Close()
+
+	// queue everything before starting so the scheduler sees a full backlog for every class
+	for i := 0; i < 20; i++ {
+		for _, priority := range []ctrl_pb.PriorityClass{ctrl_pb.PriorityClass_PriorityBulk, ctrl_pb.PriorityClass_PriorityDefault, ctrl_pb.PriorityClass_PriorityInteractive} {
+			sent, err := scheduler.TrySend(newPriorityTestMsg(priority), priority)
+			req.NoError(err)
+			req.True(sent)
+		}
+	}
+	req.Equal(map[string]int{"PriorityInteractive": 20, "PriorityDefault": 20, "PriorityBulk": 20}, scheduler.QueuedMsgs())
+
+	ch := &recordingChannel{sent: make(chan channel.Sendable, 60)}
+	scheduler.Start(ch)
+
+	next := func() string {
+		select {
+		case s := <-ch.sent:
+			return string(s.Msg().Body)
+		case <-time.After(time.Second):
+			req.Fail("timed out waiting for scheduled message")
+			return ""
+		}
+	}
+
+	var expected, actual []string
+	for _, count := range []struct {
+		priority ctrl_pb.PriorityClass
+		count    int
+	}{
+		{ctrl_pb.PriorityClass_PriorityInteractive, 8},
+		{ctrl_pb.PriorityClass_PriorityDefault, 4},
+		{ctrl_pb.PriorityClass_PriorityBulk, 1},
+		{ctrl_pb.PriorityClass_PriorityInteractive, 8},
+		{ctrl_pb.PriorityClass_PriorityDefault, 4},
+		{ctrl_pb.PriorityClass_PriorityBulk, 1},
+		{ctrl_pb.PriorityClass_PriorityInteractive, 4},
+		{ctrl_pb.PriorityClass_PriorityDefault, 4},
+		{ctrl_pb.PriorityClass_PriorityBulk, 1},
+	} {
+		for i := 0; i < count.count; i++ {
+			expected = append(expected, count.priority.String())
+			actual = append(actual, next())
+		}
+	}
+	req.Equal(expected, actual)
+}
+
+func TestLinkScheduler_DropsWhenFull(t *testing.T) {
+	req := require.New(t)
+
+	scheduler := newLinkScheduler()
+
+	for i := 0; i < linkSchedulerQueueSize; i++ {
+		sent, err := scheduler.TrySend(newPriorityTestMsg(ctrl_pb.PriorityClass_PriorityBulk), ctrl_pb.PriorityClass_PriorityBulk)
+		req.NoError(err)
+		req.True(sent)
+	}
+
+	sent, err := scheduler.TrySend(newPriorityTestMsg(ctrl_pb.PriorityClass_PriorityBulk), ctrl_pb.PriorityClass_PriorityBulk)
+	req.NoError(err)
+	req.False(sent)
+
+	// a full bulk queue doesn't keep interactive traffic out
+	sent, err = scheduler.TrySend(newPriorityTestMsg(ctrl_pb.PriorityClass_PriorityInteractive), ctrl_pb.PriorityClass_PriorityInteractive)
+	req.NoError(err)
+	req.True(sent)
+
+	scheduler.Close()
+	_, err = scheduler.TrySend(newPriorityTestMsg(ctrl_pb.PriorityClass_PriorityInteractive), ctrl_pb.PriorityClass_PriorityInteractive)
+	req.ErrorAs(err, &channel.ClosedError{})
+}
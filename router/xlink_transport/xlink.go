@@ -36,6 +36,7 @@ type impl struct {
 	closed          atomic.Bool
 	faultsSent      atomic.Bool
 	droppedMsgMeter metrics.Meter
+	scheduler       *linkScheduler
 	dialed          bool
 	iteration       uint32
 	dupsRejected    uint32
@@ -57,27 +58,32 @@ func (self *impl) Init(metricsRegistry metrics.Registry) error {
 	if self.droppedMsgMeter == nil {
 		self.droppedMsgMeter = metricsRegistry.Meter("link.dropped_msgs:" + self.id)
 	}
+	self.scheduler.Start(self.ch)
 	return nil
 }
 
 func (self *impl) SendPayload(msg *xgress.Payload) error {
-	sent, err := self.ch.TrySend(msg.Marshall())
-	if err == nil && !sent {
-		self.droppedMsgMeter.Mark(1)
-	}
-	return err
+	return self.SendPayloadWithPriority(msg, ctrl_pb.PriorityClass_PriorityDefault)
+}
+
+func (self *impl) SendPayloadWithPriority(msg *xgress.Payload, priority ctrl_pb.PriorityClass) error {
+	return self.send(msg.Marshall(), priority)
 }
 
 func (self *impl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
-	sent, err := self.ch.TrySend(msg.Marshall())
-	if err == nil && !sent {
-		self.droppedMsgMeter.Mark(1)
-	}
-	return err
+	return self.SendAcknowledgementWithPriority(msg, ctrl_pb.PriorityClass_PriorityDefault)
+}
+
+func (self *impl) SendAcknowledgementWithPriority(msg *xgress.Acknowledgement, priority ctrl_pb.PriorityClass) error {
+	return self.send(msg.Marshall(), priority)
 }
 
 func (self *impl) SendControl(msg *xgress.Control) error {
-	sent, err := self.ch.TrySend(msg.Marshall())
+	return self.send(msg.Marshall(), ctrl_pb.PriorityClass_PriorityDefault)
+}
+
+func (self *impl) send(msg *channel.Message, priority ctrl_pb.PriorityClass) error {
+	sent, err := self.scheduler.TrySend(msg, priority)
 	if err == nil && !sent {
 		self.droppedMsgMeter.Mark(1)
 	}
@@ -86,6 +92,7 @@ func (self *impl) SendControl(msg *xgress.Control) error {
 
 func (self *impl) Close() error {
 	self.droppedMsgMeter.Dispose()
+	self.scheduler.Close()
 	return self.ch.Close()
 }
 
@@ -143,6 +150,7 @@ func (self *impl) InspectLink() *inspect.LinkInspectDetail {
 		Dest:        self.DestinationId(),
 		DestVersion: self.DestVersion(),
 		Dialed:      self.dialed,
+		QueuedMsgs:  self.scheduler.QueuedMsgs(),
 	}
 }
 
@@ -39,6 +39,7 @@ type splitImpl struct {
 	closed          atomic.Bool
 	faultsSent      atomic.Bool
 	droppedMsgMeter metrics.Meter
+	scheduler       *linkScheduler
 	dialed          bool
 	iteration       uint32
 	dupsRejected    uint32
@@ -61,6 +62,9 @@ func (self *splitImpl) Init(metricsRegistry metrics.Registry) error {
 	if self.droppedMsgMeter == nil {
 		self.droppedMsgMeter = metricsRegistry.Meter("link.dropped_msgs:" + self.id)
 	}
+	if self.payloadCh != nil {
+		self.scheduler.Start(self.payloadCh)
+	}
 	return nil
 }
 
@@ -71,13 +75,15 @@ func (self *splitImpl) syncInit(f func() error) error {
 }
 
 func (self *splitImpl) SendPayload(msg *xgress.Payload) error {
-	sent, err := self.payloadCh.TrySend(msg.Marshall())
-	if err == nil && !sent {
-		self.droppedMsgMeter.Mark(1)
-	}
-	return err
+	return self.SendPayloadWithPriority(msg, ctrl_pb.PriorityClass_PriorityDefault)
 }
 
+func (self *splitImpl) SendPayloadWithPriority(msg *xgress.Payload, priority ctrl_pb.PriorityClass) error {
+	return self.schedulePayloadMsg(msg.Marshall(), priority)
+}
+
+// SendAcknowledgement sends acks straight to the ack channel. They don't compete with payloads there, so they don't
+// need to be scheduled by priority class.
 func (self *splitImpl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
 	sent, err := self.ackCh.TrySend(msg.Marshall())
 	if err == nil && !sent {
@@ -86,8 +92,16 @@ func (self *splitImpl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
 	return err
 }
 
+func (self *splitImpl) SendAcknowledgementWithPriority(msg *xgress.Acknowledgement, _ ctrl_pb.PriorityClass) error {
+	return self.SendAcknowledgement(msg)
+}
+
 func (self *splitImpl) SendControl(msg *xgress.Control) error {
-	sent, err := self.payloadCh.TrySend(msg.Marshall())
+	return self.schedulePayloadMsg(msg.Marshall(), ctrl_pb.PriorityClass_PriorityDefault)
+}
+
+func (self *splitImpl) schedulePayloadMsg(msg *channel.Message, priority ctrl_pb.PriorityClass) error {
+	sent, err := self.scheduler.TrySend(msg, priority)
 	if err == nil && !sent {
 		self.droppedMsgMeter.Mark(1)
 	}
@@ -110,6 +124,7 @@ func (self *splitImpl) Close() error {
 	if self.droppedMsgMeter != nil {
 		self.droppedMsgMeter.Dispose()
 	}
+	self.scheduler.Close()
 	var err, err2 error
 	if self.payloadCh != nil {
 		err = self.payloadCh.Close()
@@ -172,6 +187,7 @@ func (self *splitImpl) InspectLink() *inspect.LinkInspectDetail {
 		Dest:        self.DestinationId(),
 		DestVersion: self.DestVersion(),
 		Dialed:      self.dialed,
+		QueuedMsgs:  self.scheduler.QueuedMsgs(),
 	}
 }
 
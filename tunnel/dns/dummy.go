@@ -29,6 +29,12 @@ func (d dummy) RemoveHostname(_ string) net.IP {
 func (d dummy) RemoveDomain(_ string) {
 }
 
+func (d dummy) AddService(_ string, _ *ServiceInfo) {
+}
+
+func (d dummy) RemoveService(_ string, _ string) {
+}
+
 func (d dummy) Cleanup() error {
 	return nil
 }
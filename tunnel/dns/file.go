@@ -56,6 +56,10 @@ func (h *hostFile) AddDomain(name string, _ func(string) (net.IP, error)) error
 
 func (h *hostFile) RemoveDomain(string) {}
 
+func (h *hostFile) AddService(string, *ServiceInfo) {}
+
+func (h *hostFile) RemoveService(string, string) {}
+
 func (h *hostFile) Lookup(_ net.IP) (string, error) {
 	return "", fmt.Errorf("not implemented")
 }
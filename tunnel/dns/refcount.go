@@ -29,6 +29,14 @@ func (self *RefCountingResolver) RemoveDomain(name string) {
 	self.wrapped.RemoveDomain(name)
 }
 
+func (self *RefCountingResolver) AddService(hostname string, info *ServiceInfo) {
+	self.wrapped.AddService(hostname, info)
+}
+
+func (self *RefCountingResolver) RemoveService(hostname string, serviceName string) {
+	self.wrapped.RemoveService(hostname, serviceName)
+}
+
 func (self *RefCountingResolver) AddHostname(s string, ip net.IP) error {
 	err := self.wrapped.AddHostname(s, ip)
 	if err != nil {
@@ -16,17 +16,90 @@
 
 package dns
 
-import "net"
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
 
 type Resolver interface {
 	AddHostname(string, net.IP) error
 	AddDomain(string, func(string) (net.IP, error)) error
+	AddService(string, *ServiceInfo)
 	Lookup(net.IP) (string, error)
 	RemoveHostname(string) net.IP
 	RemoveDomain(string)
+	RemoveService(string, string)
 	Cleanup() error
 }
 
+// MaxServiceSrvRecords limits the SRV records returned for a service, so services intercepting large port ranges
+// don't produce answers too big to be useful. Ports beyond the limit are still listed in the TXT record
+const MaxServiceSrvRecords = 64
+
+// PortRange is an inclusive range of intercepted ports
+type PortRange struct {
+	Low  uint16
+	High uint16
+}
+
+func (self PortRange) String() string {
+	if self.High <= self.Low {
+		return fmt.Sprintf("%d", self.Low)
+	}
+	return fmt.Sprintf("%d-%d", self.Low, self.High)
+}
+
+// ServiceInfo describes a service intercepted on a hostname. It's published as a TXT record on the hostname, listing
+// all port ranges, and as SRV records at _<service>._<protocol>.<hostname>, where the service label is the lower-cased
+// service name with characters other than letters, digits and hyphens replaced by hyphens. There's an SRV record for
+// each intercepted port, up to MaxServiceSrvRecords
+type ServiceInfo struct {
+	Name       string
+	Protocols  []string
+	PortRanges []PortRange
+}
+
+// srvPorts returns the ports to publish as SRV records, in port range order, up to MaxServiceSrvRecords
+func (self *ServiceInfo) srvPorts() []uint16 {
+	var result []uint16
+	for _, portRange := range self.PortRanges {
+		for port := uint32(portRange.Low); port <= uint32(max(portRange.Low, portRange.High)); port++ {
+			if len(result) == MaxServiceSrvRecords {
+				return result
+			}
+			result = append(result, uint16(port))
+		}
+	}
+	return result
+}
+
+func (self *ServiceInfo) label() string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(self.Name))
+}
+
+func (self *ServiceInfo) txt() []string {
+	protocols := append([]string(nil), self.Protocols...)
+	sort.Strings(protocols)
+
+	var ports []string
+	for _, portRange := range self.PortRanges {
+		ports = append(ports, portRange.String())
+	}
+
+	return []string{
+		"service=" + self.Name,
+		"protocols=" + strings.Join(protocols, ","),
+		"ports=" + strings.Join(ports, ","),
+	}
+}
+
 type domainEntry struct {
 	name  string
 	getIP func(string) (net.IP, error)
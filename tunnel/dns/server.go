@@ -20,10 +20,13 @@ import (
 	"errors"
 	"fmt"
 	"github.com/miekg/dns"
+	"github.com/openziti/foundation/v2/stringz"
 	"github.com/sirupsen/logrus"
 	"net"
 	"net/url"
 	"os/exec"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -31,13 +34,39 @@ import (
 
 var log = logrus.StandardLogger()
 
+const (
+	DefaultTtl             = 60 * time.Second
+	DefaultUpstreamTimeout = 2 * time.Second
+)
+
+var errNotFound = errors.New("not found")
+
+// DnsServerOptions configures the internal dns server. Names which aren't intercepted are forwarded to the upstream
+// servers, in order, if any are configured. Otherwise they are refused, so the client moves on to its next server
+type DnsServerOptions struct {
+	Ttl             time.Duration
+	Upstreams       []string
+	UpstreamTimeout time.Duration
+}
+
+func DefaultDnsServerOptions() *DnsServerOptions {
+	return &DnsServerOptions{
+		Ttl:             DefaultTtl,
+		UpstreamTimeout: DefaultUpstreamTimeout,
+	}
+}
+
 type resolver struct {
 	server     *dns.Server
 	names      map[string]net.IP
 	ips        map[string]string
+	services   map[string]map[string]*ServiceInfo
 	namesMtx   sync.Mutex
 	domains    map[string]*domainEntry
 	domainsMtx sync.Mutex
+	ttl        uint32
+	upstreams  []string
+	client     *dns.Client
 }
 
 func flushDnsCaches() {
@@ -75,7 +104,11 @@ func NewResolver(config string) (Resolver, error) {
 	case "", "file":
 		return NewRefCountingResolver(NewHostFile(resolverURL.Path)), nil
 	case "udp":
-		dnsResolver, err := NewDnsServer(resolverURL.Host)
+		options, err := parseDnsServerOptions(resolverURL.Query())
+		if err != nil {
+			return nil, fmt.Errorf("invalid resolver configuration '%s': %w", config, err)
+		}
+		dnsResolver, err := NewDnsServerWithOptions(resolverURL.Host, options)
 		if err != nil {
 			return nil, err
 		}
@@ -85,24 +118,68 @@ func NewResolver(config string) (Resolver, error) {
 	return nil, fmt.Errorf("invalid resolver configuration '%s'. must be 'file://' or 'udp://' URL", config)
 }
 
+// parseDnsServerOptions reads options from the resolver URL query, for example
+// udp://127.0.0.1:53?ttl=30&upstream=1.1.1.1&upstream=8.8.8.8:53&upstreamTimeout=1s
+func parseDnsServerOptions(values url.Values) (*DnsServerOptions, error) {
+	options := DefaultDnsServerOptions()
+
+	if val := values.Get("ttl"); val != "" {
+		seconds, err := strconv.ParseUint(val, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ttl '%s', must be a number of seconds", val)
+		}
+		options.Ttl = time.Duration(seconds) * time.Second
+	}
+
+	for _, upstream := range values["upstream"] {
+		if _, _, err := net.SplitHostPort(upstream); err != nil {
+			upstream = net.JoinHostPort(strings.Trim(upstream, "[]"), "53")
+		}
+		options.Upstreams = append(options.Upstreams, upstream)
+	}
+
+	if val := values.Get("upstreamTimeout"); val != "" {
+		timeout, err := time.ParseDuration(val)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("invalid upstreamTimeout '%s', must be a positive duration", val)
+		}
+		options.UpstreamTimeout = timeout
+	}
+
+	return options, nil
+}
+
 func NewDnsServer(addr string) (Resolver, error) {
+	return NewDnsServerWithOptions(addr, DefaultDnsServerOptions())
+}
+
+func newResolver(options *DnsServerOptions) *resolver {
+	return &resolver{
+		names:     make(map[string]net.IP),
+		ips:       make(map[string]string),
+		services:  make(map[string]map[string]*ServiceInfo),
+		domains:   make(map[string]*domainEntry),
+		ttl:       uint32(options.Ttl / time.Second),
+		upstreams: options.Upstreams,
+		client:    &dns.Client{Net: "udp", Timeout: options.UpstreamTimeout},
+	}
+}
+
+func NewDnsServerWithOptions(addr string, options *DnsServerOptions) (Resolver, error) {
 	log.Infof("starting dns server...")
 	s := &dns.Server{
 		Addr: addr,
 		Net:  "udp",
 	}
 
-	names := make(map[string]net.IP)
-	r := &resolver{
-		server:     s,
-		names:      names,
-		ips:        make(map[string]string),
-		namesMtx:   sync.Mutex{},
-		domains:    make(map[string]*domainEntry),
-		domainsMtx: sync.Mutex{},
-	}
+	r := newResolver(options)
+	r.server = s
 	s.Handler = r
 
+	if len(r.upstreams) > 0 {
+		log.Infof("dns server forwarding unknown names to %v", r.upstreams)
+	}
+
 	errChan := make(chan error)
 	go func() {
 		errChan <- s.ListenAndServe()
@@ -196,38 +273,213 @@ func (r *resolver) getAddress(name string) (net.IP, error) {
 		}
 	}
 
-	return nil, errors.New("not found")
+	return nil, errNotFound
+}
+
+// isDomainName returns true if the name falls under one of the intercepted wildcard domains
+func (r *resolver) isDomainName(name string) bool {
+	canonical := strings.ToLower(name)
+
+	r.domainsMtx.Lock()
+	defer r.domainsMtx.Unlock()
+	for {
+		idx := strings.IndexByte(canonical[1:], '.')
+		if idx < 0 {
+			return false
+		}
+		canonical = canonical[idx+1:]
+		if _, ok := r.domains[canonical]; ok {
+			return true
+		}
+	}
+}
+
+// isManaged returns true if the resolver is authoritative for the given name
+func (r *resolver) isManaged(name string) bool {
+	if _, found := r.getHostnameIp(name); found {
+		return true
+	}
+	return r.isDomainName(name)
 }
 
 func (r *resolver) ServeDNS(w dns.ResponseWriter, query *dns.Msg) {
 	log.Tracef("received:\n%s\n", query.String())
-	msg := dns.Msg{}
+	msg := r.resolve(query)
+
+	size := dns.MinMsgSize
+	if opt := query.IsEdns0(); opt != nil {
+		size = int(opt.UDPSize())
+	}
+	msg.Truncate(size)
+
+	log.Tracef("response:\n%s\n", msg.String())
+	err := w.WriteMsg(msg)
+	if err != nil {
+		log.Errorf("write failed: %s", err)
+	}
+}
+
+func (r *resolver) resolve(query *dns.Msg) *dns.Msg {
+	msg := &dns.Msg{}
 	msg.SetReply(query)
-	msg.RecursionAvailable = false
-	msg.Rcode = dns.RcodeRefused
+	msg.RecursionAvailable = len(r.upstreams) > 0
+
+	if len(query.Question) != 1 {
+		msg.Rcode = dns.RcodeFormatError
+		return msg
+	}
+
 	q := query.Question[0]
+	answers, rcode, managed := r.answer(q)
+	if !managed {
+		if len(r.upstreams) > 0 && query.RecursionDesired {
+			return r.forward(query)
+		}
+		msg.Rcode = dns.RcodeRefused // fail fast, and inspire resolver to query next name server in its list.
+		return msg
+	}
+
+	msg.Authoritative = rcode != dns.RcodeServerFailure
+	msg.Rcode = rcode
+	msg.Answer = answers
+	return msg
+}
+
+// answer returns the records for the question, if the name is intercepted. Intercepted names without records of
+// the requested type get an empty successful answer, while names which don't exist below an intercepted hostname
+// get NXDOMAIN
+func (r *resolver) answer(q dns.Question) ([]dns.RR, int, bool) {
+	name := q.Name
 	switch q.Qtype {
-	case dns.TypeA:
-		name := q.Name
-		address, err := r.getAddress(name)
-		if err == nil {
-			msg.Authoritative = true
-			msg.Rcode = dns.RcodeSuccess
-			answer := &dns.A{
-				Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
-				A:   address,
-			}
-			msg.Answer = append(msg.Answer, answer)
-		} else {
-			msg.Rcode = dns.RcodeRefused // fail fast, and inspire resolver to query next name server in its list.
+	case dns.TypeA, dns.TypeAAAA:
+		ip, err := r.getAddress(name)
+		if errors.Is(err, errNotFound) {
+			return nil, dns.RcodeSuccess, false
 		}
+		if err != nil {
+			log.WithError(err).Errorf("failed to assign address for %s", name)
+			return nil, dns.RcodeServerFailure, true
+		}
+		if ip4 := ip.To4(); ip4 != nil && q.Qtype == dns.TypeA {
+			return []dns.RR{&dns.A{Hdr: r.header(name, dns.TypeA), A: ip4}}, dns.RcodeSuccess, true
+		}
+		if ip.To4() == nil && q.Qtype == dns.TypeAAAA {
+			return []dns.RR{&dns.AAAA{Hdr: r.header(name, dns.TypeAAAA), AAAA: ip}}, dns.RcodeSuccess, true
+		}
+		return nil, dns.RcodeSuccess, true
+	case dns.TypeSRV:
+		return r.answerSrv(name)
+	case dns.TypeTXT:
+		if !r.isManaged(name) {
+			return nil, dns.RcodeSuccess, false
+		}
+		var answers []dns.RR
+		for _, info := range r.getServices(name) {
+			answers = append(answers, &dns.TXT{Hdr: r.header(name, dns.TypeTXT), Txt: info.txt()})
+		}
+		return answers, dns.RcodeSuccess, true
+	default:
+		return nil, dns.RcodeSuccess, r.isManaged(name)
 	}
-	log.Tracef("response:\n%s\n", msg.String())
-	err := w.WriteMsg(&msg)
-	if err != nil {
-		log.Errorf("write failed: %s", err)
+}
+
+func (r *resolver) answerSrv(name string) ([]dns.RR, int, bool) {
+	labels := dns.SplitDomainName(name)
+	if len(labels) < 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+		return nil, dns.RcodeSuccess, r.isManaged(name)
+	}
+
+	hostname := dns.Fqdn(strings.Join(labels[2:], "."))
+	if _, found := r.getHostnameIp(hostname); !found {
+		return nil, dns.RcodeSuccess, r.isManaged(name)
+	}
+
+	service := strings.ToLower(labels[0][1:])
+	protocol := strings.ToLower(labels[1][1:])
+
+	var answers []dns.RR
+	for _, info := range r.getServices(hostname) {
+		if info.label() != service || !stringz.Contains(info.Protocols, protocol) {
+			continue
+		}
+		for _, port := range info.srvPorts() {
+			answers = append(answers, &dns.SRV{
+				Hdr:    r.header(name, dns.TypeSRV),
+				Port:   port,
+				Target: strings.ToLower(hostname),
+			})
+		}
 	}
 
+	if len(answers) == 0 {
+		return nil, dns.RcodeNameError, true
+	}
+	return answers, dns.RcodeSuccess, true
+}
+
+func (r *resolver) header(name string, rrtype uint16) dns.RR_Header {
+	return dns.RR_Header{Name: name, Rrtype: rrtype, Class: dns.ClassINET, Ttl: r.ttl}
+}
+
+func (r *resolver) forward(query *dns.Msg) *dns.Msg {
+	for _, upstream := range r.upstreams {
+		resp, _, err := r.client.Exchange(query, upstream)
+		if err == nil && resp.Truncated {
+			tcpClient := *r.client
+			tcpClient.Net = "tcp"
+			resp, _, err = tcpClient.Exchange(query, upstream)
+		}
+		if err != nil {
+			log.WithError(err).Debugf("failed to forward query for %s to %s", query.Question[0].Name, upstream)
+			continue
+		}
+		return resp
+	}
+
+	msg := &dns.Msg{}
+	msg.SetRcode(query, dns.RcodeServerFailure)
+	msg.RecursionAvailable = true
+	return msg
+}
+
+func (r *resolver) getServices(name string) []*ServiceInfo {
+	r.namesMtx.Lock()
+	defer r.namesMtx.Unlock()
+
+	var result []*ServiceInfo
+	for _, info := range r.services[strings.ToLower(name)] {
+		result = append(result, info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func (r *resolver) AddService(hostname string, info *ServiceInfo) {
+	r.namesMtx.Lock()
+	defer r.namesMtx.Unlock()
+
+	canonical := strings.ToLower(hostname) + "."
+	services, found := r.services[canonical]
+	if !found {
+		services = map[string]*ServiceInfo{}
+		r.services[canonical] = services
+	}
+	services[info.Name] = info
+}
+
+func (r *resolver) RemoveService(hostname string, serviceName string) {
+	r.namesMtx.Lock()
+	defer r.namesMtx.Unlock()
+
+	canonical := strings.ToLower(hostname) + "."
+	if services, found := r.services[canonical]; found {
+		delete(services, serviceName)
+		if len(services) == 0 {
+			delete(r.services, canonical)
+		}
+	}
 }
 
 func (r *resolver) AddDomain(name string, ipCB func(string) (net.IP, error)) error {
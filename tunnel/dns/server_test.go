@@ -0,0 +1,211 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package dns
+
+import (
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQuery(name string, qtype uint16) *dns.Msg {
+	query := &dns.Msg{}
+	query.SetQuestion(dns.Fqdn(name), qtype)
+	return query
+}
+
+func newTestResolver(t *testing.T, options *DnsServerOptions) *resolver {
+	r := newResolver(options)
+	require.NoError(t, r.AddHostname("db.ziti", net.ParseIP("100.64.0.2")))
+	require.NoError(t, r.AddHostname("v6.ziti", net.ParseIP("fd00::2")))
+	require.NoError(t, r.AddDomain("*.wild.ziti", func(string) (net.IP, error) {
+		return net.ParseIP("100.64.0.3"), nil
+	}))
+	r.AddService("db.ziti", &ServiceInfo{Name: "My DB", Protocols: []string{"tcp"}, PortRanges: []PortRange{{Low: 5432, High: 5433}}})
+	return r
+}
+
+func TestResolveAddresses(t *testing.T) {
+	req := require.New(t)
+	options := DefaultDnsServerOptions()
+	options.Ttl = 30 * time.Second
+	r := newTestResolver(t, options)
+
+	msg := r.resolve(newTestQuery("DB.ziti", dns.TypeA))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.True(msg.Authoritative)
+	req.Len(msg.Answer, 1)
+	req.Equal("100.64.0.2", msg.Answer[0].(*dns.A).A.String())
+	req.Equal(uint32(30), msg.Answer[0].Header().Ttl)
+
+	msg = r.resolve(newTestQuery("db.ziti", dns.TypeAAAA))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.True(msg.Authoritative)
+	req.Empty(msg.Answer)
+
+	msg = r.resolve(newTestQuery("v6.ziti", dns.TypeAAAA))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.Len(msg.Answer, 1)
+	req.Equal("fd00::2", msg.Answer[0].(*dns.AAAA).AAAA.String())
+
+	msg = r.resolve(newTestQuery("v6.ziti", dns.TypeA))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.Empty(msg.Answer)
+
+	msg = r.resolve(newTestQuery("host.wild.ziti", dns.TypeA))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.Len(msg.Answer, 1)
+	req.Equal("100.64.0.3", msg.Answer[0].(*dns.A).A.String())
+
+	msg = r.resolve(newTestQuery("db.ziti", dns.TypeMX))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.True(msg.Authoritative)
+	req.Empty(msg.Answer)
+
+	msg = r.resolve(newTestQuery("example.com", dns.TypeA))
+	req.Equal(dns.RcodeRefused, msg.Rcode)
+	req.Empty(msg.Answer)
+}
+
+func TestResolveServiceRecords(t *testing.T) {
+	req := require.New(t)
+	r := newTestResolver(t, DefaultDnsServerOptions())
+
+	msg := r.resolve(newTestQuery("_my-db._tcp.db.ziti", dns.TypeSRV))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.Len(msg.Answer, 2)
+	srv := msg.Answer[0].(*dns.SRV)
+	req.Equal(uint16(5432), srv.Port)
+	req.Equal("db.ziti.", srv.Target)
+	req.Equal(uint32(60), srv.Hdr.Ttl)
+
+	msg = r.resolve(newTestQuery("_my-db._udp.db.ziti", dns.TypeSRV))
+	req.Equal(dns.RcodeNameError, msg.Rcode)
+	req.True(msg.Authoritative)
+
+	msg = r.resolve(newTestQuery("_other._tcp.db.ziti", dns.TypeSRV))
+	req.Equal(dns.RcodeNameError, msg.Rcode)
+
+	msg = r.resolve(newTestQuery("_my-db._tcp.example.com", dns.TypeSRV))
+	req.Equal(dns.RcodeRefused, msg.Rcode)
+
+	msg = r.resolve(newTestQuery("db.ziti", dns.TypeTXT))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.Len(msg.Answer, 1)
+	req.Equal([]string{"service=My DB", "protocols=tcp", "ports=5432-5433"}, msg.Answer[0].(*dns.TXT).Txt)
+
+	r.RemoveService("db.ziti", "My DB")
+	msg = r.resolve(newTestQuery("db.ziti", dns.TypeTXT))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.Empty(msg.Answer)
+}
+
+func TestResolveForwarding(t *testing.T) {
+	req := require.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	req.NoError(err)
+
+	upstream := &dns.Server{
+		PacketConn: conn,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, query *dns.Msg) {
+			msg := &dns.Msg{}
+			msg.SetReply(query)
+			if query.Question[0].Name == "example.com." {
+				msg.Answer = append(msg.Answer, &dns.A{
+					Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 300},
+					A:   net.ParseIP("93.184.216.34"),
+				})
+			} else {
+				msg.Rcode = dns.RcodeNameError
+			}
+			_ = w.WriteMsg(msg)
+		}),
+	}
+	go func() { _ = upstream.ActivateAndServe() }()
+	defer func() { _ = upstream.Shutdown() }()
+
+	options := DefaultDnsServerOptions()
+	options.Upstreams = []string{conn.LocalAddr().String()}
+	r := newTestResolver(t, options)
+
+	msg := r.resolve(newTestQuery("example.com", dns.TypeA))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.Len(msg.Answer, 1)
+	req.Equal(uint32(300), msg.Answer[0].Header().Ttl)
+
+	msg = r.resolve(newTestQuery("missing.example.com", dns.TypeA))
+	req.Equal(dns.RcodeNameError, msg.Rcode)
+
+	// intercepted names are still answered locally
+	msg = r.resolve(newTestQuery("db.ziti", dns.TypeA))
+	req.Equal(dns.RcodeSuccess, msg.Rcode)
+	req.True(msg.Authoritative)
+	req.True(msg.RecursionAvailable)
+	req.Len(msg.Answer, 1)
+
+	options.Upstreams = []string{"127.0.0.1:1"}
+	options.UpstreamTimeout = 100 * time.Millisecond
+	r = newTestResolver(t, options)
+	msg = r.resolve(newTestQuery("example.com", dns.TypeA))
+	req.Equal(dns.RcodeServerFailure, msg.Rcode)
+}
+
+func TestParseDnsServerOptions(t *testing.T) {
+	req := require.New(t)
+
+	values, err := url.ParseQuery("ttl=10&upstream=1.1.1.1&upstream=8.8.8.8:5353&upstream=[2606:4700::1111]&upstreamTimeout=500ms")
+	req.NoError(err)
+
+	options, err := parseDnsServerOptions(values)
+	req.NoError(err)
+	req.Equal(10*time.Second, options.Ttl)
+	req.Equal([]string{"1.1.1.1:53", "8.8.8.8:5353", "[2606:4700::1111]:53"}, options.Upstreams)
+	req.Equal(500*time.Millisecond, options.UpstreamTimeout)
+
+	options, err = parseDnsServerOptions(url.Values{})
+	req.NoError(err)
+	req.Equal(DefaultTtl, options.Ttl)
+	req.Empty(options.Upstreams)
+
+	_, err = parseDnsServerOptions(url.Values{"ttl": []string{"-1"}})
+	req.Error(err)
+
+	_, err = parseDnsServerOptions(url.Values{"upstreamTimeout": []string{"soon"}})
+	req.Error(err)
+}
+
+func TestServiceInfoPortRanges(t *testing.T) {
+	req := require.New(t)
+
+	info := &ServiceInfo{Name: "web", Protocols: []string{"udp", "tcp"}, PortRanges: []PortRange{{Low: 443, High: 443}, {Low: 8000, High: 8002}, {Low: 9000}}}
+	req.Equal([]uint16{443, 8000, 8001, 8002, 9000}, info.srvPorts())
+	req.Equal([]string{"service=web", "protocols=tcp,udp", "ports=443,8000-8002,9000"}, info.txt())
+
+	// large ranges are truncated in SRV records, but the TXT record still lists them in full
+	info = &ServiceInfo{Name: "all", Protocols: []string{"tcp"}, PortRanges: []PortRange{{Low: 22, High: 22}, {Low: 1, High: 65535}}}
+	ports := info.srvPorts()
+	req.Len(ports, MaxServiceSrvRecords)
+	req.Equal(uint16(22), ports[0])
+	req.Equal(uint16(1), ports[1])
+	req.Equal(uint16(MaxServiceSrvRecords-1), ports[MaxServiceSrvRecords-1])
+	req.Equal("ports=22,1-65535", info.txt()[2])
+}
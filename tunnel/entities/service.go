@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/mitchellh/mapstructure"
@@ -13,6 +15,7 @@ import (
 	"github.com/openziti/ziti/tunnel/utils"
 	"github.com/pkg/errors"
 	"net"
+	"os"
 	"reflect"
 	"strconv"
 	"strings"
@@ -34,6 +37,10 @@ type ServiceConfig struct {
 	Port       int
 	PortChecks []*health.PortCheckDefinition
 	HttpChecks []*health.HttpCheckDefinition
+	TlsChecks  []*health.TlsCheckDefinition
+	GrpcChecks []*health.GrpcCheckDefinition
+	DnsChecks  []*health.DnsCheckDefinition
+	ExecChecks []*health.ExecCheckDefinition
 }
 
 func (self *ServiceConfig) GetPortChecks() []*health.PortCheckDefinition {
@@ -44,6 +51,22 @@ func (self *ServiceConfig) GetHttpChecks() []*health.HttpCheckDefinition {
 	return self.HttpChecks
 }
 
+func (self *ServiceConfig) GetTlsChecks() []*health.TlsCheckDefinition {
+	return self.TlsChecks
+}
+
+func (self *ServiceConfig) GetGrpcChecks() []*health.GrpcCheckDefinition {
+	return self.GrpcChecks
+}
+
+func (self *ServiceConfig) GetDnsChecks() []*health.DnsCheckDefinition {
+	return self.DnsChecks
+}
+
+func (self *ServiceConfig) GetExecChecks() []*health.ExecCheckDefinition {
+	return self.ExecChecks
+}
+
 func (s *ServiceConfig) String() string {
 	return fmt.Sprintf("%v:%v:%v", s.Protocol, s.Hostname, s.Port)
 }
@@ -63,6 +86,10 @@ func (self *ServiceConfig) ToHostV2Config() *HostV2Config {
 		Port:       self.Port,
 		PortChecks: self.PortChecks,
 		HttpChecks: self.HttpChecks,
+		TlsChecks:  self.TlsChecks,
+		GrpcChecks: self.GrpcChecks,
+		DnsChecks:  self.DnsChecks,
+		ExecChecks: self.ExecChecks,
 	}
 
 	return &HostV2Config{
@@ -96,9 +123,14 @@ type HostV1Config struct {
 
 	PortChecks []*health.PortCheckDefinition
 	HttpChecks []*health.HttpCheckDefinition
+	TlsChecks  []*health.TlsCheckDefinition
+	GrpcChecks []*health.GrpcCheckDefinition
+	DnsChecks  []*health.DnsCheckDefinition
+	ExecChecks []*health.ExecCheckDefinition
 
 	ListenOptions *HostV1ListenOptions
 	Proxy         *ProxyConfiguration
+	Tls           *TlsConfiguration
 
 	allowedAddrs []allowedAddress
 }
@@ -172,8 +204,101 @@ func makeAllowedAddress(addr string) (allowedAddress, error) {
 }
 
 type ProxyConfiguration struct {
-	Address string
-	Type    string
+	Address  string
+	Type     string
+	Username string
+	Password string
+}
+
+const (
+	TlsVerifyFull = "full"
+	TlsVerifyCa   = "ca"
+	TlsVerifyNone = "none"
+)
+
+// TlsConfiguration defines how connections to the hosted server are wrapped in TLS. Certificate and key files are
+// read from the hosting tunneler's file system
+type TlsConfiguration struct {
+	ServerName string
+	Ca         string
+	CaFile     string
+	CertFile   string
+	KeyFile    string
+	VerifyMode string
+}
+
+func (self *TlsConfiguration) ToTlsConfig() (*tls.Config, error) {
+	result := &tls.Config{
+		ServerName: self.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if self.Ca != "" || self.CaFile != "" {
+		result.RootCAs = x509.NewCertPool()
+		if self.Ca != "" && !result.RootCAs.AppendCertsFromPEM([]byte(self.Ca)) {
+			return nil, errors.New("no valid certificates found in tls ca")
+		}
+		if self.CaFile != "" {
+			pem, err := os.ReadFile(self.CaFile)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to read tls ca file %s", self.CaFile)
+			}
+			if !result.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, errors.Errorf("no valid certificates found in tls ca file %s", self.CaFile)
+			}
+		}
+	}
+
+	if self.CertFile != "" || self.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(self.CertFile, self.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load tls client certificate")
+		}
+		result.Certificates = []tls.Certificate{cert}
+	}
+
+	switch self.VerifyMode {
+	case "", TlsVerifyFull:
+	case TlsVerifyCa:
+		// verify the chain against the configured roots, but not the server name
+		roots := result.RootCAs
+		result.InsecureSkipVerify = true
+		result.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyCertChain(rawCerts, roots)
+		}
+	case TlsVerifyNone:
+		result.InsecureSkipVerify = true
+	default:
+		return nil, errors.Errorf("invalid tls verify mode '%s'", self.VerifyMode)
+	}
+
+	return result, nil
+}
+
+func verifyCertChain(rawCerts [][]byte, roots *x509.CertPool) error {
+	if len(rawCerts) == 0 {
+		return errors.New("server presented no certificates")
+	}
+
+	var certs []*x509.Certificate
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return errors.Wrap(err, "unable to parse server certificate")
+		}
+		certs = append(certs, cert)
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(opts)
+	return err
 }
 
 func (self *HostV1Config) GetDialTimeout(defaultTimeout time.Duration) time.Duration {
@@ -213,6 +338,22 @@ func (self *HostV1Config) GetHttpChecks() []*health.HttpCheckDefinition {
 	return self.HttpChecks
 }
 
+func (self *HostV1Config) GetTlsChecks() []*health.TlsCheckDefinition {
+	return self.TlsChecks
+}
+
+func (self *HostV1Config) GetGrpcChecks() []*health.GrpcCheckDefinition {
+	return self.GrpcChecks
+}
+
+func (self *HostV1Config) GetDnsChecks() []*health.DnsCheckDefinition {
+	return self.DnsChecks
+}
+
+func (self *HostV1Config) GetExecChecks() []*health.ExecCheckDefinition {
+	return self.ExecChecks
+}
+
 func (self *HostV1Config) getValue(options map[string]interface{}, key string) (string, error) {
 	val, ok := options[key]
 	if !ok {
@@ -0,0 +1,165 @@
+package health
+
+import (
+	"context"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func executeCheck(t *testing.T, def CheckDefinition) (interface{}, error) {
+	check, err := def.CreateCheck("test")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return check.Execute(ctx)
+}
+
+func Test_TlsCheck(t *testing.T) {
+	req := require.New(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	address := server.Listener.Addr().String()
+
+	_, err := executeCheck(t, &TlsCheckDefinition{Address: address})
+	req.Error(err, "untrusted server certificate should fail")
+
+	details, err := executeCheck(t, &TlsCheckDefinition{
+		Address:          address,
+		TlsClientOptions: TlsClientOptions{Ca: ca, ServerName: "example.com"},
+		ExpiryThreshold:  24 * time.Hour,
+	})
+	req.NoError(err)
+	req.Contains(details, "notAfter")
+
+	_, err = executeCheck(t, &TlsCheckDefinition{
+		Address:          address,
+		TlsClientOptions: TlsClientOptions{SkipVerify: true},
+		ExpiryThreshold:  time.Until(server.Certificate().NotAfter) + time.Hour,
+	})
+	req.ErrorContains(err, "within the threshold")
+}
+
+func Test_DnsCheck(t *testing.T) {
+	req := require.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	req.NoError(err)
+
+	handler := dns.HandlerFunc(func(w dns.ResponseWriter, query *dns.Msg) {
+		resp := &dns.Msg{}
+		resp.SetReply(query)
+		if query.Question[0].Name == "svc.example.com." {
+			rr, _ := dns.NewRR("svc.example.com. 60 IN A 10.1.2.3")
+			resp.Answer = append(resp.Answer, rr)
+		} else {
+			resp.Rcode = dns.RcodeNameError
+		}
+		_ = w.WriteMsg(resp)
+	})
+
+	server := &dns.Server{PacketConn: conn, Handler: handler}
+	go func() { _ = server.ActivateAndServe() }()
+	defer func() { _ = server.Shutdown() }()
+
+	address := conn.LocalAddr().String()
+
+	_, err = executeCheck(t, &DnsCheckDefinition{Server: address, Name: "svc.example.com", Expect: "10.1.2.3"})
+	req.NoError(err)
+
+	_, err = executeCheck(t, &DnsCheckDefinition{Server: address, Name: "svc.example.com", Expect: "10.9.9.9"})
+	req.Error(err)
+
+	_, err = executeCheck(t, &DnsCheckDefinition{Server: address, Name: "missing.example.com"})
+	req.ErrorContains(err, "NXDOMAIN")
+
+	_, err = (&DnsCheckDefinition{Server: address, Name: "svc.example.com", Type: "BOGUS"}).CreateCheck("test")
+	req.Error(err)
+}
+
+func Test_GrpcCheck(t *testing.T) {
+	req := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	req.NoError(err)
+
+	healthServer := grpchealth.NewServer()
+	healthServer.SetServingStatus("ok", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("down", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	address := listener.Addr().String()
+
+	status, err := executeCheck(t, &GrpcCheckDefinition{Address: address, Service: "ok"})
+	req.NoError(err)
+	req.Equal("SERVING", status)
+
+	_, err = executeCheck(t, &GrpcCheckDefinition{Address: address, Service: "down"})
+	req.Error(err)
+}
+
+func Test_ExecCheck(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("exec check test requires unix commands")
+	}
+	req := require.New(t)
+
+	truePath, err := exec.LookPath("true")
+	req.NoError(err)
+	falsePath, err := exec.LookPath("false")
+	req.NoError(err)
+
+	defer func() {
+		_ = SetExecCheckAllowList(nil)
+	}()
+
+	req.NoError(SetExecCheckAllowList(nil))
+	_, err = (&ExecCheckDefinition{Command: truePath}).CreateCheck("test")
+	req.Error(err, "exec checks should be disabled by default")
+
+	req.Error(SetExecCheckAllowList([]string{"true"}), "relative commands should not be allowed")
+	req.Error(SetExecCheckAllowList([]string{"*"}))
+	req.Error(SetExecCheckAllowList([]string{truePath + " 'unterminated"}))
+
+	req.NoError(SetExecCheckAllowList([]string{truePath, falsePath + " --check 'a b' *"}))
+
+	_, err = (&ExecCheckDefinition{Command: "true"}).CreateCheck("test")
+	req.Error(err, "relative commands should not be allowed")
+
+	_, err = (&ExecCheckDefinition{Command: truePath, Args: []string{"--extra"}}).CreateCheck("test")
+	req.Error(err, "entries without a wildcard should only allow the exact command line")
+
+	_, err = (&ExecCheckDefinition{Command: falsePath}).CreateCheck("test")
+	req.Error(err, "wildcard entries should require their own arguments")
+
+	_, err = (&ExecCheckDefinition{Command: falsePath, Args: []string{"--check", "a", "b"}}).CreateCheck("test")
+	req.Error(err, "quoted arguments should be matched as one argument")
+
+	_, err = (&ExecCheckDefinition{Command: falsePath, Args: []string{"--check", "a b", "-v"}}).CreateCheck("test")
+	req.NoError(err)
+
+	_, err = executeCheck(t, &ExecCheckDefinition{Command: truePath})
+	req.NoError(err)
+
+	_, err = executeCheck(t, &ExecCheckDefinition{Command: falsePath, Args: []string{"--check", "a b"}})
+	req.Error(err)
+}
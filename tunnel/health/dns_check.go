@@ -0,0 +1,99 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+type DnsCheckDefinition struct {
+	BaseCheckDefinition `mapstructure:",squash"`
+	Server              string
+	Name                string
+	Type                string
+	Expect              string
+}
+
+func (self *DnsCheckDefinition) String() string {
+	return fmt.Sprintf("dns-check server=%v, name=%v, type=%v, interval=%v, timeout=%v", self.Server, self.Name, self.Type, self.Interval, self.Timeout)
+}
+
+func (self *DnsCheckDefinition) GetType() string {
+	return "dns"
+}
+
+func (self *DnsCheckDefinition) CreateCheck(name string) (Check, error) {
+	qtype := dns.TypeA
+	if self.Type != "" {
+		var found bool
+		if qtype, found = dns.StringToType[strings.ToUpper(self.Type)]; !found {
+			return nil, errors.Errorf("invalid dns record type '%v'", self.Type)
+		}
+	}
+
+	server := self.Server
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(strings.Trim(server, "[]"), "53")
+	}
+
+	return &dnsCheck{
+		name:   name,
+		server: server,
+		query:  dns.Fqdn(self.Name),
+		qtype:  qtype,
+		expect: self.Expect,
+	}, nil
+}
+
+// dnsCheck queries the server and fails unless it answers successfully with at least one record. If expect is set,
+// one of the records must contain it
+type dnsCheck struct {
+	name   string
+	server string
+	query  string
+	qtype  uint16
+	expect string
+}
+
+func (self *dnsCheck) Name() string {
+	return self.name
+}
+
+func (self *dnsCheck) Execute(ctx context.Context) (interface{}, error) {
+	msg := &dns.Msg{}
+	msg.SetQuestion(self.query, self.qtype)
+
+	client := &dns.Client{}
+	resp, _, err := client.ExchangeContext(ctx, msg, self.server)
+	if err != nil {
+		return nil, errors.Wrapf(err, "dns query for %v to %v failed", self.query, self.server)
+	}
+
+	if resp.Rcode != dns.RcodeSuccess {
+		return nil, errors.Errorf("dns query for %v to %v returned %v", self.query, self.server, dns.RcodeToString[resp.Rcode])
+	}
+
+	var answers []string
+	for _, rr := range resp.Answer {
+		answers = append(answers, strings.TrimPrefix(rr.String(), rr.Header().String()))
+	}
+
+	if len(answers) == 0 {
+		return nil, errors.Errorf("dns query for %v to %v returned no answers", self.query, self.server)
+	}
+
+	if self.expect != "" {
+		for _, answer := range answers {
+			if strings.Contains(answer, self.expect) {
+				return answers, nil
+			}
+		}
+		return answers, errors.Errorf("dns answers for %v did not contain '%v'", self.query, self.expect)
+	}
+
+	return answers, nil
+}
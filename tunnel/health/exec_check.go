@@ -0,0 +1,163 @@
+package health
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/kballard/go-shellquote"
+	"github.com/pkg/errors"
+)
+
+const maxExecOutput = 4096
+
+// execAllowWildcard ends an allow list entry which permits any further arguments
+const execAllowWildcard = "*"
+
+var execAllowList atomic.Pointer[[]*execAllowEntry]
+
+// execAllowEntry is a parsed allow list entry. The check's command line must equal argv or, if prefix is set, start
+// with it
+type execAllowEntry struct {
+	argv   []string
+	prefix bool
+}
+
+func (self *execAllowEntry) allows(argv []string) bool {
+	if len(argv) < len(self.argv) || (!self.prefix && len(argv) != len(self.argv)) {
+		return false
+	}
+	return slices.Equal(self.argv, argv[:len(self.argv)])
+}
+
+// SetExecCheckAllowList sets the command lines which exec health checks may run.
+//
+// Exec checks are defined centrally, in service configs, and run on the hosting tunneler with its privileges. Anyone
+// who can edit the service's configs can choose which check runs, so the tunneler's allow list is the trust
+// boundary: an entry should only allow command lines which are safe to run with whatever arguments the entry lets a
+// config author choose. Each entry is a command line, split using shell quoting rules, whose first word is the
+// absolute path of the command. A check must match an entry's arguments exactly, unless the entry ends with a
+// separate *, in which case any further arguments are allowed. For example, `/usr/bin/pg_isready -h localhost` only
+// allows that command line, while `/usr/bin/pg_isready -h localhost *` also allows `-p 5432`. Exec checks are
+// disabled while the list is empty
+func SetExecCheckAllowList(commandLines []string) error {
+	var entries []*execAllowEntry
+	for _, commandLine := range commandLines {
+		argv, err := shellquote.Split(commandLine)
+		if err != nil {
+			return errors.Wrapf(err, "invalid exec health check allow list entry '%v'", commandLine)
+		}
+
+		entry := &execAllowEntry{argv: argv}
+		if len(argv) > 0 && argv[len(argv)-1] == execAllowWildcard {
+			entry.argv = argv[:len(argv)-1]
+			entry.prefix = true
+		}
+
+		if len(entry.argv) == 0 || !filepath.IsAbs(entry.argv[0]) {
+			return errors.Errorf("invalid exec health check allow list entry '%v', must start with an absolute command path", commandLine)
+		}
+		entry.argv[0] = filepath.Clean(entry.argv[0])
+		entries = append(entries, entry)
+	}
+	execAllowList.Store(&entries)
+	return nil
+}
+
+func isExecAllowed(command string, args []string) bool {
+	if !filepath.IsAbs(command) {
+		return false
+	}
+
+	argv := append([]string{filepath.Clean(command)}, args...)
+	if allowed := execAllowList.Load(); allowed != nil {
+		for _, entry := range *allowed {
+			if entry.allows(argv) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type ExecCheckDefinition struct {
+	BaseCheckDefinition `mapstructure:",squash"`
+	Command             string
+	Args                []string
+}
+
+func (self *ExecCheckDefinition) String() string {
+	return fmt.Sprintf("exec-check command=%v, args=%v, interval=%v, timeout=%v", self.Command, self.Args, self.Interval, self.Timeout)
+}
+
+func (self *ExecCheckDefinition) GetType() string {
+	return "exec"
+}
+
+func (self *ExecCheckDefinition) CreateCheck(name string) (Check, error) {
+	if !isExecAllowed(self.Command, self.Args) {
+		return nil, errors.Errorf("exec health check command '%v' with args %v is not in the tunneler's exec check allow list", self.Command, self.Args)
+	}
+
+	return &execCheck{
+		name:    name,
+		command: self.Command,
+		args:    self.Args,
+	}, nil
+}
+
+// execCheck runs a command, passing if it exits with status 0. The command is run directly, without a shell, with
+// an empty environment apart from a minimal PATH, no stdin, the temp directory as its working directory and in its
+// own process group, which is killed if the check times out
+type execCheck struct {
+	name    string
+	command string
+	args    []string
+}
+
+func (self *execCheck) Name() string {
+	return self.name
+}
+
+func (self *execCheck) Execute(ctx context.Context) (interface{}, error) {
+	output := &limitedBuffer{limit: maxExecOutput}
+
+	cmd := exec.CommandContext(ctx, self.command, self.args...)
+	cmd.Env = []string{"PATH=/usr/sbin:/usr/bin:/sbin:/bin"}
+	cmd.Dir = os.TempDir()
+	cmd.Stdout = output
+	cmd.Stderr = output
+	cmd.WaitDelay = time.Second
+	configureExecSandbox(cmd)
+
+	err := cmd.Run()
+	result := strings.TrimSpace(output.String())
+	if err != nil {
+		return result, errors.Wrapf(err, "exec health check '%v' failed", self.command)
+	}
+	return result, nil
+}
+
+// limitedBuffer keeps the first limit bytes written to it and discards the rest
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (self *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := self.limit - self.Len(); remaining > 0 {
+		if len(p) > remaining {
+			self.Buffer.Write(p[:remaining])
+		} else {
+			self.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
@@ -0,0 +1,16 @@
+//go:build !windows
+
+package health
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+func configureExecSandbox(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		// kill the whole process group, so children of the command don't outlive the check
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
@@ -0,0 +1,10 @@
+package health
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+func configureExecSandbox(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{HideWindow: true}
+}
@@ -0,0 +1,77 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type GrpcCheckDefinition struct {
+	BaseCheckDefinition `mapstructure:",squash"`
+	TlsClientOptions    `mapstructure:",squash"`
+	Address             string
+	Service             string
+	Tls                 bool
+}
+
+func (self *GrpcCheckDefinition) String() string {
+	return fmt.Sprintf("grpc-check address=%v, service=%v, tls=%v, interval=%v, timeout=%v", self.Address, self.Service, self.Tls, self.Interval, self.Timeout)
+}
+
+func (self *GrpcCheckDefinition) GetType() string {
+	return "grpc"
+}
+
+func (self *GrpcCheckDefinition) CreateCheck(name string) (Check, error) {
+	creds := insecure.NewCredentials()
+	if self.Tls {
+		tlsConfig, err := self.toTlsConfig(self.Address)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	return &grpcCheck{
+		name:    name,
+		address: self.Address,
+		service: self.Service,
+		creds:   creds,
+	}, nil
+}
+
+// grpcCheck calls the standard grpc.health.v1 Check method and fails unless the service reports SERVING
+type grpcCheck struct {
+	name    string
+	address string
+	service string
+	creds   credentials.TransportCredentials
+}
+
+func (self *grpcCheck) Name() string {
+	return self.name
+}
+
+func (self *grpcCheck) Execute(ctx context.Context) (interface{}, error) {
+	conn, err := grpc.NewClient(self.address, grpc.WithTransportCredentials(self.creds))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create grpc client for %v", self.address)
+	}
+	defer func() { _ = conn.Close() }()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: self.service})
+	if err != nil {
+		return nil, errors.Wrapf(err, "grpc health check of %v failed", self.address)
+	}
+
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return resp.Status.String(), errors.Errorf("grpc service '%v' at %v is %v", self.service, self.address, resp.Status)
+	}
+
+	return resp.Status.String(), nil
+}
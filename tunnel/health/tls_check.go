@@ -0,0 +1,118 @@
+package health
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TlsClientOptions configures the tls client used by tls and grpc checks
+type TlsClientOptions struct {
+	ServerName string
+	Ca         string
+	SkipVerify bool
+}
+
+func (self *TlsClientOptions) toTlsConfig(address string) (*tls.Config, error) {
+	result := &tls.Config{
+		ServerName:         self.ServerName,
+		InsecureSkipVerify: self.SkipVerify,
+	}
+
+	if result.ServerName == "" {
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			result.ServerName = host
+		}
+	}
+
+	if self.Ca != "" {
+		result.RootCAs = x509.NewCertPool()
+		if !result.RootCAs.AppendCertsFromPEM([]byte(self.Ca)) {
+			return nil, errors.New("no valid certificates found in ca")
+		}
+	}
+
+	return result, nil
+}
+
+type TlsCheckDefinition struct {
+	BaseCheckDefinition `mapstructure:",squash"`
+	TlsClientOptions    `mapstructure:",squash"`
+	Address             string
+	ExpiryThreshold     time.Duration
+}
+
+func (self *TlsCheckDefinition) String() string {
+	return fmt.Sprintf("tls-check address=%v, interval=%v, timeout=%v, expiryThreshold=%v", self.Address, self.Interval, self.Timeout, self.ExpiryThreshold)
+}
+
+func (self *TlsCheckDefinition) GetType() string {
+	return "tls"
+}
+
+func (self *TlsCheckDefinition) CreateCheck(name string) (Check, error) {
+	tlsConfig, err := self.toTlsConfig(self.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tlsCheck{
+		name:            name,
+		address:         self.Address,
+		tlsConfig:       tlsConfig,
+		expiryThreshold: self.ExpiryThreshold,
+	}, nil
+}
+
+// tlsCheck completes a tls handshake with the server and fails if the handshake fails or if any certificate in the
+// presented chain expires within the expiry threshold
+type tlsCheck struct {
+	name            string
+	address         string
+	tlsConfig       *tls.Config
+	expiryThreshold time.Duration
+}
+
+func (self *tlsCheck) Name() string {
+	return self.name
+}
+
+func (self *tlsCheck) Execute(ctx context.Context) (interface{}, error) {
+	dialer := &tls.Dialer{Config: self.tlsConfig}
+	conn, err := dialer.DialContext(ctx, "tcp", self.address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "tls handshake with %v failed", self.address)
+	}
+	defer func() { _ = conn.Close() }()
+
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, errors.Errorf("%v presented no certificates", self.address)
+	}
+
+	expiring := certs[0]
+	for _, cert := range certs[1:] {
+		if cert.NotAfter.Before(expiring.NotAfter) {
+			expiring = cert
+		}
+	}
+
+	remaining := time.Until(expiring.NotAfter)
+	details := map[string]interface{}{
+		"subject":   expiring.Subject.String(),
+		"notAfter":  expiring.NotAfter,
+		"remaining": remaining.Truncate(time.Second).String(),
+	}
+
+	if remaining < self.expiryThreshold {
+		return details, errors.Errorf("certificate %v expires in %v, which is within the threshold of %v",
+			expiring.Subject.String(), remaining.Truncate(time.Second), self.expiryThreshold)
+	}
+
+	return details, nil
+}
@@ -17,6 +17,8 @@
 package intercept
 
 import (
+	"context"
+	"crypto/tls"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge-api/rest_model"
 	"github.com/openziti/sdk-golang/ziti"
@@ -38,6 +40,10 @@ import (
 type healthChecksProvider interface {
 	GetPortChecks() []*health.PortCheckDefinition
 	GetHttpChecks() []*health.HttpCheckDefinition
+	GetTlsChecks() []*health.TlsCheckDefinition
+	GetGrpcChecks() []*health.GrpcCheckDefinition
+	GetDnsChecks() []*health.DnsCheckDefinition
+	GetExecChecks() []*health.ExecCheckDefinition
 }
 
 func createHostingContexts(service *entities.Service, identity *rest_model.IdentityDetail, tracker AddressTracker) []tunnel.HostingContext {
@@ -100,12 +106,27 @@ func newDefaultHostingContext(identity *rest_model.IdentityDetail, service *enti
 			Address: config.Proxy.Address,
 			Type:    transport.ProxyType(config.Proxy.Type),
 		}
+		if config.Proxy.Username != "" {
+			proxyConf.Auth = &proxy.Auth{
+				User:     config.Proxy.Username,
+				Password: config.Proxy.Password,
+			}
+		}
+	}
+
+	var tlsConfig *tls.Config
+	if config.Tls != nil {
+		if tlsConfig, err = config.Tls.ToTlsConfig(); err != nil {
+			log.WithError(err).Error("failed to setup tls configuration")
+			return nil
+		}
 	}
 
 	return &hostingContext{
 		service:     service,
 		options:     listenOptions,
 		proxyConf:   proxyConf,
+		tlsConfig:   tlsConfig,
 		dialTimeout: config.GetDialTimeout(5 * time.Second),
 		config:      config,
 		addrTracker: tracker,
@@ -117,6 +138,7 @@ type hostingContext struct {
 	service     *entities.Service
 	options     *ziti.ListenOptions
 	proxyConf   *transport.ProxyConfiguration
+	tlsConfig   *tls.Config
 	config      *entities.HostV1Config
 	dialTimeout time.Duration
 	onClose     func()
@@ -143,6 +165,10 @@ func (self *hostingContext) dialAddress(options map[string]interface{}, protocol
 	var conn net.Conn
 	var err error
 
+	if self.tlsConfig != nil && !isTcp {
+		return nil, false, errors.Errorf("tls not supported for protocol '%v'", protocol)
+	}
+
 	var dialer proxy.Dialer
 
 	if sourceAddr != "" {
@@ -174,18 +200,56 @@ func (self *hostingContext) dialAddress(options map[string]interface{}, protocol
 	}
 
 	if self.proxyConf != nil && self.proxyConf.Type != transport.ProxyTypeNone {
-		if self.proxyConf.Type == transport.ProxyTypeHttpConnect {
+		switch self.proxyConf.Type {
+		case transport.ProxyTypeHttpConnect:
 			dialer = proxies.NewHttpConnectProxyDialer(dialer, self.proxyConf.Address, self.proxyConf.Auth, self.dialTimeout)
-		} else {
+		case ProxyTypeSocks5:
+			// the source address applies to the connections to the proxy, which always start with a tcp control connection
+			if isUdp && sourceAddr != "" {
+				return nil, false, errors.New("source address not supported for udp through a socks5 proxy")
+			}
+			dialer = newSocks5ProxyDialer(dialer, self.proxyConf.Address, self.proxyConf.Auth, self.dialTimeout)
+		default:
 			return nil, false, errors.Errorf("unsupported proxy type %s", string(self.proxyConf.Type))
 		}
 	}
 
 	conn, err = dialer.Dial(protocol, address)
 
+	if err == nil && self.tlsConfig != nil {
+		conn, err = self.wrapTls(conn, address)
+	}
+
 	return conn, enableHalfClose, err
 }
 
+func (self *hostingContext) wrapTls(conn net.Conn, address string) (net.Conn, error) {
+	tlsConfig := self.tlsConfig
+	if tlsConfig.ServerName == "" {
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			tlsConfig = tlsConfig.Clone()
+			tlsConfig.ServerName = host
+		}
+	}
+
+	ctx := context.Background()
+	if self.dialTimeout > 0 {
+		timeoutCtx, cancelF := context.WithTimeout(ctx, self.dialTimeout)
+		defer cancelF()
+		ctx = timeoutCtx
+	}
+
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		if closeErr := conn.Close(); closeErr != nil {
+			pfxlog.Logger().WithError(closeErr).Error("failed to close connection after tls handshake failure")
+		}
+		return nil, errors.Wrapf(err, "tls handshake with %s failed", address)
+	}
+
+	return tlsConn, nil
+}
+
 func (self *hostingContext) SetCloseCallback(f func()) {
 	self.onClose = f
 }
@@ -219,6 +283,22 @@ func (self *hostingContext) getHealthChecks(provider healthChecksProvider) []hea
 		checkDefinitions = append(checkDefinitions, checkDef)
 	}
 
+	for _, checkDef := range provider.GetTlsChecks() {
+		checkDefinitions = append(checkDefinitions, checkDef)
+	}
+
+	for _, checkDef := range provider.GetGrpcChecks() {
+		checkDefinitions = append(checkDefinitions, checkDef)
+	}
+
+	for _, checkDef := range provider.GetDnsChecks() {
+		checkDefinitions = append(checkDefinitions, checkDef)
+	}
+
+	for _, checkDef := range provider.GetExecChecks() {
+		checkDefinitions = append(checkDefinitions, checkDef)
+	}
+
 	return checkDefinitions
 }
 
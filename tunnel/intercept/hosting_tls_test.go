@@ -0,0 +1,113 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package intercept
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/tunnel/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func newTlsTestHostingContext(t *testing.T, tlsConfig *entities.TlsConfiguration) *hostingContext {
+	cfg, err := tlsConfig.ToTlsConfig()
+	require.NoError(t, err)
+	return &hostingContext{
+		config:      &entities.HostV1Config{Tls: tlsConfig},
+		tlsConfig:   cfg,
+		dialTimeout: 5 * time.Second,
+	}
+}
+
+func TestHostingContextTls(t *testing.T) {
+	req := require.New(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	caPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	address := server.Listener.Addr().String()
+
+	t.Run("full verification with matching server name", func(t *testing.T) {
+		req := require.New(t)
+		ctx := newTlsTestHostingContext(t, &entities.TlsConfiguration{Ca: caPem, ServerName: "example.com"})
+		conn, halfClose, err := ctx.dialAddress(map[string]interface{}{}, "tcp", address)
+		req.NoError(err)
+		req.True(halfClose)
+		defer func() { _ = conn.Close() }()
+
+		_, err = conn.Write([]byte("GET / HTTP/1.0\r\nHost: example.com\r\n\r\n"))
+		req.NoError(err)
+		resp, err := io.ReadAll(conn)
+		req.NoError(err)
+		req.Contains(string(resp), "ok")
+
+		tlsConn, ok := conn.(*tls.Conn)
+		req.True(ok)
+		req.Equal("example.com", tlsConn.ConnectionState().ServerName)
+	})
+
+	t.Run("full verification with mismatched server name", func(t *testing.T) {
+		ctx := newTlsTestHostingContext(t, &entities.TlsConfiguration{Ca: caPem, ServerName: "other.com"})
+		_, _, err := ctx.dialAddress(map[string]interface{}{}, "tcp", address)
+		require.Error(t, err)
+	})
+
+	t.Run("ca verification ignores server name", func(t *testing.T) {
+		ctx := newTlsTestHostingContext(t, &entities.TlsConfiguration{
+			Ca:         caPem,
+			ServerName: "other.com",
+			VerifyMode: entities.TlsVerifyCa,
+		})
+		conn, _, err := ctx.dialAddress(map[string]interface{}{}, "tcp", address)
+		require.NoError(t, err)
+		_ = conn.Close()
+	})
+
+	t.Run("ca verification with unknown ca", func(t *testing.T) {
+		ctx := newTlsTestHostingContext(t, &entities.TlsConfiguration{VerifyMode: entities.TlsVerifyCa})
+		_, _, err := ctx.dialAddress(map[string]interface{}{}, "tcp", address)
+		require.Error(t, err)
+	})
+
+	t.Run("no verification", func(t *testing.T) {
+		ctx := newTlsTestHostingContext(t, &entities.TlsConfiguration{VerifyMode: entities.TlsVerifyNone})
+		conn, _, err := ctx.dialAddress(map[string]interface{}{}, "tcp", address)
+		require.NoError(t, err)
+		_ = conn.Close()
+	})
+
+	t.Run("udp not supported", func(t *testing.T) {
+		ctx := newTlsTestHostingContext(t, &entities.TlsConfiguration{VerifyMode: entities.TlsVerifyNone})
+		_, _, err := ctx.dialAddress(map[string]interface{}{}, "udp", address)
+		require.Error(t, err)
+	})
+
+	_, err := (&entities.TlsConfiguration{VerifyMode: "sometimes"}).ToTlsConfig()
+	req.Error(err)
+
+	_, err = (&entities.TlsConfiguration{Ca: "not a cert"}).ToTlsConfig()
+	req.Error(err)
+}
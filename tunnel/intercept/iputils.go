@@ -133,5 +133,26 @@ func getInterceptIP(svc *entities.Service, hostname string, resolver dns.Resolve
 		logger.WithError(err).Errorf("failed to add host/ip mapping to resolver: %v -> %v", hostname, ip)
 	}
 
+	if info := getServiceInfo(svc); info != nil {
+		resolver.AddService(hostname, info)
+		svc.AddCleanupAction(func() { resolver.RemoveService(hostname, info.Name) })
+	}
+
 	return nil
 }
+
+// getServiceInfo describes the intercepted protocols and ports of the service, for publishing via SRV and TXT records
+func getServiceInfo(svc *entities.Service) *dns.ServiceInfo {
+	if svc.Name == nil || svc.InterceptV1Config == nil {
+		return nil
+	}
+
+	info := &dns.ServiceInfo{
+		Name:      *svc.Name,
+		Protocols: svc.InterceptV1Config.Protocols,
+	}
+	for _, portRange := range svc.InterceptV1Config.PortRanges {
+		info.PortRanges = append(info.PortRanges, dns.PortRange{Low: portRange.Low, High: portRange.High})
+	}
+	return info
+}
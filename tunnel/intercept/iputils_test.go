@@ -0,0 +1,33 @@
+package intercept
+
+import (
+	"testing"
+
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/util"
+	"github.com/openziti/ziti/tunnel/dns"
+	"github.com/openziti/ziti/tunnel/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetServiceInfo(t *testing.T) {
+	req := require.New(t)
+
+	svc := &entities.Service{
+		ServiceDetail: rest_model.ServiceDetail{
+			Name: util.Ptr("web"),
+		},
+	}
+	req.Nil(getServiceInfo(svc))
+
+	svc.InterceptV1Config = &entities.InterceptV1Config{
+		Protocols:  []string{"tcp"},
+		PortRanges: []*entities.PortRange{{Low: 80, High: 80}, {Low: 8000, High: 8080}},
+	}
+
+	info := getServiceInfo(svc)
+	req.NotNil(info)
+	req.Equal("web", info.Name)
+	req.Equal([]string{"tcp"}, info.Protocols)
+	req.Equal([]dns.PortRange{{Low: 80, High: 80}, {Low: 8000, High: 8080}}, info.PortRanges)
+}
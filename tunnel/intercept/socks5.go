@@ -0,0 +1,372 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package intercept
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/transport/v2"
+	"github.com/pkg/errors"
+	"golang.org/x/net/proxy"
+)
+
+const ProxyTypeSocks5 transport.ProxyType = "socks5"
+
+const (
+	socks5Version         = 5
+	socks5AuthNone        = 0
+	socks5AuthPassword    = 2
+	socks5AuthNoMatch     = 0xff
+	socks5CmdUdpAssociate = 3
+	socks5AddrIPv4        = 1
+	socks5AddrDomain      = 3
+	socks5AddrIPv6        = 4
+	socks5ReplySucceeded  = 0
+)
+
+// newSocks5ProxyDialer returns a dialer which connects through a SOCKS5 proxy. TCP connections use the CONNECT
+// command, UDP connections use UDP ASSOCIATE with datagrams relayed through the proxy
+func newSocks5ProxyDialer(dialer proxy.Dialer, addr string, auth *proxy.Auth, timeout time.Duration) *socks5ProxyDialer {
+	return &socks5ProxyDialer{
+		dialer:  dialer,
+		address: addr,
+		auth:    auth,
+		timeout: timeout,
+	}
+}
+
+type socks5ProxyDialer struct {
+	dialer  proxy.Dialer
+	address string
+	auth    *proxy.Auth
+	timeout time.Duration
+}
+
+func (self *socks5ProxyDialer) Dial(network, addr string) (net.Conn, error) {
+	switch network {
+	case "tcp", "tcp4", "tcp6":
+		return self.dialTcp(network, addr)
+	case "udp", "udp4", "udp6":
+		return self.dialUdp(addr)
+	default:
+		return nil, errors.Errorf("unsupported network %s for socks5 proxy", network)
+	}
+}
+
+func (self *socks5ProxyDialer) dialTcp(network, addr string) (net.Conn, error) {
+	dialer, err := proxy.SOCKS5("tcp", self.address, self.auth, self.dialer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	if self.timeout > 0 {
+		timeoutCtx, cancelF := context.WithTimeout(ctx, self.timeout)
+		defer cancelF()
+		ctx = timeoutCtx
+	}
+
+	conn, err := dialer.(proxy.ContextDialer).DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to connect to %s through socks5 proxy at %s", addr, self.address)
+	}
+	return conn, nil
+}
+
+func (self *socks5ProxyDialer) dialUdp(addr string) (net.Conn, error) {
+	header, err := newSocks5UdpHeader(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl, err := self.dialer.Dial("tcp", self.address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to connect to socks5 proxy at %s", self.address)
+	}
+
+	relayAddr, err := self.associate(ctrl)
+	if err != nil {
+		self.closeCtrl(ctrl)
+		return nil, err
+	}
+
+	relay, err := net.DialUDP("udp", nil, relayAddr)
+	if err != nil {
+		self.closeCtrl(ctrl)
+		return nil, errors.Wrapf(err, "unable to connect to socks5 udp relay at %s", relayAddr)
+	}
+
+	conn := &socks5UdpConn{
+		UDPConn: relay,
+		ctrl:    ctrl,
+		header:  header,
+	}
+
+	// the association lasts only as long as the control connection, so tear down the relay when it goes away
+	go func() {
+		_, _ = io.Copy(io.Discard, ctrl)
+		_ = conn.Close()
+	}()
+
+	return conn, nil
+}
+
+func (self *socks5ProxyDialer) closeCtrl(ctrl net.Conn) {
+	if err := ctrl.Close(); err != nil {
+		pfxlog.Logger().WithError(err).Error("failed to close connection to socks5 proxy")
+	}
+}
+
+// associate negotiates authentication and issues a UDP ASSOCIATE request on the control connection, returning the
+// address of the relay that datagrams should be sent to
+func (self *socks5ProxyDialer) associate(ctrl net.Conn) (*net.UDPAddr, error) {
+	if self.timeout > 0 {
+		if err := ctrl.SetDeadline(time.Now().Add(self.timeout)); err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = ctrl.SetDeadline(time.Time{})
+		}()
+	}
+
+	methods := []byte{socks5AuthNone}
+	if self.auth != nil {
+		methods = append(methods, socks5AuthPassword)
+	}
+
+	if _, err := ctrl.Write(append([]byte{socks5Version, byte(len(methods))}, methods...)); err != nil {
+		return nil, errors.Wrap(err, "failed to write socks5 greeting")
+	}
+
+	reply := make([]byte, 2)
+	if _, err := io.ReadFull(ctrl, reply); err != nil {
+		return nil, errors.Wrap(err, "failed to read socks5 greeting reply")
+	}
+
+	if reply[0] != socks5Version {
+		return nil, errors.Errorf("unexpected socks version %d", reply[0])
+	}
+
+	switch reply[1] {
+	case socks5AuthNone:
+	case socks5AuthPassword:
+		if self.auth == nil {
+			return nil, errors.New("socks5 proxy requested username/password authentication, but no credentials configured")
+		}
+		if err := self.authenticate(ctrl); err != nil {
+			return nil, err
+		}
+	case socks5AuthNoMatch:
+		return nil, errors.New("socks5 proxy rejected all offered authentication methods")
+	default:
+		return nil, errors.Errorf("socks5 proxy selected unsupported authentication method %d", reply[1])
+	}
+
+	// the client address isn't known until the relay socket is bound, so send the unspecified address
+	request := []byte{socks5Version, socks5CmdUdpAssociate, 0, socks5AddrIPv4, 0, 0, 0, 0, 0, 0}
+	if _, err := ctrl.Write(request); err != nil {
+		return nil, errors.Wrap(err, "failed to write socks5 udp associate request")
+	}
+
+	reply = make([]byte, 3)
+	if _, err := io.ReadFull(ctrl, reply); err != nil {
+		return nil, errors.Wrap(err, "failed to read socks5 udp associate reply")
+	}
+
+	if reply[1] != socks5ReplySucceeded {
+		return nil, errors.Errorf("socks5 proxy refused udp associate, reply code %d", reply[1])
+	}
+
+	host, port, err := readSocks5Addr(ctrl)
+	if err != nil {
+		return nil, err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		ipAddr, err := net.ResolveIPAddr("ip", host)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to resolve socks5 udp relay address %s", host)
+		}
+		ip = ipAddr.IP
+	}
+
+	// proxies commonly report the unspecified address, meaning the relay is on the same host as the proxy
+	if ip.IsUnspecified() {
+		if tcpAddr, ok := ctrl.RemoteAddr().(*net.TCPAddr); ok {
+			ip = tcpAddr.IP
+		}
+	}
+
+	return &net.UDPAddr{IP: ip, Port: port}, nil
+}
+
+func (self *socks5ProxyDialer) authenticate(ctrl net.Conn) error {
+	user, password := self.auth.User, self.auth.Password
+	if len(user) == 0 || len(user) > 255 || len(password) > 255 {
+		return errors.New("invalid socks5 username or password length")
+	}
+
+	request := []byte{1, byte(len(user))}
+	request = append(request, user...)
+	request = append(request, byte(len(password)))
+	request = append(request, password...)
+
+	if _, err := ctrl.Write(request); err != nil {
+		return errors.Wrap(err, "failed to write socks5 authentication request")
+	}
+
+	reply := make([]byte, 2)
+	if _, err := io.ReadFull(ctrl, reply); err != nil {
+		return errors.Wrap(err, "failed to read socks5 authentication reply")
+	}
+
+	if reply[1] != 0 {
+		return errors.New("socks5 proxy authentication failed")
+	}
+
+	return nil
+}
+
+func newSocks5UdpHeader(addr string) ([]byte, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid port in address %s", addr)
+	}
+
+	// RSV, RSV, FRAG
+	header := []byte{0, 0, 0}
+	if ip := net.ParseIP(host); ip == nil {
+		if len(host) > 255 {
+			return nil, errors.Errorf("host name %s too long for socks5", host)
+		}
+		header = append(header, socks5AddrDomain, byte(len(host)))
+		header = append(header, host...)
+	} else if ip4 := ip.To4(); ip4 != nil {
+		header = append(header, socks5AddrIPv4)
+		header = append(header, ip4...)
+	} else {
+		header = append(header, socks5AddrIPv6)
+		header = append(header, ip.To16()...)
+	}
+
+	return binary.BigEndian.AppendUint16(header, uint16(port)), nil
+}
+
+func readSocks5Addr(r io.Reader) (string, int, error) {
+	addrType := make([]byte, 1)
+	if _, err := io.ReadFull(r, addrType); err != nil {
+		return "", 0, err
+	}
+
+	var host string
+	switch addrType[0] {
+	case socks5AddrIPv4, socks5AddrIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if addrType[0] == socks5AddrIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(r, ip); err != nil {
+			return "", 0, err
+		}
+		host = ip.String()
+	case socks5AddrDomain:
+		domainLen := make([]byte, 1)
+		if _, err := io.ReadFull(r, domainLen); err != nil {
+			return "", 0, err
+		}
+		domain := make([]byte, domainLen[0])
+		if _, err := io.ReadFull(r, domain); err != nil {
+			return "", 0, err
+		}
+		host = string(domain)
+	default:
+		return "", 0, errors.Errorf("unsupported socks5 address type %d", addrType[0])
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(r, port); err != nil {
+		return "", 0, err
+	}
+
+	return host, int(binary.BigEndian.Uint16(port)), nil
+}
+
+// socks5UdpConn is a connected udp socket to a socks5 relay. Writes are wrapped in the socks5 udp request header
+// addressed to the target and reads have the header stripped
+type socks5UdpConn struct {
+	*net.UDPConn
+	ctrl      net.Conn
+	header    []byte
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func (self *socks5UdpConn) Write(b []byte) (int, error) {
+	datagram := make([]byte, 0, len(self.header)+len(b))
+	datagram = append(datagram, self.header...)
+	datagram = append(datagram, b...)
+	if _, err := self.UDPConn.Write(datagram); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (self *socks5UdpConn) Read(b []byte) (int, error) {
+	buf := make([]byte, len(b)+262)
+	for {
+		n, err := self.UDPConn.Read(buf)
+		if err != nil {
+			return 0, err
+		}
+
+		// fragmented datagrams aren't supported and are dropped, as the RFC allows
+		if n < 4 || buf[2] != 0 {
+			continue
+		}
+
+		reader := bytes.NewReader(buf[3:n])
+		if _, _, err = readSocks5Addr(reader); err != nil {
+			continue
+		}
+
+		return copy(b, buf[n-reader.Len():n]), nil
+	}
+}
+
+func (self *socks5UdpConn) Close() error {
+	self.closeOnce.Do(func() {
+		self.closeErr = self.UDPConn.Close()
+		if err := self.ctrl.Close(); err != nil && self.closeErr == nil {
+			self.closeErr = err
+		}
+	})
+	return self.closeErr
+}
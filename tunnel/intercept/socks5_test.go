@@ -0,0 +1,269 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package intercept
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/proxy"
+)
+
+// testSocks5Server is a minimal socks5 server supporting username/password auth, CONNECT and UDP ASSOCIATE
+type testSocks5Server struct {
+	t        *testing.T
+	listener net.Listener
+	user     string
+	password string
+}
+
+func newTestSocks5Server(t *testing.T, user, password string) *testSocks5Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &testSocks5Server{t: t, listener: listener, user: user, password: password}
+	t.Cleanup(func() { _ = listener.Close() })
+	go server.accept()
+	return server
+}
+
+func (self *testSocks5Server) accept() {
+	for {
+		conn, err := self.listener.Accept()
+		if err != nil {
+			return
+		}
+		go self.handle(conn)
+	}
+}
+
+func (self *testSocks5Server) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return
+	}
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return
+	}
+
+	if !bytes.Contains(methods, []byte{socks5AuthPassword}) {
+		_, _ = conn.Write([]byte{socks5Version, socks5AuthNoMatch})
+		return
+	}
+	_, _ = conn.Write([]byte{socks5Version, socks5AuthPassword})
+
+	authHeader := make([]byte, 2)
+	if _, err := io.ReadFull(conn, authHeader); err != nil {
+		return
+	}
+	user := make([]byte, authHeader[1])
+	if _, err := io.ReadFull(conn, user); err != nil {
+		return
+	}
+	passwordLen := make([]byte, 1)
+	if _, err := io.ReadFull(conn, passwordLen); err != nil {
+		return
+	}
+	password := make([]byte, passwordLen[0])
+	if _, err := io.ReadFull(conn, password); err != nil {
+		return
+	}
+	if string(user) != self.user || string(password) != self.password {
+		_, _ = conn.Write([]byte{1, 1})
+		return
+	}
+	_, _ = conn.Write([]byte{1, 0})
+
+	request := make([]byte, 3)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return
+	}
+	host, port, err := readSocks5Addr(conn)
+	if err != nil {
+		return
+	}
+
+	switch request[1] {
+	case 1: // CONNECT
+		target, err := net.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+		if err != nil {
+			_, _ = conn.Write([]byte{socks5Version, 5, 0, socks5AddrIPv4, 0, 0, 0, 0, 0, 0})
+			return
+		}
+		defer func() { _ = target.Close() }()
+		_, _ = conn.Write([]byte{socks5Version, socks5ReplySucceeded, 0, socks5AddrIPv4, 0, 0, 0, 0, 0, 0})
+		go func() { _, _ = io.Copy(target, conn) }()
+		_, _ = io.Copy(conn, target)
+	case socks5CmdUdpAssociate:
+		relay, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		if err != nil {
+			return
+		}
+		defer func() { _ = relay.Close() }()
+
+		// report the unspecified address, so the client has to fall back to the proxy address
+		reply := []byte{socks5Version, socks5ReplySucceeded, 0, socks5AddrIPv4, 0, 0, 0, 0}
+		reply = binary.BigEndian.AppendUint16(reply, uint16(relay.LocalAddr().(*net.UDPAddr).Port))
+		_, _ = conn.Write(reply)
+
+		go self.relayUdp(relay)
+		_, _ = io.Copy(io.Discard, conn)
+	}
+}
+
+func (self *testSocks5Server) relayUdp(relay *net.UDPConn) {
+	buf := make([]byte, 1500)
+	var client *net.UDPAddr
+	for {
+		n, from, err := relay.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+
+		if client == nil || from.String() == client.String() {
+			client = from
+			reader := bytes.NewReader(buf[3:n])
+			host, port, err := readSocks5Addr(reader)
+			if err != nil {
+				continue
+			}
+			target, err := net.ResolveUDPAddr("udp", net.JoinHostPort(host, strconv.Itoa(port)))
+			if err != nil {
+				continue
+			}
+			_, _ = relay.WriteToUDP(buf[n-reader.Len():n], target)
+		} else {
+			header, err := newSocks5UdpHeader(from.String())
+			if err != nil {
+				continue
+			}
+			_, _ = relay.WriteToUDP(append(header, buf[:n]...), client)
+		}
+	}
+}
+
+func TestSocks5ProxyDialerTcp(t *testing.T) {
+	req := require.New(t)
+
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	req.NoError(err)
+	defer func() { _ = target.Close() }()
+
+	go func() {
+		conn, err := target.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_, _ = io.Copy(conn, conn)
+	}()
+
+	server := newTestSocks5Server(t, "user", "secret")
+	dialer := newSocks5ProxyDialer(&net.Dialer{}, server.listener.Addr().String(), &proxy.Auth{User: "user", Password: "secret"}, time.Second)
+
+	conn, err := dialer.Dial("tcp", target.Addr().String())
+	req.NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	_, err = conn.Write([]byte("hello"))
+	req.NoError(err)
+
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	req.NoError(err)
+	req.Equal("hello", string(buf))
+}
+
+func TestSocks5ProxyDialerUdp(t *testing.T) {
+	req := require.New(t)
+
+	target, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	req.NoError(err)
+	defer func() { _ = target.Close() }()
+
+	go func() {
+		buf := make([]byte, 1500)
+		for {
+			n, from, err := target.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			_, _ = target.WriteToUDP(bytes.ToUpper(buf[:n]), from)
+		}
+	}()
+
+	server := newTestSocks5Server(t, "user", "secret")
+	dialer := newSocks5ProxyDialer(&net.Dialer{}, server.listener.Addr().String(), &proxy.Auth{User: "user", Password: "secret"}, time.Second)
+
+	conn, err := dialer.Dial("udp", target.LocalAddr().String())
+	req.NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	req.NoError(conn.SetDeadline(time.Now().Add(5 * time.Second)))
+
+	_, err = conn.Write([]byte("hello"))
+	req.NoError(err)
+
+	buf := make([]byte, 1500)
+	n, err := conn.Read(buf)
+	req.NoError(err)
+	req.Equal("HELLO", string(buf[:n]))
+}
+
+func TestSocks5ProxyDialerBadCredentials(t *testing.T) {
+	req := require.New(t)
+
+	server := newTestSocks5Server(t, "user", "secret")
+	dialer := newSocks5ProxyDialer(&net.Dialer{}, server.listener.Addr().String(), &proxy.Auth{User: "user", Password: "wrong"}, time.Second)
+
+	_, err := dialer.Dial("udp", "127.0.0.1:53")
+	req.Error(err)
+
+	dialer = newSocks5ProxyDialer(&net.Dialer{}, server.listener.Addr().String(), nil, time.Second)
+	_, err = dialer.Dial("udp", "127.0.0.1:53")
+	req.Error(err)
+}
+
+func TestNewSocks5UdpHeader(t *testing.T) {
+	req := require.New(t)
+
+	header, err := newSocks5UdpHeader("10.1.2.3:53")
+	req.NoError(err)
+	req.Equal([]byte{0, 0, 0, socks5AddrIPv4, 10, 1, 2, 3, 0, 53}, header)
+
+	header, err = newSocks5UdpHeader("example.com:443")
+	req.NoError(err)
+	req.Equal(append(append([]byte{0, 0, 0, socks5AddrDomain, 11}, "example.com"...), 1, 187), header)
+
+	header, err = newSocks5UdpHeader("[fd00::1]:53")
+	req.NoError(err)
+	req.Equal(22, len(header))
+	req.Equal(byte(socks5AddrIPv6), header[3])
+
+	host, port, err := readSocks5Addr(bytes.NewReader(header[3:]))
+	req.NoError(err)
+	req.Equal("fd00::1", host)
+	req.Equal(53, port)
+}
@@ -0,0 +1,333 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tproxy
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"github.com/google/nftables/userdata"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/tunnel/intercept"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const nftTableName = "ziti-tproxy"
+
+type nftRule struct {
+	id               uint32
+	service          string
+	addr             *intercept.InterceptAddress
+	port             IPPortAddr
+	preroutingHandle uint64
+	inputHandle      uint64
+}
+
+// comment identifies the rule in the kernel, so its handles can be found after it's added. It starts with the
+// service name, to keep 'nft list table' readable
+func (self *nftRule) comment() string {
+	return fmt.Sprintf("%v [%d]", self.service, self.id)
+}
+
+func (self *nftRule) userData() []byte {
+	return userdata.AppendString(nil, userdata.TypeComment, self.comment())
+}
+
+// nftRuleset manages the tproxy rules in a dedicated nftables table. The table is recreated when the ruleset is
+// created. After that, rules are inserted and deleted individually, using the handles the kernel assigns them, so
+// a change doesn't disturb the rules of other services. Each change is sent as a single netlink batch, which the
+// kernel applies atomically
+type nftRuleset struct {
+	lanIf      string
+	lock       sync.Mutex
+	nextId     uint32
+	rules      []*nftRule
+	table      *nftables.Table
+	prerouting *nftables.Chain
+	input      *nftables.Chain
+}
+
+func newNftRuleset(lanIf string) (*nftRuleset, error) {
+	result := &nftRuleset{
+		lanIf: lanIf,
+	}
+
+	// replaces anything left behind by a previous run, and verifies that nftables is usable
+	if err := result.reset(); err != nil {
+		return nil, errors.Wrap(err, "tproxy: failed to initialize nftables table")
+	}
+
+	pfxlog.Logger().Infof("using nftables table ip %s", nftTableName)
+	return result, nil
+}
+
+func (self *nftRuleset) add(service string, addr *intercept.InterceptAddress, port IPPortAddr) error {
+	if addr.IpNet().IP.To4() == nil {
+		return errors.Errorf("nftables tproxy only supports ipv4 addresses, got %v", addr.IpNet())
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.nextId++
+	rule := &nftRule{
+		id:      self.nextId,
+		service: service,
+		addr:    addr,
+		port:    port,
+	}
+
+	conn, err := nftables.New()
+	if err != nil {
+		return errors.Wrap(err, "failed to open nftables connection")
+	}
+
+	// rules are inserted at the head of the chain, matching the iptables implementation, so newer rules win
+	conn.InsertRule(&nftables.Rule{
+		Table:    self.table,
+		Chain:    self.prerouting,
+		Exprs:    nftTproxyExprs(rule),
+		UserData: rule.userData(),
+	})
+
+	if self.input != nil {
+		conn.InsertRule(&nftables.Rule{
+			Table:    self.table,
+			Chain:    self.input,
+			Exprs:    nftAcceptExprs(self.lanIf, rule),
+			UserData: rule.userData(),
+		})
+	}
+
+	if err = conn.Flush(); err != nil {
+		return errors.Wrap(err, "failed to add nftables rules")
+	}
+
+	// the handles aren't returned when rules are added, so they're looked up by the rule's comment
+	if rule.preroutingHandle, err = nftFindRuleHandle(conn, self.table, self.prerouting, rule.comment()); err == nil && self.input != nil {
+		rule.inputHandle, err = nftFindRuleHandle(conn, self.table, self.input, rule.comment())
+	}
+	if err != nil {
+		if delErr := self.delete(conn, rule); delErr != nil {
+			pfxlog.Logger().WithError(delErr).Errorf("failed to remove nftables rules for service %v", service)
+		}
+		return err
+	}
+
+	self.rules = append(self.rules, rule)
+	pfxlog.Logger().Infof("added nftables rule for service %v, %v %v:%v", service, addr.Proto(), addr.IpNet(), addr.LowPort())
+	return nil
+}
+
+func (self *nftRuleset) remove(addr *intercept.InterceptAddress) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for i, rule := range self.rules {
+		if rule.addr == addr {
+			conn, err := nftables.New()
+			if err != nil {
+				return errors.Wrap(err, "failed to open nftables connection")
+			}
+			if err = self.delete(conn, rule); err != nil {
+				return err
+			}
+			self.rules = append(self.rules[:i], self.rules[i+1:]...)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// delete removes the rule from the chains in which it has a handle
+func (self *nftRuleset) delete(conn *nftables.Conn, rule *nftRule) error {
+	if rule.preroutingHandle != 0 {
+		if err := conn.DelRule(&nftables.Rule{Table: self.table, Chain: self.prerouting, Handle: rule.preroutingHandle}); err != nil {
+			return err
+		}
+	}
+
+	if rule.inputHandle != 0 {
+		if err := conn.DelRule(&nftables.Rule{Table: self.table, Chain: self.input, Handle: rule.inputHandle}); err != nil {
+			return err
+		}
+	}
+
+	if err := conn.Flush(); err != nil {
+		return errors.Wrapf(err, "failed to remove nftables rules for service %v", rule.service)
+	}
+	return nil
+}
+
+// cleanup removes the table, along with all rules in it
+func (self *nftRuleset) cleanup() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	log := pfxlog.Logger().WithField("table", nftTableName)
+	log.Info("removing nftables table")
+
+	conn, err := nftables.New()
+	if err != nil {
+		log.WithError(err).Error("failed to open nftables connection")
+		return
+	}
+
+	// adding the table first makes the delete succeed even if the table is already gone
+	table := &nftables.Table{Family: nftables.TableFamilyIPv4, Name: nftTableName}
+	conn.AddTable(table)
+	conn.DelTable(table)
+
+	if err = conn.Flush(); err != nil {
+		log.WithError(err).Error("failed to remove nftables table")
+	}
+	self.rules = nil
+}
+
+// reset recreates the table and its chains, without any rules
+func (self *nftRuleset) reset() error {
+	conn, err := nftables.New()
+	if err != nil {
+		return errors.Wrap(err, "failed to open nftables connection")
+	}
+
+	table := &nftables.Table{Family: nftables.TableFamilyIPv4, Name: nftTableName}
+	conn.AddTable(table)
+	conn.DelTable(table)
+	self.table = conn.AddTable(table)
+
+	self.prerouting = conn.AddChain(&nftables.Chain{
+		Name:     "prerouting",
+		Table:    self.table,
+		Type:     nftables.ChainTypeFilter,
+		Hooknum:  nftables.ChainHookPrerouting,
+		Priority: nftables.ChainPriorityMangle,
+	})
+
+	self.input = nil
+	if self.lanIf != "" {
+		self.input = conn.AddChain(&nftables.Chain{
+			Name:     "input",
+			Table:    self.table,
+			Type:     nftables.ChainTypeFilter,
+			Hooknum:  nftables.ChainHookInput,
+			Priority: nftables.ChainPriorityFilter,
+		})
+	}
+
+	if err = conn.Flush(); err != nil {
+		return errors.Wrap(err, "failed to create nftables table")
+	}
+	self.rules = nil
+	return nil
+}
+
+func nftFindRuleHandle(conn *nftables.Conn, table *nftables.Table, chain *nftables.Chain, comment string) (uint64, error) {
+	rules, err := conn.GetRules(table, chain)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to list nftables rules in chain %v", chain.Name)
+	}
+	if handle, found := nftRuleHandleForComment(rules, comment); found {
+		return handle, nil
+	}
+	return 0, errors.Errorf("nftables rule '%v' not found in chain %v", comment, chain.Name)
+}
+
+// nftRuleHandleForComment returns the handle of the rule with the given comment
+func nftRuleHandleForComment(rules []*nftables.Rule, comment string) (uint64, bool) {
+	for _, rule := range rules {
+		if ruleComment, ok := userdata.GetString(rule.UserData, userdata.TypeComment); ok && ruleComment == comment {
+			return rule.Handle, true
+		}
+	}
+	return 0, false
+}
+
+// nftMatchExprs matches 'ip daddr <cidr> meta l4proto <proto> th dport <low>-<high>'
+func nftMatchExprs(addr *intercept.InterceptAddress) []expr.Any {
+	ipNet := addr.IpNet()
+	mask := ipNet.Mask
+	if len(mask) == net.IPv6len {
+		mask = mask[12:]
+	}
+
+	proto := byte(unix.IPPROTO_TCP)
+	if addr.Proto() == "udp" {
+		proto = unix.IPPROTO_UDP
+	}
+
+	return []expr.Any{
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: 16, Len: 4},
+		&expr.Bitwise{SourceRegister: 1, DestRegister: 1, Len: 4, Mask: mask, Xor: make([]byte, 4)},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: ipNet.IP.To4().Mask(mask)},
+		&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{proto}},
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: 2, Len: 2},
+		&expr.Range{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			FromData: binaryutil.BigEndian.PutUint16(addr.LowPort()),
+			ToData:   binaryutil.BigEndian.PutUint16(addr.HighPort()),
+		},
+	}
+}
+
+// nftTproxyExprs is the equivalent of
+// '<match> tproxy to <listener ip>:<listener port> meta mark set mark | 0x1 accept'
+func nftTproxyExprs(rule *nftRule) []expr.Any {
+	return append(nftMatchExprs(rule.addr),
+		&expr.Immediate{Register: 1, Data: rule.port.GetIP().To4()},
+		&expr.Immediate{Register: 2, Data: binaryutil.BigEndian.PutUint16(uint16(rule.port.GetPort()))},
+		&expr.TProxy{
+			Family:      byte(nftables.TableFamilyIPv4),
+			TableFamily: byte(nftables.TableFamilyIPv4),
+			RegAddr:     1,
+			RegPort:     2,
+		},
+		&expr.Meta{Key: expr.MetaKeyMARK, Register: 1},
+		&expr.Bitwise{
+			SourceRegister: 1,
+			DestRegister:   1,
+			Len:            4,
+			Mask:           binaryutil.NativeEndian.PutUint32(^uint32(1)),
+			Xor:            binaryutil.NativeEndian.PutUint32(1),
+		},
+		&expr.Meta{Key: expr.MetaKeyMARK, SourceRegister: true, Register: 1},
+		&expr.Verdict{Kind: expr.VerdictAccept},
+	)
+}
+
+// nftAcceptExprs is the equivalent of 'iifname <lanIf> <match> accept'
+func nftAcceptExprs(lanIf string, rule *nftRule) []expr.Any {
+	return append([]expr.Any{
+		&expr.Meta{Key: expr.MetaKeyIIFNAME, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: nftIfName(lanIf)},
+	}, append(nftMatchExprs(rule.addr), &expr.Verdict{Kind: expr.VerdictAccept})...)
+}
+
+func nftIfName(name string) []byte {
+	// interface names are compared as fixed size, nul padded buffers
+	result := make([]byte, unix.IFNAMSIZ)
+	copy(result, name)
+	return result
+}
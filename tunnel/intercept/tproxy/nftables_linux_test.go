@@ -0,0 +1,134 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tproxy
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"github.com/google/nftables/userdata"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/util"
+	"github.com/openziti/ziti/tunnel/dns"
+	"github.com/openziti/ziti/tunnel/entities"
+	"github.com/openziti/ziti/tunnel/intercept"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+type testAddrCollector []*intercept.InterceptAddress
+
+func (self *testAddrCollector) Apply(addr *intercept.InterceptAddress) {
+	*self = append(*self, addr)
+}
+
+func newTestInterceptAddr(t *testing.T, cidr string, protocol string, low, high uint16) *intercept.InterceptAddress {
+	svc := &entities.Service{
+		ServiceDetail: rest_model.ServiceDetail{Name: util.Ptr("test")},
+		InterceptV1Config: &entities.InterceptV1Config{
+			Addresses:  []string{cidr},
+			PortRanges: []*entities.PortRange{{Low: low, High: high}},
+		},
+	}
+	var addrs testAddrCollector
+	require.NoError(t, intercept.GetInterceptAddresses(svc, []string{protocol}, dns.NewDummyResolver(), &addrs))
+	require.Len(t, addrs, 1)
+	return addrs[0]
+}
+
+func TestNftMatchExprs(t *testing.T) {
+	req := require.New(t)
+
+	exprs := nftMatchExprs(newTestInterceptAddr(t, "10.1.2.0/24", "udp", 5000, 5010))
+	req.Len(exprs, 7)
+
+	mask := exprs[1].(*expr.Bitwise)
+	req.Equal([]byte{255, 255, 255, 0}, []byte(mask.Mask))
+
+	daddr := exprs[2].(*expr.Cmp)
+	req.Equal([]byte{10, 1, 2, 0}, daddr.Data)
+
+	proto := exprs[4].(*expr.Cmp)
+	req.Equal([]byte{unix.IPPROTO_UDP}, proto.Data)
+
+	ports := exprs[6].(*expr.Range)
+	req.Equal(binaryutil.BigEndian.PutUint16(5000), ports.FromData)
+	req.Equal(binaryutil.BigEndian.PutUint16(5010), ports.ToData)
+
+	// single addresses have a full mask and tcp is the default protocol
+	exprs = nftMatchExprs(newTestInterceptAddr(t, "192.168.1.10", "tcp", 443, 443))
+	req.Equal([]byte{255, 255, 255, 255}, []byte(exprs[1].(*expr.Bitwise).Mask))
+	req.Equal([]byte{192, 168, 1, 10}, exprs[2].(*expr.Cmp).Data)
+	req.Equal([]byte{unix.IPPROTO_TCP}, exprs[4].(*expr.Cmp).Data)
+}
+
+func TestNftTproxyAndAcceptExprs(t *testing.T) {
+	req := require.New(t)
+
+	rule := &nftRule{
+		id:      7,
+		service: "web",
+		addr:    newTestInterceptAddr(t, "10.1.2.3/32", "tcp", 80, 80),
+		port:    &TCPIPPortAddr{IP: net.ParseIP("127.0.0.1"), Port: 34567},
+	}
+
+	exprs := nftTproxyExprs(rule)
+	req.Len(exprs, 7+7)
+	req.Equal([]byte{127, 0, 0, 1}, exprs[7].(*expr.Immediate).Data)
+	req.Equal(binaryutil.BigEndian.PutUint16(34567), exprs[8].(*expr.Immediate).Data)
+	tproxy := exprs[9].(*expr.TProxy)
+	req.Equal(uint32(1), tproxy.RegAddr)
+	req.Equal(uint32(2), tproxy.RegPort)
+	req.Equal(expr.VerdictAccept, exprs[len(exprs)-1].(*expr.Verdict).Kind)
+
+	exprs = nftAcceptExprs("eth0", rule)
+	req.Len(exprs, 2+7+1)
+	ifName := exprs[1].(*expr.Cmp).Data
+	req.Len(ifName, unix.IFNAMSIZ)
+	req.Equal("eth0", string(ifName[:4]))
+	req.Equal(make([]byte, unix.IFNAMSIZ-4), ifName[4:])
+	req.Equal(expr.VerdictAccept, exprs[len(exprs)-1].(*expr.Verdict).Kind)
+}
+
+func TestNftRuleHandleForComment(t *testing.T) {
+	req := require.New(t)
+
+	web := &nftRule{id: 1, service: "web"}
+	web2 := &nftRule{id: 12, service: "web"}
+	req.Equal("web [1]", web.comment())
+
+	rules := []*nftables.Rule{
+		{Handle: 4, UserData: web2.userData()},
+		{Handle: 5},
+		{Handle: 6, UserData: web.userData()},
+		{Handle: 7, UserData: userdata.AppendString(nil, userdata.TypeComment, "web")},
+	}
+
+	handle, found := nftRuleHandleForComment(rules, web.comment())
+	req.True(found)
+	req.Equal(uint64(6), handle)
+
+	handle, found = nftRuleHandleForComment(rules, web2.comment())
+	req.True(found)
+	req.Equal(uint64(4), handle)
+
+	_, found = nftRuleHandleForComment(rules, (&nftRule{id: 2, service: "web"}).comment())
+	req.False(found)
+}
@@ -18,9 +18,15 @@ package tproxy
 
 import "time"
 
+const (
+	BackendIptables = "iptables"
+	BackendNftables = "nftables"
+)
+
 type Config struct {
 	LanIf            string
 	Diverter         string
+	Backend          string // iptables (the default) or nftables. ignored if Diverter is set
 	UDPIdleTimeout   time.Duration
 	UDPCheckInterval time.Duration
 }
@@ -100,6 +100,7 @@ func New(config Config) (intercept.Interceptor, error) {
 
 	log.Infof("tproxy config: lanIf            =  [%s]", self.lanIf)
 	log.Infof("tproxy config: diverter         =  [%s]", self.diverter)
+	log.Infof("tproxy config: backend          =  [%s]", config.Backend)
 	log.Infof("tproxy config: udpIdleTimeout   =  [%s]", self.udpIdleTimeout.String())
 	log.Infof("tproxy config: udpCheckInterval =  [%s]", self.udpCheckInterval.String())
 
@@ -122,6 +123,25 @@ func New(config Config) (intercept.Interceptor, error) {
 		return self, nil
 	}
 
+	if self.lanIf != "" {
+		if _, err := net.InterfaceByName(self.lanIf); err != nil {
+			return nil, fmt.Errorf("invalid lanIf '%s'", self.lanIf)
+		}
+	} else {
+		logrus.Infof("no lan interface specified with '-lanIf'. please ensure firewall accepts intercepted service addresses")
+	}
+
+	switch config.Backend {
+	case "", BackendIptables:
+	case BackendNftables:
+		if self.nft, err = newNftRuleset(self.lanIf); err != nil {
+			return nil, err
+		}
+		return self, nil
+	default:
+		return nil, errors.Errorf("tproxy: unsupported backend '%s', must be one of [%s, %s]", config.Backend, BackendIptables, BackendNftables)
+	}
+
 	ipt, err := iptables.New()
 	if err != nil {
 		return nil, errors.Wrap(err, "tproxy: failed to initialize iptables handle")
@@ -132,16 +152,10 @@ func New(config Config) (intercept.Interceptor, error) {
 	}
 
 	if self.lanIf != "" {
-		_, err := net.InterfaceByName(self.lanIf)
-		if err != nil {
-			return nil, fmt.Errorf("invalid lanIf '%s'", self.lanIf)
-		}
 		err = self.addIptablesChain(self.ipt, filterTable, "INPUT", dstChain)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		logrus.Infof("no lan interface specified with '-lanIf'. please ensure firewall accepts intercepted service addresses")
 	}
 
 	return self, err
@@ -163,6 +177,7 @@ type interceptor struct {
 
 	serviceProxies cmap.ConcurrentMap[string, *tProxy]
 	ipt            *iptables.IPTables
+	nft            *nftRuleset
 }
 
 func (self *interceptor) Stop() {
@@ -199,6 +214,12 @@ func (self *interceptor) cleanupChains() {
 	if self.diverter != "" {
 		return
 	}
+	if self.nft != nil {
+		if self.serviceProxies.IsEmpty() {
+			self.nft.cleanup()
+		}
+		return
+	}
 	if self.serviceProxies.IsEmpty() {
 		deleteIptablesChain(self.ipt, mangleTable, "PREROUTING", dstChain)
 		if self.lanIf != "" {
@@ -541,6 +562,10 @@ func (self *tProxy) addInterceptAddr(interceptAddr *intercept.InterceptAddress,
 		} else {
 			cmdLogger.Infof("diverter command succeeded. output: %s", out)
 		}
+	} else if self.interceptor.nft != nil {
+		if err := self.interceptor.nft.add(*service.Name, interceptAddr, port); err != nil {
+			return errors.Wrap(err, "failed to add nftables rule")
+		}
 	} else {
 		interceptAddr.TproxySpec = []string{
 			"-m", "comment", "--comment", *service.Name,
@@ -603,6 +628,11 @@ func (self *tProxy) StopIntercepting(tracker intercept.AddressTracker) error {
 			} else {
 				cmdLogger.Infof("diverter command succeeded. output: %s", out)
 			}
+		} else if self.interceptor.nft != nil {
+			if err := self.interceptor.nft.remove(addr); err != nil {
+				errorList = append(errorList, err)
+				log.WithError(err).Errorf("failed to remove nftables rule for service %s", *self.service.Name)
+			}
 		} else {
 			log.Infof("Removing rule iptables -t %v -A %v %v", mangleTable, dstChain, addr.TproxySpec)
 			err := self.interceptor.ipt.Delete(mangleTable, dstChain, addr.TproxySpec...)
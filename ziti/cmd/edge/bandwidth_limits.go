@@ -0,0 +1,51 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"github.com/Jeffail/gabs"
+	"github.com/openziti/ziti/common/bandwidth"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/spf13/cobra"
+)
+
+const bandwidthLimitsFlag = "bandwidth-limits"
+
+func addBandwidthLimitsFlag(cmd *cobra.Command, target *string, entityType string) {
+	cmd.Flags().StringVar(target, bandwidthLimitsFlag, "", "Token bucket bandwidth limits for the "+entityType+
+		", in bytes per second and bytes, for example ingressRate=1048576,ingressBurst=4194304,egressRate=1048576,egressBurst=4194304. "+
+		"Bursts default to one second at the rate. An empty value removes the limits")
+}
+
+// setBandwidthLimits validates the bandwidth limits flag value and adds it to the entity. All limits are replaced
+func setBandwidthLimits(entityData *gabs.Container, val string) error {
+	limits, err := bandwidth.Parse(val)
+	if err != nil {
+		return err
+	}
+
+	if limits.IsEmpty() {
+		api.SetJSONValue(entityData, nil, "bandwidthLimits")
+		return nil
+	}
+
+	api.SetJSONValue(entityData, limits.IngressRate, "bandwidthLimits", "ingressRate")
+	api.SetJSONValue(entityData, limits.IngressBurst, "bandwidthLimits", "ingressBurst")
+	api.SetJSONValue(entityData, limits.EgressRate, "bandwidthLimits", "egressRate")
+	api.SetJSONValue(entityData, limits.EgressBurst, "bandwidthLimits", "egressBurst")
+	return nil
+}
@@ -36,6 +36,7 @@ type createIdentityOptions struct {
 	api.EntityOptions
 	isAdmin                  bool
 	roleAttributes           []string
+	permissions              []string
 	jwtOutputFile            string
 	username                 string
 	defaultHostingPrecedence string
@@ -45,6 +46,7 @@ type createIdentityOptions struct {
 	appData                  map[string]string
 	externalId               string
 	authPolicyNameOrId       string
+	bandwidthLimits          string
 }
 
 // newCreateIdentityCmd creates the 'edge controller create identity' command
@@ -87,6 +89,7 @@ func newCreateIdentityOfTypeCmd(name string, options *createIdentityOptions) *co
 	// allow interspersing positional args and flags
 	cmd.Flags().SetInterspersed(true)
 	cmd.Flags().BoolVarP(&options.isAdmin, "admin", "A", false, "Give the new identity admin privileges")
+	cmd.Flags().StringSliceVar(&options.permissions, "permissions", nil, "comma-separated management roles for the new identity [auditor,service-operator,service-operator:<role attribute>,enrollment-operator]")
 	cmd.Flags().StringVar(&options.username, "updb", "", "username to give the identity, will create a UPDB enrollment")
 	cmd.Flags().StringVar(&options.externalId, "external-id", "", "an external id to give to the identity")
 	cmd.Flags().StringSliceVarP(&options.roleAttributes, "role-attributes", "a", nil, "comma-separated role attributes for the new identity")
@@ -97,6 +100,7 @@ func newCreateIdentityOfTypeCmd(name string, options *createIdentityOptions) *co
 	cmd.Flags().StringToStringVar(&options.servicePrecedences, "service-precedences", map[string]string{}, "Per-service hosting precedences")
 	cmd.Flags().StringToStringVar(&options.appData, "app-data", nil, "Custom application data")
 	cmd.Flags().StringVarP(&options.authPolicyNameOrId, "auth-policy", "P", "default", "The name or id of the auth policy to assign to the identity")
+	addBandwidthLimitsFlag(cmd, &options.bandwidthLimits, "identity's circuits on each router")
 
 	options.AddCommonFlags(cmd)
 
@@ -118,10 +122,20 @@ func runCreateIdentity(o *createIdentityOptions) error {
 	api.SetJSONValue(entityData, o.roleAttributes, "roleAttributes")
 	api.SetJSONValue(entityData, o.appData, "appData")
 
+	if len(o.permissions) > 0 {
+		api.SetJSONValue(entityData, o.permissions, "permissions")
+	}
+
 	if o.externalId != "" {
 		api.SetJSONValue(entityData, o.externalId, "externalId")
 	}
 
+	if o.bandwidthLimits != "" {
+		if err := setBandwidthLimits(entityData, o.bandwidthLimits); err != nil {
+			return err
+		}
+	}
+
 	if o.defaultHostingPrecedence != "" {
 		prec, err := normalizeAndValidatePrecedence(o.defaultHostingPrecedence)
 		if err != nil {
@@ -42,6 +42,7 @@ func newCreatePostureCheckCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.AddCommand(newCreatePostureCheckOsCmd(out, errOut))
 	cmd.AddCommand(newCreatePostureCheckMfaCmd(out, errOut))
 	cmd.AddCommand(newCreatePostureCheckProcessMultiCmd(out, errOut))
+	cmd.AddCommand(newCreatePostureCheckSourceIpCmd(out, errOut))
 
 	return cmd
 }
@@ -69,6 +70,50 @@ type createPostureCheckDomainOptions struct {
 	domains []string
 }
 
+type createPostureCheckSourceIpOptions struct {
+	createPostureCheckOptions
+	sourceIpRules
+}
+
+// sourceIpRules holds the allow and deny lists of a SOURCE_IP posture check
+type sourceIpRules struct {
+	allowedCidrs     []string
+	deniedCidrs      []string
+	allowedCountries []string
+	deniedCountries  []string
+}
+
+func (rules *sourceIpRules) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&rules.allowedCidrs, "allowed-cidrs", nil, "CIDRs clients must connect from, may be CSV or multiple flags")
+	cmd.Flags().StringSliceVar(&rules.deniedCidrs, "denied-cidrs", nil, "CIDRs clients may not connect from, may be CSV or multiple flags")
+	cmd.Flags().StringSliceVar(&rules.allowedCountries, "allowed-countries", nil, "ISO 3166-1 alpha-2 country codes clients must connect from. Requires a geoip database on the controller")
+	cmd.Flags().StringSliceVar(&rules.deniedCountries, "denied-countries", nil, "ISO 3166-1 alpha-2 country codes clients may not connect from. Requires a geoip database on the controller")
+}
+
+// setChanged sets the lists whose flags were given, or all of them if all is true. It returns true if any were set.
+func (rules *sourceIpRules) setChanged(cmd *cobra.Command, entity *gabs.Container, all bool) bool {
+	changed := false
+	set := func(flag string, values []string, field string) {
+		if all || cmd.Flags().Changed(flag) {
+			if values == nil {
+				values = []string{}
+			}
+			api.SetJSONValue(entity, values, field)
+			changed = true
+		}
+	}
+	set("allowed-cidrs", rules.allowedCidrs, "allowedCidrs")
+	set("denied-cidrs", rules.deniedCidrs, "deniedCidrs")
+	set("allowed-countries", rules.allowedCountries, "allowedCountries")
+	set("denied-countries", rules.deniedCountries, "deniedCountries")
+	return changed
+}
+
+func (rules *sourceIpRules) isEmpty() bool {
+	return len(rules.allowedCidrs) == 0 && len(rules.deniedCidrs) == 0 &&
+		len(rules.allowedCountries) == 0 && len(rules.deniedCountries) == 0
+}
+
 type createPostureCheckOsOptions struct {
 	createPostureCheckOptions
 	os []string
@@ -307,11 +352,12 @@ const (
 	OsIOS           = "iOS"
 	OsLinux         = "Linux"
 
-	PostureCheckTypeDomain  = "DOMAIN"
-	PostureCheckTypeProcess = "PROCESS"
-	PostureCheckTypeMAC     = "MAC"
-	PostureCheckTypeOS      = "OS"
-	PostureCheckTypeMFA     = "MFA"
+	PostureCheckTypeDomain   = "DOMAIN"
+	PostureCheckTypeProcess  = "PROCESS"
+	PostureCheckTypeMAC      = "MAC"
+	PostureCheckTypeOS       = "OS"
+	PostureCheckTypeMFA      = "MFA"
+	PostureCheckTypeSourceIp = "SOURCE_IP"
 )
 
 // Returns the normalized Edge API value or empty string
@@ -593,6 +639,60 @@ func runCreatePostureCheckDomain(o *createPostureCheckDomainOptions) (err error)
 	return err
 }
 
+func newCreatePostureCheckSourceIpCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &createPostureCheckSourceIpOptions{
+		createPostureCheckOptions: createPostureCheckOptions{
+			EntityOptions: api.NewEntityOptions(out, errOut),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "source-ip <name>",
+		Short: "creates a posture check for the address clients connect from",
+		Long: "Creates a posture check for the address clients connect from. Denied CIDRs and countries take precedence " +
+			"over allowed ones. If allowed CIDRs or countries are given, the address must match one of them. The check " +
+			"is enforced by the controller when clients authenticate and create sessions.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+
+			err := runCreatePostureCheckSourceIp(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+	options.createPostureCheckOptions.addPostureFlags(cmd)
+	options.sourceIpRules.addFlags(cmd)
+
+	return cmd
+}
+
+// runCreatePostureCheckSourceIp implements the command to create a source ip posture check
+func runCreatePostureCheckSourceIp(o *createPostureCheckSourceIpOptions) error {
+	if o.sourceIpRules.isEmpty() {
+		return fmt.Errorf("must specify at least one allowed or denied CIDR or country")
+	}
+
+	entityData := gabs.New()
+	setPostureCheckEntityValues(entityData, &o.createPostureCheckOptions, PostureCheckTypeSourceIp)
+	o.sourceIpRules.setChanged(o.Cmd, entityData, true)
+
+	result, err := CreateEntityOfType("posture-checks", entityData.String(), &o.Options)
+	if err != nil {
+		return err
+	}
+
+	checkId := result.S("data", "id").Data()
+
+	_, err = fmt.Fprintf(o.Out, "%v\n", checkId)
+	return err
+}
+
 func newCreatePostureCheckOsCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	options := &createPostureCheckOsOptions{
 		createPostureCheckOptions: createPostureCheckOptions{
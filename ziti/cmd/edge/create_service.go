@@ -29,6 +29,10 @@ type createServiceOptions struct {
 	api.EntityOptions
 	maxIdleTime        time.Duration
 	terminatorStrategy string
+	multipath          string
+	priority           string
+	compression        string
+	bandwidthLimits    string
 	roleAttributes     []string
 	configs            []string
 	encryption         encryptionVar
@@ -59,6 +63,10 @@ func newCreateServiceCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVarP(&options.roleAttributes, "role-attributes", "a", nil, "comma-separated role attributes for the new service")
 	cmd.Flags().StringSliceVarP(&options.configs, "configs", "c", nil, "Configuration id or names to be associated with the new service")
 	cmd.Flags().StringVar(&options.terminatorStrategy, "terminator-strategy", "", "Specifies the terminator strategy for the service")
+	cmd.Flags().StringVar(&options.multipath, "multipath", "", "Routes circuits over two link-disjoint paths. Valid values are 'duplicate', which sends every payload over both paths, and 'failover', which only uses the second path when the first fails")
+	cmd.Flags().StringVar(&options.priority, "priority", "", "Sets the priority class links use to schedule traffic for the service's circuits. Valid values are 'interactive', 'default' and 'bulk'")
+	cmd.Flags().StringVar(&options.compression, "compression", "", "Compresses circuit payloads for the service. Valid values are 'zstd' and 'lz4'. Payloads are only compressed when the routers at both ends of the circuit support the codec")
+	addBandwidthLimitsFlag(cmd, &options.bandwidthLimits, "service's circuits")
 	cmd.Flags().DurationVar(&options.maxIdleTime, "max-idle-time", 0, "Time after which idle circuit will be terminated. Defaults to 0, which indicates no limit on idle circuits")
 
 	if err := options.encryption.Set("ON"); err != nil {
@@ -82,6 +90,20 @@ func runCreateService(o *createServiceOptions) (err error) {
 	if o.terminatorStrategy != "" {
 		api.SetJSONValue(entityData, o.terminatorStrategy, "terminatorStrategy")
 	}
+	if o.multipath != "" {
+		api.SetJSONValue(entityData, o.multipath, "multipath")
+	}
+	if o.priority != "" {
+		api.SetJSONValue(entityData, o.priority, "priority")
+	}
+	if o.compression != "" {
+		api.SetJSONValue(entityData, o.compression, "compression")
+	}
+	if o.bandwidthLimits != "" {
+		if err = setBandwidthLimits(entityData, o.bandwidthLimits); err != nil {
+			return err
+		}
+	}
 
 	api.SetJSONValue(entityData, o.encryption.Get(), "encryptionRequired")
 	api.SetJSONValue(entityData, o.roleAttributes, "roleAttributes")
@@ -21,6 +21,7 @@ import (
 	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -34,6 +35,9 @@ type createServicePolicyOptions struct {
 	identityRoles     []string
 	postureCheckRoles []string
 	semantic          string
+	validFrom         string
+	validUntil        string
+	schedules         []string
 }
 
 // newCreateServicePolicyCmd creates the 'edge controller create service-policy' command
@@ -63,6 +67,7 @@ func newCreateServicePolicyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVar(&options.identityRoles, "identity-roles", nil, "Identity roles of the new service policy")
 	cmd.Flags().StringVar(&options.semantic, "semantic", "AllOf", "Semantic dictating how multiple attributes should be interpreted. Valid values: AnyOf, AllOf")
 	cmd.Flags().StringSliceVarP(&options.postureCheckRoles, "posture-check-roles", "p", nil, "Posture check roles of the new service policy")
+	addServicePolicyScheduleFlags(cmd, &options.validFrom, &options.validUntil, &options.schedules)
 	options.AddCommonFlags(cmd)
 
 	return cmd
@@ -99,12 +104,75 @@ func runCreateServicePolicy(o *createServicePolicyOptions) error {
 	if o.semantic != "" {
 		api.SetJSONValue(entityData, o.semantic, "semantic")
 	}
+	if err = setServicePolicySchedule(o.Cmd, entityData, o.validFrom, o.validUntil, o.schedules); err != nil {
+		return err
+	}
 	o.SetTags(entityData)
 
 	result, err := CreateEntityOfType("service-policies", entityData.String(), &o.Options)
 	return o.LogCreateResult("service policy", result, err)
 }
 
+func addServicePolicyScheduleFlags(cmd *cobra.Command, validFrom, validUntil *string, schedules *[]string) {
+	cmd.Flags().StringVar(validFrom, "valid-from", "", "Time the service policy starts granting access, in RFC3339 format")
+	cmd.Flags().StringVar(validUntil, "valid-until", "", "Time the service policy stops granting access, in RFC3339 format")
+	cmd.Flags().StringArrayVar(schedules, "schedule", nil, "Recurring access window as '<cron>;<duration>[;<timezone>]', for example '0 9 * * MON-FRI;8h;America/New_York'. May be given multiple times")
+}
+
+// setServicePolicySchedule sets any schedule values which were given on the command line
+func setServicePolicySchedule(cmd *cobra.Command, entityData *gabs.Container, validFrom, validUntil string, schedules []string) error {
+	timeFlags := []struct {
+		flag  string
+		field string
+		value string
+	}{
+		{"valid-from", "validFrom", validFrom},
+		{"valid-until", "validUntil", validUntil},
+	}
+
+	for _, timeFlag := range timeFlags {
+		if !cmd.Flags().Changed(timeFlag.flag) {
+			continue
+		}
+		// an empty value clears the field
+		if timeFlag.value == "" {
+			api.SetJSONValue(entityData, nil, timeFlag.field)
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, timeFlag.value); err != nil {
+			return errors.Wrapf(err, "invalid %v value '%v'", timeFlag.flag, timeFlag.value)
+		}
+		api.SetJSONValue(entityData, timeFlag.value, timeFlag.field)
+	}
+
+	if cmd.Flags().Changed("schedule") {
+		windows := []map[string]interface{}{}
+		for _, schedule := range schedules {
+			if schedule == "" {
+				continue
+			}
+			parts := strings.Split(schedule, ";")
+			if len(parts) < 2 || len(parts) > 3 {
+				return errors.Errorf("invalid schedule '%v', expected '<cron>;<duration>[;<timezone>]'", schedule)
+			}
+			if _, err := time.ParseDuration(strings.TrimSpace(parts[1])); err != nil {
+				return errors.Wrapf(err, "invalid duration in schedule '%v'", schedule)
+			}
+			window := map[string]interface{}{
+				"cron":     strings.TrimSpace(parts[0]),
+				"duration": strings.TrimSpace(parts[1]),
+			}
+			if len(parts) == 3 {
+				window["timezone"] = strings.TrimSpace(parts[2])
+			}
+			windows = append(windows, window)
+		}
+		api.SetJSONValue(entityData, windows, "schedules")
+	}
+
+	return nil
+}
+
 func convertNamesToIds(roles []string, entityType string, o api.Options) ([]string, error) {
 	var result []string
 	for _, val := range roles {
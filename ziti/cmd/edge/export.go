@@ -0,0 +1,751 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// edgeExportRef is a field holding the id, or list of ids, of another entity. In exported documents the ids are
+// replaced by entity names, and single value references drop their Id suffix, so configTypeId becomes configType
+type edgeExportRef struct {
+	path       []string
+	docPath    []string
+	entityType string
+	list       bool
+}
+
+// edgeExportRoles is a policy role field. Roles of the form @<id> are exported as @<name>
+type edgeExportRoles struct {
+	field      string
+	entityType string
+}
+
+// edgeExportKeyRef is a map field keyed by entity id, such as an identity's service hosting costs. The keys are
+// exported as entity names
+type edgeExportKeyRef struct {
+	field      string
+	entityType string
+}
+
+type edgeExportType struct {
+	entityType string
+	readOnly   []string
+	renames    map[string]string
+	refs       []edgeExportRef
+	roles      []edgeExportRoles
+	keyRefs    []edgeExportKeyRef
+	skip       func(entity map[string]interface{}) bool
+	noPrune    func(entity map[string]interface{}) bool
+
+	// createOnly fields are only sent when creating, and createDefaults are used when creating if not set
+	createOnly     []string
+	createDefaults map[string]interface{}
+
+	// deferred fields reference entity types which come later in the dependency order, so import applies them in a
+	// second pass, once all entities have been created
+	deferred []string
+}
+
+// edgeExportTypes lists the exported entity types in dependency order. Import creates and updates entities in this
+// order and prunes in reverse
+var edgeExportTypes = []*edgeExportType{
+	{
+		entityType: "config-types",
+	},
+	{
+		entityType: "configs",
+		readOnly:   []string{"configType"},
+		refs: []edgeExportRef{
+			{path: []string{"configTypeId"}, docPath: []string{"configType"}, entityType: "config-types"},
+		},
+	},
+	{
+		entityType: "posture-checks",
+		readOnly:   []string{"version"},
+	},
+	{
+		entityType: "external-jwt-signers",
+		readOnly:   []string{"commonName", "fingerprint", "notAfter", "notBefore"},
+	},
+	{
+		entityType: "auth-policies",
+		refs: []edgeExportRef{
+			{path: []string{"primary", "extJwt", "allowedSigners"}, entityType: "external-jwt-signers", list: true},
+			{path: []string{"secondary", "requireExtJwtSigner"}, entityType: "external-jwt-signers"},
+		},
+		noPrune: func(entity map[string]interface{}) bool {
+			return entity["id"] == "default"
+		},
+	},
+	{
+		entityType: "identities",
+		readOnly: []string{"authPolicy", "authenticators", "enrollment", "envInfo", "sdkInfo", "hasApiSession",
+			"hasEdgeRouterConnection", "isDefaultAdmin", "isMfaEnabled", "disabled", "disabledAt", "disabledUntil", "type"},
+		renames:    map[string]string{"typeId": "type"},
+		createOnly: []string{"enrollment"},
+		createDefaults: map[string]interface{}{
+			"type":       "Default",
+			"enrollment": map[string]interface{}{"ott": true},
+		},
+		refs: []edgeExportRef{
+			{path: []string{"authPolicyId"}, docPath: []string{"authPolicy"}, entityType: "auth-policies"},
+		},
+		keyRefs: []edgeExportKeyRef{
+			{field: "serviceHostingCosts", entityType: "services"},
+			{field: "serviceHostingPrecedences", entityType: "services"},
+		},
+		deferred: []string{"serviceHostingCosts", "serviceHostingPrecedences"},
+		skip: func(entity map[string]interface{}) bool {
+			isDefaultAdmin, _ := entity["isDefaultAdmin"].(bool)
+			return isDefaultAdmin || entity["typeId"] == "Router"
+		},
+	},
+	{
+		entityType: "services",
+		readOnly:   []string{"config", "permissions", "postureQueries"},
+		refs: []edgeExportRef{
+			{path: []string{"configs"}, entityType: "configs", list: true},
+		},
+	},
+	{
+		entityType: "service-policies",
+		readOnly:   []string{"identityRolesDisplay", "serviceRolesDisplay", "postureCheckRolesDisplay"},
+		roles: []edgeExportRoles{
+			{field: "identityRoles", entityType: "identities"},
+			{field: "serviceRoles", entityType: "services"},
+			{field: "postureCheckRoles", entityType: "posture-checks"},
+		},
+	},
+	{
+		entityType: "edge-router-policies",
+		readOnly:   []string{"identityRolesDisplay", "edgeRouterRolesDisplay", "isSystem"},
+		roles: []edgeExportRoles{
+			{field: "identityRoles", entityType: "identities"},
+			{field: "edgeRouterRoles", entityType: "edge-routers"},
+		},
+		skip: func(entity map[string]interface{}) bool {
+			isSystem, _ := entity["isSystem"].(bool)
+			return isSystem
+		},
+	},
+	{
+		entityType: "service-edge-router-policies",
+		readOnly:   []string{"serviceRolesDisplay", "edgeRouterRolesDisplay"},
+		roles: []edgeExportRoles{
+			{field: "serviceRoles", entityType: "services"},
+			{field: "edgeRouterRoles", entityType: "edge-routers"},
+		},
+	},
+}
+
+var edgeExportCommonReadOnly = []string{"id", "createdAt", "updatedAt", "_links"}
+
+func getEdgeExportType(entityType string) *edgeExportType {
+	for _, t := range edgeExportTypes {
+		if t.entityType == entityType {
+			return t
+		}
+	}
+	return nil
+}
+
+func (self *edgeExportType) isSkipped(entity map[string]interface{}) bool {
+	return self.skip != nil && self.skip(entity)
+}
+
+func (self *edgeExportType) isPrunable(entity map[string]interface{}) bool {
+	return !self.isSkipped(entity) && (self.noPrune == nil || !self.noPrune(entity))
+}
+
+// withoutDeferred returns a copy of the entity without its deferred fields
+func (self *edgeExportType) withoutDeferred(entity map[string]interface{}) map[string]interface{} {
+	if len(self.deferred) == 0 {
+		return entity
+	}
+	result := map[string]interface{}{}
+	for k, v := range entity {
+		if !stringz.Contains(self.deferred, k) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// onlyDeferred returns the deferred fields of the entity, or nil if it has none
+func (self *edgeExportType) onlyDeferred(entity map[string]interface{}) map[string]interface{} {
+	var result map[string]interface{}
+	for _, field := range self.deferred {
+		if v, found := entity[field]; found {
+			if result == nil {
+				result = map[string]interface{}{}
+			}
+			result[field] = v
+		}
+	}
+	return result
+}
+
+func (self *edgeExportRef) getDocPath() []string {
+	if self.docPath != nil {
+		return self.docPath
+	}
+	return self.path
+}
+
+// toDocument converts an entity, as returned by the edge management API, to its exported form
+func (self *edgeExportType) toDocument(entity map[string]interface{}, index *edgeEntityIndex) (map[string]interface{}, error) {
+	result := normalizeEdgeExportValue(entity).(map[string]interface{})
+
+	for _, field := range edgeExportCommonReadOnly {
+		delete(result, field)
+	}
+	for _, field := range self.readOnly {
+		delete(result, field)
+	}
+	for from, to := range self.renames {
+		if v, found := result[from]; found {
+			delete(result, from)
+			result[to] = v
+		}
+	}
+
+	for _, ref := range self.refs {
+		val, found := getMapPath(result, ref.path)
+		if !found {
+			continue
+		}
+		deleteMapPath(result, ref.path)
+		mapped, err := mapEdgeExportRef(val, ref.list, func(id string) (string, error) {
+			return index.nameForId(ref.entityType, id)
+		})
+		if err != nil {
+			return nil, err
+		}
+		setMapPath(result, ref.getDocPath(), mapped)
+	}
+
+	for _, roles := range self.roles {
+		mapped, err := mapEdgeExportRoles(result[roles.field], func(id string) (string, error) {
+			return index.nameForId(roles.entityType, id)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if mapped != nil {
+			result[roles.field] = mapped
+		}
+	}
+
+	for _, keyRef := range self.keyRefs {
+		mapped, err := mapEdgeExportKeys(result[keyRef.field], func(id string) (string, error) {
+			return index.nameForId(keyRef.entityType, id)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if mapped != nil {
+			result[keyRef.field] = mapped
+		}
+	}
+
+	// role attributes are a set, so they're sorted to keep exports stable
+	if attrs, ok := result["roleAttributes"].([]interface{}); ok {
+		sortEdgeExportList(attrs)
+	}
+
+	return result, nil
+}
+
+// toRequest converts an entity from an exported document to a create or patch request body, resolving names to ids
+func (self *edgeExportType) toRequest(doc map[string]interface{}, index *edgeEntityIndex) (map[string]interface{}, error) {
+	result := normalizeEdgeExportValue(doc).(map[string]interface{})
+
+	for _, ref := range self.refs {
+		val, found := getMapPath(result, ref.getDocPath())
+		if !found {
+			continue
+		}
+		deleteMapPath(result, ref.getDocPath())
+		mapped, err := mapEdgeExportRef(val, ref.list, func(name string) (string, error) {
+			return index.idForName(ref.entityType, name)
+		})
+		if err != nil {
+			return nil, err
+		}
+		setMapPath(result, ref.path, mapped)
+	}
+
+	for _, roles := range self.roles {
+		mapped, err := mapEdgeExportRoles(result[roles.field], func(name string) (string, error) {
+			return index.idForName(roles.entityType, name)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if mapped != nil {
+			result[roles.field] = mapped
+		}
+	}
+
+	for _, keyRef := range self.keyRefs {
+		mapped, err := mapEdgeExportKeys(result[keyRef.field], func(name string) (string, error) {
+			return index.idForName(keyRef.entityType, name)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if mapped != nil {
+			result[keyRef.field] = mapped
+		}
+	}
+
+	return result, nil
+}
+
+func mapEdgeExportRef(val interface{}, list bool, mapF func(string) (string, error)) (interface{}, error) {
+	if val == nil {
+		return nil, nil
+	}
+
+	if !list {
+		s, ok := val.(string)
+		if !ok {
+			return nil, errors.Errorf("expected string reference, got %T", val)
+		}
+		if s == "" {
+			return s, nil
+		}
+		return mapF(s)
+	}
+
+	vals, ok := val.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("expected list of references, got %T", val)
+	}
+
+	var result []interface{}
+	for _, v := range vals {
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.Errorf("expected string reference, got %T", v)
+		}
+		mapped, err := mapF(s)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, mapped)
+	}
+	sortEdgeExportList(result)
+	return result, nil
+}
+
+func mapEdgeExportRoles(val interface{}, mapF func(string) (string, error)) (interface{}, error) {
+	if val == nil {
+		return nil, nil
+	}
+
+	roles, ok := val.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("expected list of roles, got %T", val)
+	}
+
+	result := make([]interface{}, 0, len(roles))
+	for _, v := range roles {
+		role, ok := v.(string)
+		if !ok {
+			return nil, errors.Errorf("expected string role, got %T", v)
+		}
+		if strings.HasPrefix(role, "@") {
+			mapped, err := mapF(strings.TrimPrefix(role, "@"))
+			if err != nil {
+				return nil, err
+			}
+			role = "@" + mapped
+		}
+		result = append(result, role)
+	}
+	sortEdgeExportList(result)
+	return result, nil
+}
+
+func mapEdgeExportKeys(val interface{}, mapF func(string) (string, error)) (interface{}, error) {
+	if val == nil {
+		return nil, nil
+	}
+
+	m, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("expected map keyed by reference, got %T", val)
+	}
+
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		mapped, err := mapF(k)
+		if err != nil {
+			return nil, err
+		}
+		result[mapped] = v
+	}
+	return result, nil
+}
+
+func sortEdgeExportList(list []interface{}) {
+	sort.Slice(list, func(i, j int) bool {
+		return fmt.Sprint(list[i]) < fmt.Sprint(list[j])
+	})
+}
+
+// normalizeEdgeExportValue deep copies a value by round tripping it through JSON, so values decoded from YAML and
+// values returned by the API compare equal
+func normalizeEdgeExportValue(val interface{}) interface{} {
+	buf, err := json.Marshal(val)
+	if err != nil {
+		return val
+	}
+	var result interface{}
+	if err = json.Unmarshal(buf, &result); err != nil {
+		return val
+	}
+	return result
+}
+
+func getMapPath(m map[string]interface{}, path []string) (interface{}, bool) {
+	for i, key := range path {
+		val, found := m[key]
+		if !found {
+			return nil, false
+		}
+		if i == len(path)-1 {
+			return val, true
+		}
+		if m, found = val.(map[string]interface{}); !found {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+func setMapPath(m map[string]interface{}, path []string, val interface{}) {
+	for _, key := range path[:len(path)-1] {
+		child, ok := m[key].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			m[key] = child
+		}
+		m = child
+	}
+	m[path[len(path)-1]] = val
+}
+
+func deleteMapPath(m map[string]interface{}, path []string) {
+	for _, key := range path[:len(path)-1] {
+		child, ok := m[key].(map[string]interface{})
+		if !ok {
+			return
+		}
+		m = child
+	}
+	delete(m, path[len(path)-1])
+}
+
+type edgeEntityList struct {
+	entities  []map[string]interface{}
+	idsByName map[string]string
+	namesById map[string]string
+}
+
+func (self *edgeEntityList) add(id, name string) {
+	self.idsByName[name] = id
+	self.namesById[id] = name
+}
+
+// edgeEntityIndex lazily loads entities by type and maps between entity names and ids
+type edgeEntityIndex struct {
+	load  func(entityType string) ([]map[string]interface{}, error)
+	lists map[string]*edgeEntityList
+}
+
+func newEdgeEntityIndex(load func(entityType string) ([]map[string]interface{}, error)) *edgeEntityIndex {
+	return &edgeEntityIndex{
+		load:  load,
+		lists: map[string]*edgeEntityList{},
+	}
+}
+
+func (self *edgeEntityIndex) get(entityType string) (*edgeEntityList, error) {
+	if list, found := self.lists[entityType]; found {
+		return list, nil
+	}
+
+	entities, err := self.load(entityType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list %v", entityType)
+	}
+
+	list := &edgeEntityList{
+		entities:  entities,
+		idsByName: map[string]string{},
+		namesById: map[string]string{},
+	}
+
+	for _, entity := range entities {
+		id, _ := entity["id"].(string)
+		name, _ := entity["name"].(string)
+		list.add(id, name)
+	}
+
+	self.lists[entityType] = list
+	return list, nil
+}
+
+func (self *edgeEntityIndex) add(entityType, id, name string) error {
+	list, err := self.get(entityType)
+	if err != nil {
+		return err
+	}
+	list.add(id, name)
+	return nil
+}
+
+// nameForId returns the name of the entity with the given id. Dangling references are returned unchanged
+func (self *edgeEntityIndex) nameForId(entityType, id string) (string, error) {
+	list, err := self.get(entityType)
+	if err != nil {
+		return "", err
+	}
+	if name, found := list.namesById[id]; found {
+		return name, nil
+	}
+	return id, nil
+}
+
+// idForName returns the id of the entity with the given name. Ids are also accepted, so hand written documents may
+// reference entities either way
+func (self *edgeEntityIndex) idForName(entityType, name string) (string, error) {
+	list, err := self.get(entityType)
+	if err != nil {
+		return "", err
+	}
+	if id, found := list.idsByName[name]; found {
+		return id, nil
+	}
+	if _, found := list.namesById[name]; found {
+		return name, nil
+	}
+	return "", errors.Errorf("no %v found with name %v", boltz.GetSingularEntityType(entityType), name)
+}
+
+// listAllEntitiesOfType pages through all entities of the given type
+func listAllEntitiesOfType(entityType string, o *api.Options) ([]map[string]interface{}, error) {
+	var result []map[string]interface{}
+	for {
+		params := url.Values{}
+		params.Add("filter", fmt.Sprintf("true sort by name skip %d limit 500", len(result)))
+		children, paging, err := ListEntitiesOfType(entityType, params, false, nil, o.Timeout, o.Verbose)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, child := range children {
+			entity, ok := child.Data().(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("unexpected %v entry of type %T", entityType, child.Data())
+			}
+			result = append(result, entity)
+		}
+
+		if len(children) == 0 || paging == nil || paging.HasError() || int64(len(result)) >= paging.Count {
+			return result, nil
+		}
+	}
+}
+
+type exportOptions struct {
+	api.Options
+	types      []string
+	outputJson bool
+}
+
+// newExportCmd creates the 'edge export' command
+func newExportCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &exportOptions{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{Out: out, Err: errOut},
+		},
+	}
+
+	var typeNames []string
+	for _, t := range edgeExportTypes {
+		typeNames = append(typeNames, t.entityType)
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export [output file]",
+		Short: "exports the edge configuration of a network as YAML or JSON",
+		Long: "Exports identities, services, configs, config types, policies, posture checks, auth policies and external JWT " +
+			"signers managed by the Ziti Edge Controller. References between entities are exported by name, so the " +
+			"document may be applied to another network with 'ziti edge import'. Router identities, the default admin " +
+			"and system policies are not exported",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runExport(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().StringSliceVar(&options.types, "types", nil, "Entity types to export, defaults to all of: "+strings.Join(typeNames, ", "))
+	cmd.Flags().BoolVar(&options.outputJson, "json", false, "Export as JSON instead of YAML")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runExport(o *exportOptions) error {
+	types, err := getEdgeExportTypes(o.types)
+	if err != nil {
+		return err
+	}
+
+	index := newEdgeEntityIndex(func(entityType string) ([]map[string]interface{}, error) {
+		return listAllEntitiesOfType(entityType, &o.Options)
+	})
+
+	doc, err := exportEdgeEntities(types, index)
+	if err != nil {
+		return err
+	}
+
+	var buf []byte
+	if o.outputJson {
+		buf, err = json.MarshalIndent(doc.toMap(), "", "    ")
+		buf = append(buf, '\n')
+	} else {
+		buf, err = doc.toYaml()
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(o.Args) > 0 {
+		return os.WriteFile(o.Args[0], buf, 0600)
+	}
+
+	_, err = o.Out.Write(buf)
+	return err
+}
+
+func getEdgeExportTypes(names []string) ([]*edgeExportType, error) {
+	if len(names) == 0 {
+		return edgeExportTypes, nil
+	}
+
+	var result []*edgeExportType
+	for _, t := range edgeExportTypes {
+		if stringz.Contains(names, t.entityType) {
+			result = append(result, t)
+		}
+	}
+
+	for _, name := range names {
+		if getEdgeExportType(name) == nil {
+			return nil, errors.Errorf("unsupported entity type %v", name)
+		}
+	}
+
+	return result, nil
+}
+
+// edgeExportDocument holds exported entities by entity type, in dependency order
+type edgeExportDocument struct {
+	sections []*edgeExportSection
+}
+
+type edgeExportSection struct {
+	entityType string
+	entities   []map[string]interface{}
+}
+
+func (self *edgeExportDocument) toMap() map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, section := range self.sections {
+		result[section.entityType] = section.entities
+	}
+	return result
+}
+
+// toYaml marshals the document keeping sections in dependency order, which is easier to read than sorted keys
+func (self *edgeExportDocument) toYaml() ([]byte, error) {
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	for _, section := range self.sections {
+		val := &yaml.Node{}
+		if err := val.Encode(section.entities); err != nil {
+			return nil, err
+		}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: section.entityType}, val)
+	}
+	return yaml.Marshal(root)
+}
+
+func exportEdgeEntities(types []*edgeExportType, index *edgeEntityIndex) (*edgeExportDocument, error) {
+	doc := &edgeExportDocument{}
+	for _, t := range types {
+		list, err := index.get(t.entityType)
+		if err != nil {
+			return nil, err
+		}
+
+		section := &edgeExportSection{
+			entityType: t.entityType,
+			entities:   []map[string]interface{}{},
+		}
+
+		for _, entity := range list.entities {
+			if t.isSkipped(entity) {
+				continue
+			}
+			exported, err := t.toDocument(entity, index)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to export %v %v", boltz.GetSingularEntityType(t.entityType), entity["name"])
+			}
+			section.entities = append(section.entities, exported)
+		}
+
+		doc.sections = append(doc.sections, section)
+	}
+	return doc, nil
+}
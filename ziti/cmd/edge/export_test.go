@@ -0,0 +1,206 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEdgeEntityIndex() *edgeEntityIndex {
+	entities := map[string][]map[string]interface{}{
+		"config-types": {
+			{"id": "ct1", "name": "intercept.v1", "schema": map[string]interface{}{}},
+		},
+		"configs": {
+			{"id": "c1", "name": "web-intercept", "configTypeId": "ct1", "configType": map[string]interface{}{"id": "ct1", "name": "intercept.v1"},
+				"data": map[string]interface{}{"port": 443}, "createdAt": "2024-01-01T00:00:00Z"},
+		},
+		"identities": {
+			{"id": "i0", "name": "admin", "isDefaultAdmin": true, "typeId": "User"},
+			{"id": "i1", "name": "alice", "typeId": "User", "authPolicyId": "default", "roleAttributes": []interface{}{"users"},
+				"hasApiSession": true, "serviceHostingCosts": map[string]interface{}{"s1": 10}},
+			{"id": "r1", "name": "router", "typeId": "Router"},
+		},
+		"auth-policies": {
+			{"id": "default", "name": "Default"},
+		},
+		"services": {
+			{"id": "s1", "name": "web", "configs": []interface{}{"c1"}, "permissions": []interface{}{"Dial"}},
+		},
+		"edge-routers": {
+			{"id": "er1", "name": "edge-1"},
+		},
+		"service-policies": {
+			{"id": "sp1", "name": "web-dial", "type": "Dial", "identityRoles": []interface{}{"@i1", "#users"},
+				"serviceRoles": []interface{}{"@s1"}, "postureCheckRoles": []interface{}{}},
+		},
+		"posture-checks": {},
+	}
+
+	return newEdgeEntityIndex(func(entityType string) ([]map[string]interface{}, error) {
+		return entities[entityType], nil
+	})
+}
+
+func TestExportEdgeEntities(t *testing.T) {
+	req := require.New(t)
+
+	index := newTestEdgeEntityIndex()
+	types, err := getEdgeExportTypes([]string{"configs", "identities", "service-policies"})
+	req.NoError(err)
+
+	doc, err := exportEdgeEntities(types, index)
+	req.NoError(err)
+	req.Len(doc.sections, 3)
+
+	configs := doc.sections[0]
+	req.Equal("configs", configs.entityType)
+	req.Equal([]map[string]interface{}{
+		{"name": "web-intercept", "configType": "intercept.v1", "data": map[string]interface{}{"port": float64(443)}},
+	}, configs.entities)
+
+	identities := doc.sections[1]
+	req.Equal([]map[string]interface{}{
+		{"name": "alice", "type": "User", "authPolicy": "Default", "roleAttributes": []interface{}{"users"},
+			"serviceHostingCosts": map[string]interface{}{"web": float64(10)}},
+	}, identities.entities)
+
+	policies := doc.sections[2]
+	req.Equal([]map[string]interface{}{
+		{"name": "web-dial", "type": "Dial", "identityRoles": []interface{}{"#users", "@alice"},
+			"serviceRoles": []interface{}{"@web"}, "postureCheckRoles": []interface{}{}},
+	}, policies.entities)
+
+	_, err = getEdgeExportTypes([]string{"edge-routers"})
+	req.Error(err)
+}
+
+func TestParseEdgeExportDocument(t *testing.T) {
+	req := require.New(t)
+
+	doc, err := parseEdgeExportDocument([]byte(`
+services:
+  - name: web
+config-types:
+  - name: host.v1
+`))
+	req.NoError(err)
+	req.Len(doc.sections, 2)
+	req.Equal("config-types", doc.sections[0].entityType)
+	req.Equal("services", doc.sections[1].entityType)
+
+	doc, err = parseEdgeExportDocument([]byte(`{"identities": [{"name": "bob"}]}`))
+	req.NoError(err)
+	req.Len(doc.sections, 1)
+	req.Equal("bob", doc.sections[0].entities[0]["name"])
+
+	_, err = parseEdgeExportDocument([]byte("routers: []"))
+	req.Error(err)
+}
+
+func TestPlanEdgeImport(t *testing.T) {
+	req := require.New(t)
+	index := newTestEdgeEntityIndex()
+
+	// an exported document applied to the same network is a no-op
+	doc, err := exportEdgeEntities(edgeExportTypes, index)
+	req.NoError(err)
+	for _, section := range doc.sections {
+		changes, deletes, _, err := planEdgeImport(getEdgeExportType(section.entityType), section.entities, index, true)
+		req.NoError(err)
+		req.Empty(changes, section.entityType)
+		req.Empty(deletes, section.entityType)
+	}
+
+	identities := getEdgeExportType("identities")
+	changes, deletes, unchanged, err := planEdgeImport(identities, []map[string]interface{}{
+		{"name": "alice", "type": "User", "roleAttributes": []interface{}{"users", "admins"}},
+		{"name": "bob", "authPolicy": "Default"},
+	}, index, true)
+	req.NoError(err)
+	req.Equal(0, unchanged)
+	req.Empty(deletes)
+	req.Len(changes, 2)
+
+	req.Equal(edgeImportUpdate, changes[0].action)
+	req.Equal("i1", changes[0].id)
+	req.Equal([]string{`roleAttributes: ["users"] => ["admins","users"]`}, changes[0].changes)
+
+	req.Equal(edgeImportCreate, changes[1].action)
+	req.Equal("default", changes[1].request["authPolicyId"])
+	req.Equal("Default", changes[1].request["type"])
+	req.NotNil(changes[1].request["enrollment"])
+
+	// policies resolve references by name, including entities created earlier in the import
+	req.NoError(index.add("identities", "bob", "bob"))
+	servicePolicies := getEdgeExportType("service-policies")
+	changes, deletes, _, err = planEdgeImport(servicePolicies, []map[string]interface{}{
+		{"name": "web-bind", "type": "Bind", "identityRoles": []interface{}{"@bob"}, "serviceRoles": []interface{}{"@web", "#all"}},
+	}, index, true)
+	req.NoError(err)
+	req.Len(changes, 1)
+	req.Equal([]interface{}{"@bob"}, changes[0].request["identityRoles"])
+	req.Equal([]interface{}{"#all", "@s1"}, changes[0].request["serviceRoles"])
+	req.Len(deletes, 1)
+	req.Equal("sp1", deletes[0].id)
+
+	_, _, _, err = planEdgeImport(servicePolicies, []map[string]interface{}{
+		{"name": "web-bind", "identityRoles": []interface{}{"@carol"}},
+	}, index, false)
+	req.Error(err)
+}
+
+func TestPlanEdgeImportDeferred(t *testing.T) {
+	req := require.New(t)
+	index := newTestEdgeEntityIndex()
+	identities := getEdgeExportType("identities")
+
+	// hosting costs are keyed by service, so they're left out of the first pass, where services may not exist yet
+	desired := []map[string]interface{}{
+		{"name": "alice", "type": "User", "roleAttributes": []interface{}{"users"}, "serviceHostingCosts": map[string]interface{}{"api": 5}},
+		{"name": "bob", "serviceHostingPrecedences": map[string]interface{}{"web": "required"}},
+	}
+	changes, _, unchanged, err := planEdgeImport(identities, desired, index, false)
+	req.NoError(err)
+	req.Equal(1, unchanged)
+	req.Len(changes, 1)
+	req.Equal(edgeImportCreate, changes[0].action)
+	req.NotContains(changes[0].request, "serviceHostingPrecedences")
+
+	req.NoError(index.add("identities", "i2", "bob"))
+	req.NoError(index.add("services", "s2", "api"))
+
+	changes, err = planEdgeImportDeferred(identities, desired, index)
+	req.NoError(err)
+	req.Len(changes, 2)
+
+	req.Equal("i1", changes[0].id)
+	req.True(changes[0].deferred)
+	req.Equal(map[string]interface{}{"serviceHostingCosts": map[string]interface{}{"s2": float64(5)}}, changes[0].request)
+
+	req.Equal("i2", changes[1].id)
+	req.Equal(map[string]interface{}{"serviceHostingPrecedences": map[string]interface{}{"s1": "required"}}, changes[1].request)
+
+	// unchanged hosting costs produce no update
+	changes, err = planEdgeImportDeferred(identities, []map[string]interface{}{
+		{"name": "alice", "serviceHostingCosts": map[string]interface{}{"web": 10}},
+	}, index)
+	req.NoError(err)
+	req.Empty(changes)
+}
@@ -0,0 +1,487 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	edgeImportCreate = "create"
+	edgeImportUpdate = "update"
+	edgeImportDelete = "delete"
+)
+
+type importOptions struct {
+	api.Options
+	dryRun bool
+	prune  bool
+}
+
+// newImportCmd creates the 'edge import' command
+func newImportCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &importOptions{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{Out: out, Err: errOut},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import <input file>",
+		Short: "applies a YAML or JSON document created by 'ziti edge export'",
+		Long: "Applies a YAML or JSON document created by 'ziti edge export'. Entities are matched by name. Missing entities " +
+			"are created and entities which differ from the document are updated, so importing the same document twice " +
+			"makes no changes. References to other entities are resolved by name. New identities are created with a " +
+			"one-time-token enrollment unless the document specifies one",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runImport(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().BoolVar(&options.dryRun, "dry-run", false, "Show the changes the import would make without applying them")
+	cmd.Flags().BoolVar(&options.prune, "prune", false, "Delete entities of the types present in the document which the document doesn't contain")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runImport(o *importOptions) error {
+	buf, err := os.ReadFile(o.Args[0])
+	if err != nil {
+		return err
+	}
+
+	doc, err := parseEdgeExportDocument(buf)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse %v", o.Args[0])
+	}
+
+	index := newEdgeEntityIndex(func(entityType string) ([]map[string]interface{}, error) {
+		return listAllEntitiesOfType(entityType, &o.Options)
+	})
+
+	importer := &edgeImporter{
+		options: o,
+		index:   index,
+		changed: map[string]struct{}{},
+	}
+	return importer.run(doc)
+}
+
+// parseEdgeExportDocument parses an exported document. JSON is valid YAML, so both formats are handled the same way
+func parseEdgeExportDocument(buf []byte) (*edgeExportDocument, error) {
+	raw := map[string]interface{}{}
+	if err := yaml.Unmarshal(buf, &raw); err != nil {
+		return nil, err
+	}
+
+	for entityType := range raw {
+		if getEdgeExportType(entityType) == nil {
+			return nil, errors.Errorf("unsupported entity type %v", entityType)
+		}
+	}
+
+	doc := &edgeExportDocument{}
+	for _, t := range edgeExportTypes {
+		val, found := raw[t.entityType]
+		if !found {
+			continue
+		}
+
+		section := &edgeExportSection{
+			entityType: t.entityType,
+			entities:   []map[string]interface{}{},
+		}
+
+		if val != nil {
+			list, ok := normalizeEdgeExportValue(val).([]interface{})
+			if !ok {
+				return nil, errors.Errorf("%v must be a list, got %T", t.entityType, val)
+			}
+
+			for _, v := range list {
+				entity, ok := v.(map[string]interface{})
+				if !ok {
+					return nil, errors.Errorf("%v entries must be objects, got %T", t.entityType, v)
+				}
+				section.entities = append(section.entities, entity)
+			}
+		}
+
+		doc.sections = append(doc.sections, section)
+	}
+
+	return doc, nil
+}
+
+type edgeImportAction struct {
+	action     string
+	entityType string
+	id         string
+	name       string
+	request    map[string]interface{}
+	changes    []string
+	deferred   bool
+}
+
+func (self *edgeImportAction) String() string {
+	return fmt.Sprintf("%v %v %v", self.action, boltz.GetSingularEntityType(self.entityType), self.name)
+}
+
+// planEdgeImport compares the entities of one type in an exported document to those in the index. It returns the
+// creates and updates needed to match the document and, if pruning, the deletes. The number of unchanged entities is
+// also returned
+func planEdgeImport(t *edgeExportType, desired []map[string]interface{}, index *edgeEntityIndex, prune bool) ([]*edgeImportAction, []*edgeImportAction, int, error) {
+	list, err := index.get(t.entityType)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	existingByName := map[string]map[string]interface{}{}
+	for _, entity := range list.entities {
+		name, _ := entity["name"].(string)
+		existingByName[name] = entity
+	}
+
+	var changes []*edgeImportAction
+	var deletes []*edgeImportAction
+	unchanged := 0
+	names := map[string]struct{}{}
+
+	for _, entity := range desired {
+		name, _ := entity["name"].(string)
+		if name == "" {
+			return nil, nil, 0, errors.Errorf("%v entry has no name", t.entityType)
+		}
+
+		if _, found := names[name]; found {
+			return nil, nil, 0, errors.Errorf("duplicate %v %v", boltz.GetSingularEntityType(t.entityType), name)
+		}
+		names[name] = struct{}{}
+
+		request, err := t.toRequest(t.withoutDeferred(entity), index)
+		if err != nil {
+			return nil, nil, 0, errors.Wrapf(err, "unable to resolve references of %v %v", boltz.GetSingularEntityType(t.entityType), name)
+		}
+
+		existing, found := existingByName[name]
+		if !found {
+			for k, v := range t.createDefaults {
+				if _, found = request[k]; !found {
+					request[k] = v
+				}
+			}
+			changes = append(changes, &edgeImportAction{
+				action:     edgeImportCreate,
+				entityType: t.entityType,
+				name:       name,
+				request:    request,
+			})
+			continue
+		}
+
+		current, err := t.toDocument(existing, index)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		canonical, err := t.toDocument(request, index)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		for _, to := range t.renames {
+			if v, found := request[to]; found {
+				canonical[to] = v
+			}
+		}
+
+		for _, field := range t.createOnly {
+			delete(canonical, field)
+			delete(request, field)
+		}
+
+		if diff := diffEdgeExportEntity(current, canonical); len(diff) > 0 {
+			id, _ := existing["id"].(string)
+			changes = append(changes, &edgeImportAction{
+				action:     edgeImportUpdate,
+				entityType: t.entityType,
+				id:         id,
+				name:       name,
+				request:    request,
+				changes:    diff,
+			})
+		} else {
+			unchanged++
+		}
+	}
+
+	if prune {
+		for _, entity := range list.entities {
+			name, _ := entity["name"].(string)
+			if _, found := names[name]; found || !t.isPrunable(entity) {
+				continue
+			}
+			id, _ := entity["id"].(string)
+			deletes = append(deletes, &edgeImportAction{
+				action:     edgeImportDelete,
+				entityType: t.entityType,
+				id:         id,
+				name:       name,
+			})
+		}
+	}
+
+	return changes, deletes, unchanged, nil
+}
+
+// planEdgeImportDeferred compares the deferred fields of the entities of one type in an exported document to those in
+// the index, returning the updates needed to match the document. It runs after all entities have been created, so
+// entities created by the import are matched through the index, with no current values
+func planEdgeImportDeferred(t *edgeExportType, desired []map[string]interface{}, index *edgeEntityIndex) ([]*edgeImportAction, error) {
+	list, err := index.get(t.entityType)
+	if err != nil {
+		return nil, err
+	}
+
+	existingByName := map[string]map[string]interface{}{}
+	for _, entity := range list.entities {
+		name, _ := entity["name"].(string)
+		existingByName[name] = entity
+	}
+
+	var result []*edgeImportAction
+	for _, entity := range desired {
+		deferred := t.onlyDeferred(entity)
+		if deferred == nil {
+			continue
+		}
+
+		name, _ := entity["name"].(string)
+		request, err := t.toRequest(deferred, index)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to resolve references of %v %v", boltz.GetSingularEntityType(t.entityType), name)
+		}
+
+		canonical, err := t.toDocument(request, index)
+		if err != nil {
+			return nil, err
+		}
+
+		current := map[string]interface{}{}
+		if existing, found := existingByName[name]; found {
+			if current, err = t.toDocument(existing, index); err != nil {
+				return nil, err
+			}
+		}
+
+		diff := diffEdgeExportEntity(current, canonical)
+		if len(diff) == 0 {
+			continue
+		}
+
+		id, err := index.idForName(t.entityType, name)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &edgeImportAction{
+			action:     edgeImportUpdate,
+			entityType: t.entityType,
+			id:         id,
+			name:       name,
+			request:    request,
+			changes:    diff,
+			deferred:   true,
+		})
+	}
+
+	return result, nil
+}
+
+// diffEdgeExportEntity describes the fields set in desired which differ from current. Fields which aren't in the
+// document are left alone by the patch, so they aren't reported
+func diffEdgeExportEntity(current, desired map[string]interface{}) []string {
+	var keys []string
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var result []string
+	for _, k := range keys {
+		if !reflect.DeepEqual(current[k], desired[k]) {
+			result = append(result, fmt.Sprintf("%v: %v => %v", k, toEdgeExportJson(current[k]), toEdgeExportJson(desired[k])))
+		}
+	}
+	return result
+}
+
+func toEdgeExportJson(val interface{}) string {
+	buf, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprint(val)
+	}
+	return string(buf)
+}
+
+type edgeImporter struct {
+	options   *importOptions
+	index     *edgeEntityIndex
+	created   int
+	updated   int
+	deleted   int
+	unchanged int
+
+	// entities created or updated by the first pass, keyed by type and name, so deferred updates aren't counted twice
+	changed map[string]struct{}
+}
+
+func (self *edgeImporter) run(doc *edgeExportDocument) error {
+	var deletes []*edgeImportAction
+
+	for _, section := range doc.sections {
+		t := getEdgeExportType(section.entityType)
+		changes, sectionDeletes, unchanged, err := planEdgeImport(t, section.entities, self.index, self.options.prune)
+		if err != nil {
+			return err
+		}
+		self.unchanged += unchanged
+
+		// entities created here may be referenced by later sections, so they're applied before planning the next one
+		for _, action := range changes {
+			if err = self.apply(action); err != nil {
+				return err
+			}
+		}
+
+		deletes = append(sectionDeletes, deletes...)
+	}
+
+	for _, section := range doc.sections {
+		t := getEdgeExportType(section.entityType)
+		if len(t.deferred) == 0 {
+			continue
+		}
+		changes, err := planEdgeImportDeferred(t, section.entities, self.index)
+		if err != nil {
+			return err
+		}
+		for _, action := range changes {
+			if err = self.apply(action); err != nil {
+				return err
+			}
+		}
+	}
+
+	// policies are deleted before the entities they reference
+	for _, action := range deletes {
+		if err := self.apply(action); err != nil {
+			return err
+		}
+	}
+
+	summary := "import complete"
+	if self.options.dryRun {
+		summary = "dry run, no changes made"
+	}
+	self.options.Printf("%v: %v created, %v updated, %v deleted, %v unchanged\n", summary, self.created, self.updated, self.deleted, self.unchanged)
+	return nil
+}
+
+func (self *edgeImporter) apply(action *edgeImportAction) error {
+	o := &self.options.Options
+	self.options.Printf("%v\n", action)
+	for _, change := range action.changes {
+		self.options.Printf("    %v\n", change)
+	}
+
+	key := action.entityType + "/" + action.name
+
+	switch action.action {
+	case edgeImportCreate:
+		self.created++
+		self.changed[key] = struct{}{}
+		if self.options.dryRun {
+			// later sections may reference the entity by name, so it's indexed using its name as a placeholder id
+			return self.index.add(action.entityType, action.name, action.name)
+		}
+		body, err := json.Marshal(action.request)
+		if err != nil {
+			return err
+		}
+		result, err := CreateEntityOfType(action.entityType, string(body), o)
+		if err != nil {
+			return errors.Wrapf(err, "unable to %v", action)
+		}
+		id, _ := result.S("data", "id").Data().(string)
+		return self.index.add(action.entityType, id, action.name)
+
+	case edgeImportUpdate:
+		if _, found := self.changed[key]; !found {
+			self.changed[key] = struct{}{}
+			self.updated++
+			if action.deferred {
+				// counted as unchanged by the first pass
+				self.unchanged--
+			}
+		}
+		if self.options.dryRun {
+			return nil
+		}
+		body, err := json.Marshal(action.request)
+		if err != nil {
+			return err
+		}
+		if _, err = patchEntityOfType(fmt.Sprintf("%v/%v", action.entityType, action.id), string(body), o); err != nil {
+			return errors.Wrapf(err, "unable to %v", action)
+		}
+		return nil
+
+	case edgeImportDelete:
+		self.deleted++
+		if self.options.dryRun {
+			return nil
+		}
+		if err, _ := util.ControllerDelete("edge", action.entityType, action.id, "", o.Out, o.OutputJSONRequest, o.OutputJSONResponse, o.Timeout, o.Verbose); err != nil {
+			return errors.Wrapf(err, "unable to %v", action)
+		}
+		return nil
+	}
+
+	return errors.Errorf("unknown import action %v", action.action)
+}
@@ -0,0 +1,184 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/openziti/channel/v2/protobufs"
+	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// policySimulationChanges is the YAML or JSON document describing the changes to simulate. Policies use the same
+// field names as the edge management API
+type policySimulationChanges struct {
+	ServicePolicies                 []*simulatedPolicyChange `yaml:"servicePolicies"`
+	EdgeRouterPolicies              []*simulatedPolicyChange `yaml:"edgeRouterPolicies"`
+	ServiceEdgeRouterPolicies       []*simulatedPolicyChange `yaml:"serviceEdgeRouterPolicies"`
+	DeleteServicePolicies           []string                 `yaml:"deleteServicePolicies"`
+	DeleteEdgeRouterPolicies        []string                 `yaml:"deleteEdgeRouterPolicies"`
+	DeleteServiceEdgeRouterPolicies []string                 `yaml:"deleteServiceEdgeRouterPolicies"`
+	IdentityRoleAttributes          map[string][]string      `yaml:"identityRoleAttributes"`
+	ServiceRoleAttributes           map[string][]string      `yaml:"serviceRoleAttributes"`
+	EdgeRouterRoleAttributes        map[string][]string      `yaml:"edgeRouterRoleAttributes"`
+}
+
+type simulatedPolicyChange struct {
+	Id              string   `yaml:"id"`
+	Name            string   `yaml:"name"`
+	Type            string   `yaml:"type"`
+	Semantic        string   `yaml:"semantic"`
+	IdentityRoles   []string `yaml:"identityRoles"`
+	ServiceRoles    []string `yaml:"serviceRoles"`
+	EdgeRouterRoles []string `yaml:"edgeRouterRoles"`
+}
+
+func (self *simulatedPolicyChange) toProto() *mgmt_pb.SimulatedPolicy {
+	return &mgmt_pb.SimulatedPolicy{
+		Id:              self.Id,
+		Name:            self.Name,
+		Semantic:        self.Semantic,
+		PolicyType:      self.Type,
+		IdentityRoles:   self.IdentityRoles,
+		ServiceRoles:    self.ServiceRoles,
+		EdgeRouterRoles: self.EdgeRouterRoles,
+	}
+}
+
+func (self *policySimulationChanges) toRequest() *mgmt_pb.PolicySimulationRequest {
+	toPolicies := func(list []*simulatedPolicyChange) []*mgmt_pb.SimulatedPolicy {
+		var result []*mgmt_pb.SimulatedPolicy
+		for _, policy := range list {
+			result = append(result, policy.toProto())
+		}
+		return result
+	}
+
+	toRoleAttributes := func(m map[string][]string) []*mgmt_pb.SimulatedRoleAttributes {
+		var result []*mgmt_pb.SimulatedRoleAttributes
+		for entity, roleAttributes := range m {
+			result = append(result, &mgmt_pb.SimulatedRoleAttributes{
+				Entity:         entity,
+				RoleAttributes: roleAttributes,
+			})
+		}
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].Entity < result[j].Entity
+		})
+		return result
+	}
+
+	return &mgmt_pb.PolicySimulationRequest{
+		ServicePolicies:                  toPolicies(self.ServicePolicies),
+		EdgeRouterPolicies:               toPolicies(self.EdgeRouterPolicies),
+		ServiceEdgeRouterPolicies:        toPolicies(self.ServiceEdgeRouterPolicies),
+		DeletedServicePolicies:           self.DeleteServicePolicies,
+		DeletedEdgeRouterPolicies:        self.DeleteEdgeRouterPolicies,
+		DeletedServiceEdgeRouterPolicies: self.DeleteServiceEdgeRouterPolicies,
+		IdentityRoleAttributes:           toRoleAttributes(self.IdentityRoleAttributes),
+		ServiceRoleAttributes:            toRoleAttributes(self.ServiceRoleAttributes),
+		EdgeRouterRoleAttributes:         toRoleAttributes(self.EdgeRouterRoleAttributes),
+	}
+}
+
+// newPolicyAdvisorSimulateCmd creates the 'edge policy-advisor simulate' command
+func newPolicyAdvisorSimulateCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &api.Options{
+		CommonOptions: common.CommonOptions{Out: out, Err: errOut},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "simulate <changes file>",
+		Short: "reports which identities would gain or lose access to services if the given policy changes were made",
+		Long: "Reports which identities would gain or lose access to services if the policy and role attribute changes in " +
+			"the given YAML or JSON file were made. Nothing is changed. The file may contain servicePolicies, " +
+			"edgeRouterPolicies and serviceEdgeRouterPolicies to add or replace, matched to existing policies by id or name, " +
+			"deleteServicePolicies, deleteEdgeRouterPolicies and deleteServiceEdgeRouterPolicies lists of policy ids or names, " +
+			"and identityRoleAttributes, serviceRoleAttributes and edgeRouterRoleAttributes maps from entity id or name to " +
+			"the entity's new role attributes. @ roles may use entity names",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runPolicyAdvisorSimulate(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runPolicyAdvisorSimulate(o *api.Options) error {
+	buf, err := os.ReadFile(o.Args[0])
+	if err != nil {
+		return err
+	}
+
+	changes := &policySimulationChanges{}
+	if err = yaml.Unmarshal(buf, changes); err != nil {
+		return errors.Wrapf(err, "unable to parse %v", o.Args[0])
+	}
+
+	ch, err := api.NewWsMgmtChannel(nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = ch.Close()
+	}()
+
+	responseMsg, err := protobufs.MarshalTyped(changes.toRequest()).WithTimeout(time.Duration(o.Timeout) * time.Second).SendForReply(ch)
+
+	response := &mgmt_pb.PolicySimulationResponse{}
+	if err = protobufs.TypedResponse(response).Unmarshall(responseMsg, err); err != nil {
+		return err
+	}
+
+	if !response.Success {
+		return errors.Errorf("policy simulation failed: %v", response.Message)
+	}
+
+	for _, warning := range response.Warnings {
+		o.Printf("warning: %v\n", warning)
+	}
+
+	outputChanges := func(label string, list []*mgmt_pb.ReachabilityChange) {
+		o.Printf("%v: %v\n", label, len(list))
+		for _, change := range list {
+			o.Printf("    %v (%v) -> %v (%v): %v\n", change.IdentityName, change.IdentityId, change.ServiceName, change.ServiceId, change.PolicyType)
+		}
+	}
+
+	outputChanges("gained", response.Gained)
+	outputChanges("lost", response.Lost)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPolicySimulationChangesToRequest(t *testing.T) {
+	req := require.New(t)
+
+	changes := &policySimulationChanges{}
+	req.NoError(yaml.Unmarshal([]byte(`
+servicePolicies:
+  - name: web-dial
+    type: Dial
+    identityRoles: ["#users"]
+    serviceRoles: ["@web"]
+deleteEdgeRouterPolicies: [old-erp]
+identityRoleAttributes:
+  bob: [users]
+  alice: []
+`), changes))
+
+	request := changes.toRequest()
+	req.Len(request.ServicePolicies, 1)
+	req.Equal("web-dial", request.ServicePolicies[0].Name)
+	req.Equal("Dial", request.ServicePolicies[0].PolicyType)
+	req.Equal([]string{"#users"}, request.ServicePolicies[0].IdentityRoles)
+	req.Equal([]string{"old-erp"}, request.DeletedEdgeRouterPolicies)
+
+	req.Len(request.IdentityRoleAttributes, 2)
+	req.Equal("alice", request.IdentityRoleAttributes[0].Entity)
+	req.Empty(request.IdentityRoleAttributes[0].RoleAttributes)
+	req.Equal("bob", request.IdentityRoleAttributes[1].Entity)
+	req.Equal([]string{"users"}, request.IdentityRoleAttributes[1].RoleAttributes)
+}
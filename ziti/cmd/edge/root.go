@@ -50,6 +50,8 @@ func NewCmdEdge(out io.Writer, errOut io.Writer, p common.OptionsProvider) *cobr
 	cmd.AddCommand(newReEnrollCmd(out, errOut))
 	cmd.AddCommand(NewQuickStartCmd(out, errOut, context.Background()))
 	cmd.AddCommand(newValidateCommand(p))
+	cmd.AddCommand(newExportCmd(out, errOut))
+	cmd.AddCommand(newImportCmd(out, errOut))
 	cmd.AddCommand(enrollment.NewEnrollCommand(p))
 
 	for _, cmdF := range ExtraEdgeCommands {
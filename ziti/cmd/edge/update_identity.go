@@ -35,6 +35,7 @@ type updateIdentityOptions struct {
 	api.EntityOptions
 	name                     string
 	roleAttributes           []string
+	permissions              []string
 	defaultHostingPrecedence string
 	defaultHostingCost       uint16
 	serviceCosts             map[string]int
@@ -42,6 +43,7 @@ type updateIdentityOptions struct {
 	appData                  map[string]string
 	externalId               string
 	authPolicyIdOrName       string
+	bandwidthLimits          string
 }
 
 func newUpdateIdentityCmd(out io.Writer, errOut io.Writer) *cobra.Command {
@@ -70,12 +72,15 @@ func newUpdateIdentityCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringVarP(&options.externalId, "external-id", "x", "", "an external id to give to the identity")
 	cmd.Flags().StringSliceVarP(&options.roleAttributes, "role-attributes", "a", nil,
 		"comma-separated role attributes for the identity. Use '' to unset.")
+	cmd.Flags().StringSliceVar(&options.permissions, "permissions", nil,
+		"comma-separated management roles for the identity [auditor,service-operator,service-operator:<role attribute>,enrollment-operator]. Use '' to unset.")
 	cmd.Flags().StringVarP(&options.defaultHostingPrecedence, "default-hosting-precedence", "p", "", "Default precedence to use when hosting services using this identity [default,required,failed]")
 	cmd.Flags().Uint16VarP(&options.defaultHostingCost, "default-hosting-cost", "c", 0, "Default cost to use when hosting services using this identity")
 	cmd.Flags().StringToIntVar(&options.serviceCosts, "service-costs", map[string]int{}, "Per-service hosting costs")
 	cmd.Flags().StringToStringVar(&options.servicePrecedences, "service-precedences", map[string]string{}, "Per-service hosting precedences")
 	cmd.Flags().StringToStringVar(&options.appData, "app-data", nil, "Custom application data")
 	cmd.Flags().StringVarP(&options.authPolicyIdOrName, "auth-policy", "P", "", "The auth policy id or name to assign to the identity")
+	addBandwidthLimitsFlag(cmd, &options.bandwidthLimits, "identity's circuits on each router")
 
 	return cmd
 }
@@ -104,6 +109,18 @@ func runUpdateIdentity(o *updateIdentityOptions) error {
 		change = true
 	}
 
+	if o.Cmd.Flags().Changed("permissions") {
+		api.SetJSONValue(entityData, o.permissions, "permissions")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed(bandwidthLimitsFlag) {
+		if err = setBandwidthLimits(entityData, o.bandwidthLimits); err != nil {
+			return err
+		}
+		change = true
+	}
+
 	if o.Cmd.Flags().Changed("default-hosting-cost") {
 		api.SetJSONValue(entityData, o.defaultHostingCost, "defaultHostingCost")
 		change = true
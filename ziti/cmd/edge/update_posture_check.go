@@ -39,6 +39,7 @@ func newUpdatePostureCheckCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.AddCommand(newUpdatePostureCheckProcessCmd(out, errOut))
 	cmd.AddCommand(newUpdatePostureCheckOsCmd(out, errOut))
 	cmd.AddCommand(newUpdatePostureCheckMfaCmd(out, errOut))
+	cmd.AddCommand(newUpdatePostureCheckSourceIpCmd(out, errOut))
 
 	return cmd
 }
@@ -75,6 +76,11 @@ type updatePostureCheckMfaOptions struct {
 	ignoreLegacyEndpoints bool
 }
 
+type updatePostureCheckSourceIpOptions struct {
+	updatePostureCheckOptions
+	sourceIpRules
+}
+
 type updatePostureCheckOsOptions struct {
 	updatePostureCheckOptions
 	os []string
@@ -184,6 +190,73 @@ func newUpdatePostureCheckDomainCmd(out io.Writer, errOut io.Writer) *cobra.Comm
 	return cmd
 }
 
+func newUpdatePostureCheckSourceIpCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &updatePostureCheckSourceIpOptions{
+		updatePostureCheckOptions: updatePostureCheckOptions{
+			EntityOptions: api.NewEntityOptions(out, errOut),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "source-ip <idOrName>",
+		Short: "updates a source ip posture check",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runUpdatePostureCheckSourceIp(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	options.AddCommonFlags(cmd)
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().StringVarP(&options.name, "name", "n", "", "Set the name")
+	cmd.Flags().StringSliceVarP(&options.roleAttributes, "role-attributes", "a", nil,
+		"comma-separated role attributes for the posture check. Use '' to unset.")
+	options.sourceIpRules.addFlags(cmd)
+	return cmd
+}
+
+func runUpdatePostureCheckSourceIp(o *updatePostureCheckSourceIpOptions) error {
+	id, err := mapNameToID("posture-checks", o.Args[0], o.Options)
+	if err != nil {
+		return err
+	}
+	entityData := gabs.New()
+	change := false
+
+	if o.Cmd.Flags().Changed("name") {
+		api.SetJSONValue(entityData, o.name, "name")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("role-attributes") {
+		api.SetJSONValue(entityData, o.roleAttributes, "roleAttributes")
+		change = true
+	}
+
+	if o.sourceIpRules.setChanged(o.Cmd, entityData, false) {
+		change = true
+	}
+
+	if o.TagsProvided() {
+		o.SetTags(entityData)
+		change = true
+	}
+
+	if !change {
+		return errors.New("no change specified. must specify at least one attribute to change")
+	}
+
+	api.SetJSONValue(entityData, PostureCheckTypeSourceIp, "typeId")
+
+	_, err = patchEntityOfType(fmt.Sprintf("posture-checks/%v", id), entityData.String(), &o.Options)
+	return err
+}
+
 func newUpdatePostureCheckMfaCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	options := &updatePostureCheckMfaOptions{
 		updatePostureCheckOptions: updatePostureCheckOptions{
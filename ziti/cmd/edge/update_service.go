@@ -34,6 +34,10 @@ type updateServiceOptions struct {
 	name               string
 	maxIdleTime        time.Duration
 	terminatorStrategy string
+	multipath          string
+	priority           string
+	compression        string
+	bandwidthLimits    string
 	roleAttributes     []string
 	encryption         encryptionVar
 	configs            []string
@@ -64,6 +68,10 @@ func newUpdateServiceCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringVar(&options.terminatorStrategy, "terminator-strategy", "", "Specifies the terminator strategy for the service")
 	cmd.Flags().StringSliceVarP(&options.roleAttributes, "role-attributes", "a", nil,
 		"comma-separated role attributes for the service. Use '' to unset.")
+	cmd.Flags().StringVar(&options.multipath, "multipath", "", "Routes circuits over two link-disjoint paths. Valid values are 'duplicate', which sends every payload over both paths, and 'failover', which only uses the second path when the first fails")
+	cmd.Flags().StringVar(&options.priority, "priority", "", "Sets the priority class links use to schedule traffic for the service's circuits. Valid values are 'interactive', 'default' and 'bulk'")
+	cmd.Flags().StringVar(&options.compression, "compression", "", "Compresses circuit payloads for the service. Valid values are 'zstd' and 'lz4'. Payloads are only compressed when the routers at both ends of the circuit support the codec")
+	addBandwidthLimitsFlag(cmd, &options.bandwidthLimits, "service's circuits")
 	cmd.Flags().DurationVar(&options.maxIdleTime, "max-idle-time", 0, "Time after which idle circuit will be terminated. Defaults to 0, which indicates no limit on idle circuits")
 	if err := options.encryption.Set("ON"); err != nil {
 		panic(err)
@@ -100,6 +108,28 @@ func runUpdateService(o *updateServiceOptions) error {
 		change = true
 	}
 
+	if o.Cmd.Flags().Changed("multipath") {
+		api.SetJSONValue(entityData, o.multipath, "multipath")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("priority") {
+		api.SetJSONValue(entityData, o.priority, "priority")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("compression") {
+		api.SetJSONValue(entityData, o.compression, "compression")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed(bandwidthLimitsFlag) {
+		if err = setBandwidthLimits(entityData, o.bandwidthLimits); err != nil {
+			return err
+		}
+		change = true
+	}
+
 	if o.Cmd.Flags().Changed("encryption") {
 		api.SetJSONValue(entityData, o.encryption.Get(), "encryptionRequired")
 		change = true
@@ -34,6 +34,9 @@ type updateServicePolicyOptions struct {
 	serviceRoles      []string
 	identityRoles     []string
 	postureCheckRoles []string
+	validFrom         string
+	validUntil        string
+	schedules         []string
 }
 
 func newUpdateServicePolicyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
@@ -62,6 +65,7 @@ func newUpdateServicePolicyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVar(&options.serviceRoles, "service-roles", nil, "Service roles of the service policy")
 	cmd.Flags().StringSliceVar(&options.identityRoles, "identity-roles", nil, "Identity roles of the service policy")
 	cmd.Flags().StringSliceVarP(&options.postureCheckRoles, "posture-check-roles", "p", nil, "Posture Check roles of the service policy")
+	addServicePolicyScheduleFlags(cmd, &options.validFrom, &options.validUntil, &options.schedules)
 
 	options.AddCommonFlags(cmd)
 
@@ -112,6 +116,13 @@ func runUpdateServicePolicy(o *updateServicePolicyOptions) error {
 		change = true
 	}
 
+	if o.Cmd.Flags().Changed("valid-from") || o.Cmd.Flags().Changed("valid-until") || o.Cmd.Flags().Changed("schedule") {
+		if err = setServicePolicySchedule(o.Cmd, entityData, o.validFrom, o.validUntil, o.schedules); err != nil {
+			return err
+		}
+		change = true
+	}
+
 	if o.TagsProvided() {
 		o.SetTags(entityData)
 		change = true
@@ -33,6 +33,7 @@ import (
 	"github.com/openziti/ziti/tunnel"
 	"github.com/openziti/ziti/tunnel/dns"
 	"github.com/openziti/ziti/tunnel/entities"
+	"github.com/openziti/ziti/tunnel/health"
 	"github.com/openziti/ziti/tunnel/intercept"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -42,6 +43,7 @@ const (
 	svcPollRateFlag   = "svcPollRate"
 	resolverCfgFlag   = "resolver"
 	dnsSvcIpRangeFlag = "dnsSvcIpRange"
+	execCheckFlag     = "health-check-exec-allow"
 )
 
 var hostSpecificCmds []*cobra.Command
@@ -62,13 +64,15 @@ func NewTunnelCmd(standalone bool) *cobra.Command {
 	root.PersistentFlags().StringP("identity", "i", "", "Path to JSON file that contains an enrolled identity")
 	root.PersistentFlags().String("identity-dir", "", "Path to directory file that contains one or more enrolled identities")
 	root.PersistentFlags().Uint(svcPollRateFlag, 15, "Set poll rate for service updates (seconds). Polling in proxy mode is disabled unless this value is explicitly set")
-	root.PersistentFlags().StringP(resolverCfgFlag, "r", "udp://127.0.0.1:53", "Resolver configuration")
+	root.PersistentFlags().StringP(resolverCfgFlag, "r", "udp://127.0.0.1:53", "Resolver configuration. udp resolvers accept ttl (seconds), upstream (repeatable) and upstreamTimeout query parameters, e.g. udp://127.0.0.1:53?ttl=30&upstream=1.1.1.1")
 	root.PersistentFlags().StringVar(&logFormatter, "log-formatter", "", "Specify log formatter [json|pfxlog|text]")
 	root.PersistentFlags().StringP(dnsSvcIpRangeFlag, "d", "100.64.0.1/10", "cidr to use when assigning IPs to unresolvable intercept hostnames")
 	root.PersistentFlags().BoolVar(&cliAgentEnabled, "cli-agent", true, "Enable/disable CLI Agent (enabled by default)")
 	root.PersistentFlags().StringVar(&cliAgentAddr, "cli-agent-addr", "", "Specify where CLI Agent should list (ex: unix:/tmp/myfile.sock or tcp:127.0.0.1:10001)")
 	root.PersistentFlags().StringVar(&cliAgentAlias, "cli-agent-alias", "", "Alias which can be used by ziti agent commands to find this instance")
 	root.PersistentFlags().BoolVar(&ha, "ha", false, "Enable HA controller compatibility")
+	root.PersistentFlags().StringArray(execCheckFlag, nil, "Command line which exec health checks on hosted services may run, starting with the command's absolute path. "+
+		"Arguments must match exactly unless the line ends with a separate *, which allows any further arguments. May be repeated. Exec checks are disabled if not set")
 
 	root.AddCommand(NewHostCmd())
 	root.AddCommand(NewProxyCmd())
@@ -137,6 +141,11 @@ func rootPostRun(cmd *cobra.Command, _ []string) {
 		log.WithError(err).Fatal("failed to start DNS resolver")
 	}
 
+	execAllowList, _ := cmd.Flags().GetStringArray(execCheckFlag)
+	if err = health.SetExecCheckAllowList(execAllowList); err != nil {
+		log.WithError(err).Fatal("invalid exec health check allow list")
+	}
+
 	serviceListenerGroup := intercept.NewServiceListenerGroup(interceptor, resolver)
 
 	dnsIpRange, _ := cmd.Flags().GetString(dnsSvcIpRangeFlag)
@@ -33,11 +33,12 @@ func NewTProxyCmd() *cobra.Command {
 	var runTProxyCmd = &cobra.Command{
 		Use:     "tproxy",
 		Short:   "Use the 'tproxy' interceptor",
-		Long:    "The 'tproxy' interceptor captures packets by using the TPROXY iptables target.",
+		Long:    "The 'tproxy' interceptor captures packets by using the TPROXY iptables target, or the nftables tproxy statement.",
 		RunE:    runTProxy,
 		PostRun: rootPostRun,
 	}
 	runTProxyCmd.PersistentFlags().String("lanIf", "", "if specified, INPUT rules for intercepted service addresses are assigned to this interface ")
+	runTProxyCmd.PersistentFlags().String("backend", tproxy.BackendIptables, "firewall used to divert intercepted traffic. one of [iptables, nftables]")
 	return runTProxyCmd
 }
 
@@ -48,7 +49,12 @@ func runTProxy(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	interceptor, err = tproxy.New(tproxy.Config{LanIf: lanIf})
+	backend, err := cmd.Flags().GetString("backend")
+	if err != nil {
+		return err
+	}
+
+	interceptor, err = tproxy.New(tproxy.Config{LanIf: lanIf, Backend: backend})
 	if err != nil {
 		return fmt.Errorf("failed to initialize tproxy interceptor: %v", err)
 	}